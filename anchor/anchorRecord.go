@@ -16,6 +16,12 @@ import (
 	"github.com/FactomProject/factomd/common/primitives"
 )
 
+// AnchorSigKeys are the public keys anchor records are signed with
+var AnchorSigKeys []string = []string{
+	"0426a802617848d4d16d87830fc521f4d136bb2d0c352850919c2679f189613a", //m1 key
+	"d569419348ed7056ec2ba54f0ecd9eea02648b260b26e0474f8c07fe9ac6bf83", //m2 key
+}
+
 //AnchorRecord is used to construct anchor chain
 type AnchorRecord struct {
 	AnchorRecordVer int
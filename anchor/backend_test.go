@@ -0,0 +1,89 @@
+package anchor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/FactomProject/factomd/anchor"
+)
+
+// rpcServer answers JSON-RPC calls from a map of method to raw response
+func rpcServer(t *testing.T, responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := new(struct {
+			Method string `json:"method"`
+		})
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Errorf("%v", err)
+		}
+		resp, ok := responses[req.Method]
+		if !ok {
+			resp = `{"result":null,"error":{"code":-32601,"message":"Method not found"}}`
+		}
+		fmt.Fprint(w, resp)
+	}))
+}
+
+func TestBitcoinRPC(t *testing.T) {
+	server := rpcServer(t, map[string]string{
+		"getrawtransaction": `{"result":{"txid":"ab","confirmations":3},"error":null,"id":1}`,
+	})
+	defer server.Close()
+
+	b := NewBitcoinRPC(server.URL)
+	if b.Name() != BitcoinTarget {
+		t.Errorf("Wrong name %v", b.Name())
+	}
+	c, err := b.Confirmations("ab")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if c != 3 {
+		t.Errorf("Got %v confirmations, expected 3", c)
+	}
+}
+
+func TestEthereumRPC(t *testing.T) {
+	server := rpcServer(t, map[string]string{
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x10"}}`,
+		"eth_blockNumber":           `{"jsonrpc":"2.0","id":2,"result":"0x14"}`,
+	})
+	defer server.Close()
+
+	e := NewEthereumRPC(server.URL)
+	if e.Name() != EthereumTarget {
+		t.Errorf("Wrong name %v", e.Name())
+	}
+	c, err := e.Confirmations("0xab")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if c != 5 {
+		t.Errorf("Got %v confirmations, expected 5", c)
+	}
+
+	// Not mined yet
+	pending := rpcServer(t, map[string]string{
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":null}`,
+	})
+	defer pending.Close()
+	c, err = NewEthereumRPC(pending.URL).Confirmations("0xab")
+	if err != nil || c != 0 {
+		t.Errorf("Expected 0 confirmations, got %v %v", c, err)
+	}
+}
+
+func TestRPCError(t *testing.T) {
+	server := rpcServer(t, map[string]string{})
+	defer server.Close()
+
+	if _, err := NewBitcoinRPC(server.URL).Confirmations("ab"); err == nil {
+		t.Errorf("Expected an error from the node to be returned")
+	}
+	if _, err := NewEthereumRPC(server.URL).Confirmations("0xab"); err == nil {
+		t.Errorf("Expected an error from the node to be returned")
+	}
+}
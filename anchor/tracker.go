@@ -0,0 +1,335 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package anchor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The chains anchor records are written to
+var AnchorChainIDs []string = []string{
+	"df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604", // Bitcoin
+	"6e4540d08d5ac6a1a394e982fb6a2ab8b516ee751c37420055141b94fe070bfe", // Ethereum
+}
+
+// DefaultDepths is how many blocks deep an anchoring transaction must be for
+// the anchor to be confirmed, by target.
+var DefaultDepths map[string]int64 = map[string]int64{
+	BitcoinTarget:  6,
+	EthereumTarget: 12,
+}
+
+// Tracker follows the anchoring of every directory block.  Sync reads new
+// directory blocks and the anchor records in the anchor chains from the
+// database, and Confirm asks the backends how deep each anchor is.
+type Tracker struct {
+	DB         interfaces.DBOverlaySimple
+	PublicKeys []interfaces.Verifier // Keys anchor records must be signed with
+	Depths     map[string]int64
+	BatchSize  uint32 // Directory blocks read per Sync
+
+	mutex    sync.RWMutex
+	backends map[string]Backend
+	statuses []*interfaces.AnchorStatus // By directory block height
+	chains   map[[32]byte]bool
+	synced   uint32 // Next height for Sync to read
+}
+
+var _ interfaces.IAnchor = (*Tracker)(nil)
+
+func NewTracker(db interfaces.DBOverlaySimple, publicKeys []interfaces.Verifier) *Tracker {
+	t := new(Tracker)
+	t.DB = db
+	t.PublicKeys = publicKeys
+	t.Depths = map[string]int64{}
+	for k, v := range DefaultDepths {
+		t.Depths[k] = v
+	}
+	t.BatchSize = 1000
+	t.backends = map[string]Backend{}
+	t.chains = map[[32]byte]bool{}
+	for _, v := range AnchorChainIDs {
+		h, err := primitives.NewShaHashFromStr(v)
+		if err != nil {
+			panic(err)
+		}
+		t.chains[h.Fixed()] = true
+	}
+	return t
+}
+
+// AddBackend sets the backend of its target, replacing any there was.
+func (t *Tracker) AddBackend(b Backend) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.backends[b.Name()] = b
+}
+
+// InitRPCClient is a no-op; backends connect when they are first asked.
+func (t *Tracker) InitRPCClient() error {
+	return nil
+}
+
+// UpdateDirBlockInfoMap records a Bitcoin anchor known from a DirBlockInfo.
+func (t *Tracker) UpdateDirBlockInfoMap(dirBlockInfo interfaces.IDirBlockInfo) {
+	if dirBlockInfo == nil || dirBlockInfo.GetBTCTxHash() == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	s := t.status(dirBlockInfo.GetDBHeight())
+	if s.KeyMR == "" && dirBlockInfo.GetDBMerkleRoot() != nil {
+		s.KeyMR = dirBlockInfo.GetDBMerkleRoot().String()
+	}
+	target := new(interfaces.AnchorTarget)
+	target.Name = BitcoinTarget
+	target.TxID = dirBlockInfo.GetBTCTxHash().String()
+	target.BlockHeight = int64(dirBlockInfo.GetBTCBlockHeight())
+	t.addTarget(s, target)
+}
+
+// Sync reads the directory blocks saved since the last Sync, up to
+// BatchSize of them, and the anchor records in them.  It returns the number
+// of blocks read.  Sync must not be called concurrently with itself.
+func (t *Tracker) Sync() (int, error) {
+	head, err := t.DB.FetchDBlockHead()
+	if err != nil {
+		return 0, err
+	}
+	if head == nil {
+		return 0, nil
+	}
+
+	read := 0
+	for height := t.synced; height <= head.GetDatabaseHeight() && uint32(read) < t.BatchSize; height++ {
+		dBlock, err := t.DB.FetchDBlockByHeight(height)
+		if err != nil {
+			return read, err
+		}
+		if dBlock == nil {
+			break
+		}
+		records, err := t.anchorRecords(dBlock)
+		if err != nil {
+			return read, err
+		}
+
+		t.mutex.Lock()
+		s := t.status(height)
+		s.KeyMR = dBlock.GetKeyMR().String()
+		for _, ar := range records {
+			t.addRecord(ar)
+		}
+		t.mutex.Unlock()
+		t.synced = height + 1
+		read++
+	}
+	return read, nil
+}
+
+// anchorRecords returns the valid anchor records in a directory block
+func (t *Tracker) anchorRecords(dBlock interfaces.IDirectoryBlock) ([]*AnchorRecord, error) {
+	var answer []*AnchorRecord
+	for _, dbEntry := range dBlock.GetDBEntries() {
+		if t.chains[dbEntry.GetChainID().Fixed()] == false {
+			continue
+		}
+		eBlock, err := t.DB.FetchEBlock(dbEntry.GetKeyMR())
+		if err != nil {
+			return nil, err
+		}
+		if eBlock == nil {
+			continue
+		}
+		for _, h := range eBlock.GetEntryHashes() {
+			if h.IsMinuteMarker() {
+				continue
+			}
+			entry, err := t.DB.FetchEntry(h)
+			if err != nil {
+				return nil, err
+			}
+			if entry == nil {
+				continue
+			}
+			ar, valid, err := UnmarshalAndValidateAnchorEntryAnyVersion(entry, t.PublicKeys)
+			if err != nil || valid == false || ar == nil {
+				// Chain creation entries and the like
+				continue
+			}
+			answer = append(answer, ar)
+		}
+	}
+	return answer, nil
+}
+
+// addRecord adds the targets of an anchor record to its directory block.
+// The caller holds the lock.
+func (t *Tracker) addRecord(ar *AnchorRecord) {
+	s := t.status(ar.DBHeight)
+	if s.KeyMR != "" && s.KeyMR != ar.KeyMR {
+		return
+	}
+	if ar.Bitcoin != nil {
+		target := new(interfaces.AnchorTarget)
+		target.Name = BitcoinTarget
+		target.TxID = ar.Bitcoin.TXID
+		target.BlockHeight = int64(ar.Bitcoin.BlockHeight)
+		target.BlockHash = ar.Bitcoin.BlockHash
+		target.Offset = int64(ar.Bitcoin.Offset)
+		t.addTarget(s, target)
+	}
+	if ar.Ethereum != nil {
+		target := new(interfaces.AnchorTarget)
+		target.Name = EthereumTarget
+		target.TxID = ar.Ethereum.TXID
+		target.BlockHeight = ar.Ethereum.BlockHeight
+		target.BlockHash = ar.Ethereum.BlockHash
+		target.Offset = ar.Ethereum.Offset
+		t.addTarget(s, target)
+	}
+}
+
+// addTarget adds or replaces the target of a status.  The caller holds the
+// lock.
+func (t *Tracker) addTarget(s *interfaces.AnchorStatus, target *interfaces.AnchorTarget) {
+	target.Status = interfaces.AnchorStatusAnchored
+	for i, v := range s.Targets {
+		if v.Name == target.Name {
+			if v.TxID == target.TxID {
+				return
+			}
+			s.Targets[i] = target
+			updateStatus(s)
+			return
+		}
+	}
+	s.Targets = append(s.Targets, target)
+	updateStatus(s)
+}
+
+// status returns the status of a height, adding pending statuses up to it
+// as needed.  The caller holds the lock.
+func (t *Tracker) status(height uint32) *interfaces.AnchorStatus {
+	for uint32(len(t.statuses)) <= height {
+		s := new(interfaces.AnchorStatus)
+		s.DBHeight = uint32(len(t.statuses))
+		s.Status = interfaces.AnchorStatusPending
+		t.statuses = append(t.statuses, s)
+	}
+	return t.statuses[height]
+}
+
+// updateStatus sets a status to the best of its targets
+func updateStatus(s *interfaces.AnchorStatus) {
+	s.Status = interfaces.AnchorStatusPending
+	for _, v := range s.Targets {
+		switch v.Status {
+		case interfaces.AnchorStatusConfirmed:
+			s.Status = interfaces.AnchorStatusConfirmed
+			return
+		case interfaces.AnchorStatusAnchored:
+			s.Status = interfaces.AnchorStatusAnchored
+		}
+	}
+}
+
+// Confirm asks the backends how deep every anchor that is not yet
+// confirmed is.  Targets without a backend stay anchored.  It returns the
+// last error from a backend, after trying all of them.
+func (t *Tracker) Confirm() error {
+	type query struct {
+		status *interfaces.AnchorStatus
+		target *interfaces.AnchorTarget
+		txID   string
+	}
+	var queries []query
+
+	t.mutex.RLock()
+	for _, s := range t.statuses {
+		for _, v := range s.Targets {
+			if v.Status == interfaces.AnchorStatusAnchored && t.backends[v.Name] != nil {
+				queries = append(queries, query{s, v, v.TxID})
+			}
+		}
+	}
+	backends := make(map[string]Backend, len(t.backends))
+	for k, v := range t.backends {
+		backends[k] = v
+	}
+	t.mutex.RUnlock()
+
+	// Ask without the lock, RPCs can be slow
+	var last error
+	for _, q := range queries {
+		c, err := backends[q.target.Name].Confirmations(q.txID)
+		if err != nil {
+			last = fmt.Errorf("%s anchor of block %d: %v", q.target.Name, q.status.DBHeight, err)
+			continue
+		}
+		t.mutex.Lock()
+		if q.target.TxID == q.txID {
+			q.target.Confirmations = c
+			if c >= t.Depths[q.target.Name] {
+				q.target.Status = interfaces.AnchorStatusConfirmed
+			}
+			updateStatus(q.status)
+		}
+		t.mutex.Unlock()
+	}
+	return last
+}
+
+// Run calls Sync and Confirm until stop is closed.  Sync runs again at once
+// while it has blocks to catch up on.
+func (t *Tracker) Run(interval time.Duration, stop chan struct{}) {
+	for {
+		read, err := t.Sync()
+		if err == nil && uint32(read) == t.BatchSize {
+			continue
+		}
+		t.Confirm()
+		select {
+		case <-stop:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (t *Tracker) GetAnchorStatus(dbHeight uint32) *interfaces.AnchorStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if dbHeight >= uint32(len(t.statuses)) {
+		return nil
+	}
+	return copyStatus(t.statuses[dbHeight])
+}
+
+func (t *Tracker) GetAnchorStatuses(start, end uint32) []*interfaces.AnchorStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	answer := []*interfaces.AnchorStatus{}
+	for h := start; h <= end && h < uint32(len(t.statuses)); h++ {
+		answer = append(answer, copyStatus(t.statuses[h]))
+	}
+	return answer
+}
+
+func copyStatus(s *interfaces.AnchorStatus) *interfaces.AnchorStatus {
+	c := *s
+	c.Targets = make([]*interfaces.AnchorTarget, len(s.Targets))
+	for i, v := range s.Targets {
+		target := *v
+		c.Targets[i] = &target
+	}
+	return &c
+}
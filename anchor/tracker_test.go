@@ -0,0 +1,122 @@
+package anchor_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func testTracker(t *testing.T) (*Tracker, uint32) {
+	dbo := CreateAndPopulateTestDatabaseOverlay()
+	head, err := dbo.FetchDBlockHead()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	tracker := NewTracker(dbo, []interfaces.Verifier{NewPrimitivesPrivateKey(0).Pub})
+	return tracker, head.GetDatabaseHeight()
+}
+
+func TestTrackerSync(t *testing.T) {
+	tracker, head := testTracker(t)
+	tracker.BatchSize = 3
+
+	read, err := tracker.Sync()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if read != 3 {
+		t.Errorf("Read %v blocks, expected 3", read)
+	}
+	for {
+		read, err = tracker.Sync()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if read == 0 {
+			break
+		}
+	}
+
+	statuses := tracker.GetAnchorStatuses(0, head+10)
+	if uint32(len(statuses)) != head+1 {
+		t.Fatalf("%v statuses, expected %v", len(statuses), head+1)
+	}
+	if tracker.GetAnchorStatus(head+1) != nil {
+		t.Errorf("Expected no status past the head")
+	}
+
+	// Every block but the head is anchored by the block after it
+	for _, s := range statuses[1:head] {
+		if s.KeyMR == "" || s.Status != interfaces.AnchorStatusAnchored || len(s.Targets) != 1 {
+			t.Errorf("Block %v: %+v", s.DBHeight, s)
+			continue
+		}
+		if s.Targets[0].Name != BitcoinTarget || s.Targets[0].BlockHeight != int64(s.DBHeight) {
+			t.Errorf("Block %v: bad target %+v", s.DBHeight, s.Targets[0])
+		}
+	}
+	if s := statuses[head]; s.Status != interfaces.AnchorStatusPending {
+		t.Errorf("Expected the head to be pending, got %v", s.Status)
+	}
+
+	// Records signed by other keys are ignored
+	tracker = NewTracker(tracker.DB, []interfaces.Verifier{NewPrimitivesPrivateKey(1).Pub})
+	tracker.Sync()
+	for _, s := range tracker.GetAnchorStatuses(0, head) {
+		if s.Status != interfaces.AnchorStatusPending {
+			t.Errorf("Block %v anchored by an unknown key", s.DBHeight)
+		}
+	}
+}
+
+func TestTrackerConfirm(t *testing.T) {
+	tracker, _ := testTracker(t)
+	if _, err := tracker.Sync(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Without a backend nothing is confirmed
+	if err := tracker.Confirm(); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	stub := NewStubBackend(BitcoinTarget)
+	tracker.AddBackend(stub)
+	deep := tracker.GetAnchorStatus(1).Targets[0].TxID
+	shallow := tracker.GetAnchorStatus(2).Targets[0].TxID
+	stub.SetConfirmations(deep, 6)
+	stub.SetConfirmations(shallow, 2)
+
+	// The other blocks are unknown to the stub
+	if err := tracker.Confirm(); err == nil {
+		t.Errorf("Expected an error for unknown transactions")
+	}
+	if s := tracker.GetAnchorStatus(1); s.Status != interfaces.AnchorStatusConfirmed || s.Targets[0].Confirmations != 6 {
+		t.Errorf("Expected block 1 confirmed, got %+v", s.Targets[0])
+	}
+	if s := tracker.GetAnchorStatus(2); s.Status != interfaces.AnchorStatusAnchored || s.Targets[0].Confirmations != 2 {
+		t.Errorf("Expected block 2 anchored, got %+v", s.Targets[0])
+	}
+
+	// Confirmed anchors are not asked about again
+	calls := stub.Calls()
+	tracker.Confirm()
+	if stub.Calls()-calls != calls-1 {
+		t.Errorf("Expected %v calls, got %v", calls-1, stub.Calls()-calls)
+	}
+
+	tracker.Depths[BitcoinTarget] = 2
+	tracker.Confirm()
+	if s := tracker.GetAnchorStatus(2); s.Status != interfaces.AnchorStatusConfirmed {
+		t.Errorf("Expected block 2 confirmed at depth 2, got %v", s.Status)
+	}
+
+	// Statuses are copies
+	s := tracker.GetAnchorStatus(2)
+	s.Targets[0].Status = interfaces.AnchorStatusPending
+	if tracker.GetAnchorStatus(2).Targets[0].Status != interfaces.AnchorStatusConfirmed {
+		t.Errorf("GetAnchorStatus returned the tracker's own status")
+	}
+}
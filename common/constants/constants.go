@@ -70,6 +70,13 @@ const (
 	// length of a Private Key
 	SIGNATURE_LENGTH     = 64    // Length of a signature
 	MAX_TRANSACTION_SIZE = 10240 // 10K like everything else?
+	// Multisig (RCD type 2) inputs are accepted from these heights on, on the
+	// main, test and local networks and on custom networks.  None is
+	// scheduled yet, so running networks do not change under their nodes.
+	RCD_2_ACTIVATION_HEIGHT_MAIN   uint32 = 0xFFFFFFFF
+	RCD_2_ACTIVATION_HEIGHT_TEST   uint32 = 0xFFFFFFFF
+	RCD_2_ACTIVATION_HEIGHT_LOCAL  uint32 = 0xFFFFFFFF
+	RCD_2_ACTIVATION_HEIGHT_CUSTOM uint32 = 0xFFFFFFFF
 	// Not sure if we need a minimum amount.  Set at 1 Factoshi
 
 	// Database
@@ -426,6 +426,14 @@ func (b *FBlock) GetLedgerMR() interfaces.IHash {
 }
 
 func (b *FBlock) GetBodyMR() interfaces.IHash {
+	b.BodyMR = primitives.ComputeMerkleRoot(b.GetBodyHashes())
+
+	return b.BodyMR
+}
+
+// Returns the leaves of the BodyMR; the transaction hashes, with the end
+// of minute markers between them.
+func (b *FBlock) GetBodyHashes() []interfaces.IHash {
 	hashes := make([]interfaces.IHash, 0, len(b.Transactions))
 	marker := 0
 	for i, trans := range b.Transactions {
@@ -440,10 +448,7 @@ func (b *FBlock) GetBodyMR() interfaces.IHash {
 		marker++
 		hashes = append(hashes, primitives.Sha(constants.ZERO))
 	}
-
-	b.BodyMR = primitives.ComputeMerkleRoot(hashes)
-
-	return b.BodyMR
+	return hashes
 }
 
 func (b *FBlock) GetPrevKeyMR() interfaces.IHash {
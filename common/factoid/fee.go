@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// FeeBreakdown itemizes the fee computed by CalculateFee.
+type FeeBreakdown struct {
+	FactoshisPerEC uint64 `json:"factoshisperec"`
+	Size           int    `json:"size"` // Bytes in the signed transaction
+	SizeFee        uint64 `json:"sizefee"`
+	Signatures     int    `json:"signatures"`
+	InputFee       uint64 `json:"inputfee"` // The signatures needed by the inputs
+	OutputFee      uint64 `json:"outputfee"`
+	ECOutputFee    uint64 `json:"ecoutputfee"`
+	Fee            uint64 `json:"fee"`
+}
+
+// CalculateFeeBreakdown is CalculateFee, with the fee split into its parts.
+func (t Transaction) CalculateFeeBreakdown(factoshisPerEC uint64) (*FeeBreakdown, error) {
+	// First look at the size of the transaction, and make sure
+	// everything is inbounds.
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("Can't Marshal the Transaction")
+	}
+	if len(data) > constants.MAX_TRANSACTION_SIZE { // Can't be bigger than our limits
+		return nil, fmt.Errorf("Transaction is greater than the max transaction size")
+	}
+
+	b := new(FeeBreakdown)
+	b.FactoshisPerEC = factoshisPerEC
+	b.Size = len(data)
+	b.SizeFee = factoshisPerEC * uint64((len(data)+1023)/1024)
+	b.OutputFee = factoshisPerEC * 10 * uint64(len(t.Outputs))
+	b.ECOutputFee = factoshisPerEC * 10 * uint64(len(t.OutECs))
+	for _, rcd := range t.RCDs {
+		b.Signatures += rcd.NumberOfSignatures()
+	}
+	b.InputFee = factoshisPerEC * uint64(b.Signatures)
+	b.Fee = b.SizeFee + b.OutputFee + b.ECOutputFee + b.InputFee
+
+	return b, nil
+}
+
+// EstimateFee returns the fee the transaction will need once it is signed.
+// Inputs without an RCD are assumed to be RCD_1 addresses, and missing
+// signatures are replaced by placeholders of the same size.  The signers of
+// an RCD_2 are assumed to be RCD_1 addresses.
+func (t Transaction) EstimateFee(factoshisPerEC uint64) (*FeeBreakdown, error) {
+	if len(t.RCDs) > len(t.Inputs) {
+		return nil, fmt.Errorf("%d RCDs for %d inputs", len(t.RCDs), len(t.Inputs))
+	}
+	rcds := make([]interfaces.IRCD, len(t.Inputs))
+	sigBlocks := make([]interfaces.ISignatureBlock, len(t.Inputs))
+	for i := range t.Inputs {
+		if i < len(t.RCDs) && t.RCDs[i] != nil {
+			rcds[i] = t.RCDs[i]
+		} else {
+			rcds[i] = NewRCD_1(make([]byte, constants.ADDRESS_LENGTH))
+		}
+		if i < len(t.SigBlocks) && t.SigBlocks[i] != nil && len(t.SigBlocks[i].GetSignatures()) > 0 {
+			sigBlocks[i] = t.SigBlocks[i]
+			continue
+		}
+		sb, err := newFeeEstimateSignatureBlock(rcds[i])
+		if err != nil {
+			return nil, fmt.Errorf("Input %d: %v", i, err)
+		}
+		sigBlocks[i] = sb
+	}
+	t.RCDs = rcds
+	t.SigBlocks = sigBlocks
+
+	return t.CalculateFeeBreakdown(factoshisPerEC)
+}
+
+// NewFeeEstimateRCD returns an RCD of the given type, the same size as the
+// real one, for estimating the fee of a transaction that is not yet built.
+// required and addresses only apply to RCD_2.
+func NewFeeEstimateRCD(rcdType int, required int, addresses int) (interfaces.IRCD, error) {
+	switch rcdType {
+	case 1:
+		return NewRCD_1(make([]byte, constants.ADDRESS_LENGTH)), nil
+	case 2:
+		if addresses < 0 || addresses > MaxRCD2Addresses {
+			return nil, fmt.Errorf("Too many addresses.  m = %d max = %d", addresses, MaxRCD2Addresses)
+		}
+		adrs := make([]interfaces.IAddress, addresses)
+		for i := range adrs {
+			adrs[i] = primitives.NewZeroHash()
+		}
+		return NewRCD_2(required, addresses, adrs)
+	}
+	return nil, fmt.Errorf("Unknown RCD type %d", rcdType)
+}
+
+// NewFeeEstimateTransaction builds an unsigned transaction with the given
+// number of inputs, outputs and EC outputs, for EstimateFee.  rcds gives
+// the RCD of each input; inputs past the end of rcds are RCD_1.  Amounts
+// are as large as they can be, so the estimate is never too low, and every
+// address is long enough to unmarshal without padding.
+func NewFeeEstimateTransaction(inputs, outputs, ecOutputs int, rcds []interfaces.IRCD) (*Transaction, error) {
+	for _, n := range []int{inputs, outputs, ecOutputs} {
+		if n < 0 || n > math.MaxUint8 {
+			return nil, fmt.Errorf("Counts must be between 0 and %d", math.MaxUint8)
+		}
+	}
+	if len(rcds) > inputs {
+		return nil, fmt.Errorf("%d RCDs for %d inputs", len(rcds), inputs)
+	}
+
+	t := new(Transaction)
+	for i := 0; i < inputs; i++ {
+		t.AddInput(primitives.NewZeroHash(), math.MaxInt64)
+	}
+	for i := 0; i < outputs; i++ {
+		t.AddOutput(primitives.NewZeroHash(), math.MaxInt64)
+	}
+	for i := 0; i < ecOutputs; i++ {
+		t.AddECOutput(primitives.NewZeroHash(), math.MaxInt64)
+	}
+	for _, rcd := range rcds {
+		t.AddRCD(rcd)
+	}
+	return t, nil
+}
+
+// UnmarshalFeeEstimateTransaction reads a transaction for EstimateFee,
+// either signed or only the part that gets signed.  The part that gets
+// signed can end in an address shorter than TransAddress.UnmarshalBinaryData
+// accepts at the end of its data, so it is padded before it is read.
+func UnmarshalFeeEstimateTransaction(data []byte) (*Transaction, error) {
+	t := new(Transaction)
+	if _, err := t.UnmarshalBinaryData(data); err == nil {
+		return t, nil
+	}
+	padded := make([]byte, len(data)+feeEstimatePadding)
+	copy(padded, data)
+	t = new(Transaction)
+	if _, err := t.UnmarshalBinarySigData(padded); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// feeEstimatePadding is how much shorter than 36 bytes an address can be:
+// a one byte amount and a 32 byte address
+const feeEstimatePadding = 36 - (1 + constants.ADDRESS_LENGTH)
+
+// newFeeEstimateSignatureBlock returns a signature block the size of the
+// one that will satisfy rcd.
+func newFeeEstimateSignatureBlock(rcd interfaces.IRCD) (*SignatureBlock, error) {
+	switch r := rcd.(type) {
+	case *RCD_1:
+		return NewSignatureBlockForRCD(r), nil
+	case *RCD_2:
+		sigs := make([]*RCD2Signature, r.N)
+		for i := range sigs {
+			signer := NewRCD_1(make([]byte, constants.ADDRESS_LENGTH))
+			sigs[i] = NewRCD2Signature(i, signer, NewSignatureBlockForRCD(signer))
+		}
+		return NewRCD2SignatureBlock(sigs...), nil
+	}
+	return nil, fmt.Errorf("Cannot estimate the signatures for RCD type %T", rcd)
+}
@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// feeTrans builds an unsigned transaction from key 0 with an output and an
+// EC output
+func feeTrans() *Transaction {
+	tx := new(Transaction)
+	tx.AddInput(testHelper.NewFactoidAddress(0), 5000)
+	tx.AddOutput(testHelper.NewFactoidAddress(1), 1000)
+	tx.AddECOutput(testHelper.NewECAddress(2), 1000)
+	return tx
+}
+
+func TestCalculateFeeBreakdown(t *testing.T) {
+	tx := feeTrans()
+	testHelper.SignFactoidTransaction(0, tx)
+
+	b, err := tx.CalculateFeeBreakdown(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	fee, err := tx.CalculateFee(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if b.Fee != fee {
+		t.Errorf("Breakdown fee %d, CalculateFee %d", b.Fee, fee)
+	}
+	if b.SizeFee != 1000 || b.OutputFee != 10000 || b.ECOutputFee != 10000 || b.InputFee != 1000 || b.Signatures != 1 {
+		t.Errorf("Bad breakdown %+v", b)
+	}
+	data, _ := tx.MarshalBinary()
+	if b.Size != len(data) {
+		t.Errorf("Size %d, expected %d", b.Size, len(data))
+	}
+}
+
+func TestEstimateFeeUnsigned(t *testing.T) {
+	signed := feeTrans()
+	testHelper.SignFactoidTransaction(0, signed)
+	expected, err := signed.CalculateFeeBreakdown(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Only the signed part, as a wallet would have it before signing
+	data, err := feeTrans().MarshalBinarySig()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	// The EC output of 1000 ends the data 2 bytes short of an address
+	if _, err := new(Transaction).UnmarshalBinarySigData(data); err == nil {
+		t.Fatalf("Unpadded data unmarshalled")
+	}
+	unsigned, err := UnmarshalFeeEstimateTransaction(data)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(unsigned.Inputs) != 1 || len(unsigned.RCDs) != 0 {
+		t.Fatalf("Bad unsigned transaction %v", unsigned)
+	}
+
+	b, err := unsigned.EstimateFee(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if *b != *expected {
+		t.Errorf("Estimated %+v, signed %+v", b, expected)
+	}
+	if len(unsigned.RCDs) != 0 || len(unsigned.SigBlocks) != 0 {
+		t.Errorf("EstimateFee changed the transaction")
+	}
+
+	// A signed transaction estimates to its own fee
+	b, err = signed.EstimateFee(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if *b != *expected {
+		t.Errorf("Estimated %+v, signed %+v", b, expected)
+	}
+}
+
+func TestEstimateFeeRCD2(t *testing.T) {
+	rcd := rcd2Keys(2, 1, 2, 3)
+	signed := rcd2Trans(rcd)
+	data, err := signed.MarshalBinarySig()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	signed.SetSignatureBlock(0, NewRCD2SignatureBlock(keySig(0, 1, data), keySig(2, 3, data)))
+	expected, err := signed.CalculateFeeBreakdown(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	b, err := rcd2Trans(rcd).EstimateFee(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if *b != *expected {
+		t.Errorf("Estimated %+v, signed %+v", b, expected)
+	}
+	if b.Signatures != 2 {
+		t.Errorf("Expected 2 signatures, got %d", b.Signatures)
+	}
+
+	// The same from counts
+	est, err := NewFeeEstimateRCD(2, 2, 3)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	counted, err := NewFeeEstimateTransaction(1, 1, 0, []interfaces.IRCD{est})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	b, err = counted.EstimateFee(1000)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if b.Fee < expected.Fee || b.Signatures != expected.Signatures || b.Size < expected.Size {
+		t.Errorf("Estimated %+v from counts, signed %+v", b, expected)
+	}
+}
+
+func TestNewFeeEstimateTransaction(t *testing.T) {
+	tx, err := NewFeeEstimateTransaction(2, 3, 1, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	b, err := tx.EstimateFee(10)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if b.Signatures != 2 || b.OutputFee != 300 || b.ECOutputFee != 100 || b.InputFee != 20 {
+		t.Errorf("Bad breakdown %+v", b)
+	}
+
+	// Unmarshals under the normal rules, with no padding
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if _, err := new(Transaction).UnmarshalBinarySigData(data); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	if _, err := NewFeeEstimateTransaction(256, 0, 0, nil); err == nil {
+		t.Errorf("Expected too many inputs to fail")
+	}
+	if _, err := NewFeeEstimateTransaction(-1, 0, 0, nil); err == nil {
+		t.Errorf("Expected negative inputs to fail")
+	}
+	rcd, _ := NewFeeEstimateRCD(1, 0, 0)
+	if _, err := NewFeeEstimateTransaction(0, 1, 0, []interfaces.IRCD{rcd}); err == nil {
+		t.Errorf("Expected more RCDs than inputs to fail")
+	}
+	if _, err := NewFeeEstimateRCD(3, 0, 0); err == nil {
+		t.Errorf("Expected an unknown RCD type to fail")
+	}
+	if _, err := NewFeeEstimateRCD(2, 3, 2); err == nil {
+		t.Errorf("Expected more required signatures than addresses to fail")
+	}
+}
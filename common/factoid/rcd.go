@@ -52,12 +52,21 @@ func NewRCD_2(n int, m int, addresses []interfaces.IAddress) (interfaces.IRCD, e
 	if len(addresses) != m {
 		return nil, fmt.Errorf("Improper number of addresses.  m = %d n = %d #addresses = %d", m, n, len(addresses))
 	}
+	if n < 1 || n > m {
+		return nil, fmt.Errorf("Improper number of signatures.  n = %d must be between 1 and m = %d", n, m)
+	}
+	if m > MaxRCD2Addresses {
+		return nil, fmt.Errorf("Too many addresses.  m = %d max = %d", m, MaxRCD2Addresses)
+	}
 
 	au := new(RCD_2)
 	au.N = n
 	au.M = m
 	au.N_Addresses = make([]interfaces.IAddress, len(addresses), len(addresses))
 	copy(au.N_Addresses, addresses)
+	if err := au.validate(); err != nil {
+		return nil, err
+	}
 
 	return au, nil
 }
@@ -7,8 +7,10 @@ package factoid
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
+	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
@@ -18,59 +20,167 @@ import (
  ************************/
 
 // Type 2 RCD implement multisig
-// m of n
+// n of m
 // Must have m addresses from which to choose, no fewer, no more
-// Must have n RCD, no fewer no more.
+// Must have n signatures, no fewer no more.
 // NOTE: This does mean you can have a multisig nested in a
 // multisig.  It just works.
+//
+// Binary layout:
+//   0x02 | N (uint16) | M (uint16) | M 32 byte addresses
+//
+// The address of an RCD_2 is the double sha256 of its binary form, just as
+// for an RCD_1.  Each of the M addresses is itself the address of an RCD,
+// which may be an RCD_1 or another RCD_2.
+//
+// The signature block for an RCD_2 holds exactly N RCD2Signatures, each
+// revealing the RCD behind one of the M addresses along with the signature
+// block that satisfies it.  Each address may be used at most once.
+
+const (
+	MaxRCD2Addresses = 256 // Most addresses an RCD_2 may list
+	MaxRCD2Depth     = 4   // Most levels of RCD_2 nested inside each other
+)
 
 type RCD_2 struct {
-	M           int                   // Number signatures required
-	N           int                   // Total sigatures possible
-	N_Addresses []interfaces.IAddress // n addresses
+	N           int                   // Number signatures required
+	M           int                   // Total signatures possible (number of addresses)
+	N_Addresses []interfaces.IAddress // m addresses
 }
 
 var _ interfaces.IRCD = (*RCD_2)(nil)
 
-/*************************************
- *       Stubs
- *************************************/
+/***************************************
+ *       Methods
+ ***************************************/
 
 func (b RCD_2) GetAddress() (interfaces.IAddress, error) {
-	return nil, nil
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return CreateAddress(primitives.Shad(data)), nil
 }
 
+// NumberOfSignatures is the number of signatures charged for in the fee.
+// Nested RCD_2s are paid for by the size of the transaction.
 func (b RCD_2) NumberOfSignatures() int {
-	return 1
+	return b.N
 }
 
-/***************************************
- *       Methods
- ***************************************/
-
 func (b RCD_2) IsSameAs(rcd interfaces.IRCD) bool {
 	return b.String() == rcd.String()
 }
 
-func (b RCD_2) UnmarshalBinary(data []byte) error {
+func (b *RCD_2) UnmarshalBinary(data []byte) error {
 	_, err := b.UnmarshalBinaryData(data)
 	return err
 }
 
 func (b RCD_2) CheckSig(trans interfaces.ITransaction, sigblk interfaces.ISignatureBlock) bool {
-	return false
+	return b.checkSig(trans, sigblk, 1) == nil
+}
+
+// CheckSigError is CheckSig, but explains why the signatures do not satisfy
+// the RCD.
+func (b RCD_2) CheckSigError(trans interfaces.ITransaction, sigblk interfaces.ISignatureBlock) error {
+	return b.checkSig(trans, sigblk, 1)
+}
+
+func (b RCD_2) checkSig(trans interfaces.ITransaction, sigblk interfaces.ISignatureBlock, depth int) error {
+	if depth > MaxRCD2Depth {
+		return fmt.Errorf("RCD_2 nested more than %d deep", MaxRCD2Depth)
+	}
+	if err := b.validate(); err != nil {
+		return err
+	}
+	if sigblk == nil {
+		return fmt.Errorf("No signature block")
+	}
+	sigs := sigblk.GetSignatures()
+	if len(sigs) != b.N {
+		return fmt.Errorf("Have %d signatures, need %d", len(sigs), b.N)
+	}
+
+	used := make(map[int]bool)
+	for i, sig := range sigs {
+		msig, ok := sig.(*RCD2Signature)
+		if !ok || msig.RCD == nil || msig.Block == nil {
+			return fmt.Errorf("Signature %d is not a multisig signature", i)
+		}
+		idx := int(msig.Index)
+		if idx >= b.M {
+			return fmt.Errorf("Signature %d is for address %d, only %d addresses", i, idx, b.M)
+		}
+		if used[idx] {
+			return fmt.Errorf("Address %d is signed more than once", idx)
+		}
+		used[idx] = true
+
+		address, err := msig.RCD.GetAddress()
+		if err != nil {
+			return fmt.Errorf("Signature %d: %s", i, err.Error())
+		}
+		if !address.IsSameAs(b.N_Addresses[idx]) {
+			return fmt.Errorf("Signature %d reveals an RCD that does not match address %d", i, idx)
+		}
+
+		switch nested := msig.RCD.(type) {
+		case *RCD_2:
+			if err := nested.checkSig(trans, msig.Block, depth+1); err != nil {
+				return fmt.Errorf("Signature %d: %s", i, err.Error())
+			}
+		default:
+			if !nested.CheckSig(trans, msig.Block) {
+				return fmt.Errorf("Signature %d is not valid", i)
+			}
+		}
+	}
+	return nil
+}
+
+func (b RCD_2) validate() error {
+	if b.N < 1 || b.N > b.M {
+		return fmt.Errorf("RCD_2 must require between 1 and %d signatures, requires %d", b.M, b.N)
+	}
+	if b.M > MaxRCD2Addresses {
+		return fmt.Errorf("RCD_2 has %d addresses, max %d", b.M, MaxRCD2Addresses)
+	}
+	if len(b.N_Addresses) != b.M {
+		return fmt.Errorf("RCD_2 has %d addresses, expected %d", len(b.N_Addresses), b.M)
+	}
+	// Signatures are counted by address index, so an address listed twice
+	// would let one signer fill two of the N
+	seen := make(map[[32]byte]bool, b.M)
+	for i, address := range b.N_Addresses {
+		if seen[address.Fixed()] {
+			return fmt.Errorf("RCD_2 lists address %d more than once", i)
+		}
+		seen[address.Fixed()] = true
+	}
+	return nil
 }
 
 func (e *RCD_2) JSONByte() ([]byte, error) {
 	return primitives.EncodeJSON(e)
 }
 
-// TODO: Fix Json marshaling of RCD_2. Right now the RCD type
-// is not included in the json marshal.
 func (e *RCD_2) JSONString() (string, error) {
 	return primitives.EncodeJSONString(e)
 }
 
+// MarshalJSON encodes the RCD as hex, type byte included, like RCD_1
+func (e *RCD_2) MarshalJSON() ([]byte, error) {
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fmt.Sprintf("%x", data))
+}
+
 func (b RCD_2) String() string {
 	txt, err := b.CustomMarshalText()
 	if err != nil {
@@ -103,6 +213,16 @@ func (t *RCD_2) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 	t.N, data = int(binary.BigEndian.Uint16(data[0:2])), data[2:]
 	t.M, data = int(binary.BigEndian.Uint16(data[0:2])), data[2:]
 
+	if t.M > MaxRCD2Addresses {
+		return nil, fmt.Errorf("RCD_2 has %d addresses, max %d", t.M, MaxRCD2Addresses)
+	}
+	if t.N < 1 || t.N > t.M {
+		return nil, fmt.Errorf("RCD_2 must require between 1 and %d signatures, requires %d", t.M, t.N)
+	}
+	if len(data) < t.M*constants.ADDRESS_LENGTH {
+		return nil, fmt.Errorf("Not enough data to unmarshal %d addresses", t.M)
+	}
+
 	t.N_Addresses = make([]interfaces.IAddress, t.M, t.M)
 
 	for i, _ := range t.N_Addresses {
@@ -119,6 +239,10 @@ func (t *RCD_2) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 func (a RCD_2) MarshalBinary() ([]byte, error) {
 	var out primitives.Buffer
 
+	if len(a.N_Addresses) != a.M {
+		return nil, fmt.Errorf("RCD_2 has %d addresses, expected %d", len(a.N_Addresses), a.M)
+	}
+
 	binary.Write(&out, binary.BigEndian, uint8(2))
 	binary.Write(&out, binary.BigEndian, uint16(a.N))
 	binary.Write(&out, binary.BigEndian, uint16(a.M))
@@ -136,6 +260,10 @@ func (a RCD_2) MarshalBinary() ([]byte, error) {
 func (a RCD_2) CustomMarshalText() ([]byte, error) {
 	var out primitives.Buffer
 
+	if len(a.N_Addresses) < a.M {
+		return nil, fmt.Errorf("RCD_2 has %d addresses, expected %d", len(a.N_Addresses), a.M)
+	}
+
 	primitives.WriteNumber8(&out, uint8(2)) // Type 2 Authorization
 	out.WriteString("\n n: ")
 	primitives.WriteNumber16(&out, uint16(a.N))
@@ -5,11 +5,14 @@
 package factoid_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
 	. "github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
 )
 
 func TestUnmarshalNilRCD_2(t *testing.T) {
@@ -108,3 +111,273 @@ func nextAuth2_rcd2() *RCD_2 {
 	rcd, _ := NewRCD_2(n, m, addresses)
 	return rcd.(*RCD_2)
 }
+
+func TestNewRCD2Bounds(t *testing.T) {
+	addresses := []interfaces.IAddress{nextAddress(), nextAddress()}
+	if _, err := NewRCD_2(0, 2, addresses); err == nil {
+		t.Error("Allowed a 0 of 2 multisig")
+	}
+	if _, err := NewRCD_2(3, 2, addresses); err == nil {
+		t.Error("Allowed a 3 of 2 multisig")
+	}
+	if _, err := NewRCD_2(1, 3, addresses); err == nil {
+		t.Error("Allowed m to differ from the number of addresses")
+	}
+	if _, err := NewRCD_2(2, 2, addresses); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewRCD_2(2, 2, []interfaces.IAddress{addresses[0], addresses[0]}); err == nil {
+		t.Error("Allowed an address listed twice")
+	}
+}
+
+func TestRCD2DuplicateAddress(t *testing.T) {
+	// One key listed twice must not satisfy a 2 of 2
+	rcd := &RCD_2{N: 2, M: 2, N_Addresses: []interfaces.IAddress{testHelper.NewFactoidAddress(0), testHelper.NewFactoidAddress(0)}}
+	if _, err := rcd.GetAddress(); err == nil {
+		t.Error("An RCD_2 listing an address twice has an address")
+	}
+
+	tx := new(Transaction)
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sb := NewRCD2SignatureBlock(keySig(0, 0, data), keySig(1, 0, data))
+	if err := rcd.CheckSigError(tx, sb); err == nil {
+		t.Error("One signer filled two signatures")
+	}
+}
+
+func TestRCD2GetAddress(t *testing.T) {
+	rcd := nextAuth2_rcd2()
+	address, err := rcd.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rcd.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !address.IsSameAs(primitives.Shad(data)) {
+		t.Error("Address is not the double sha256 of the RCD")
+	}
+	for _, a := range rcd.N_Addresses {
+		if a.IsSameAs(address) {
+			t.Error("Multisig address matches one of its keys")
+		}
+	}
+	if rcd.NumberOfSignatures() != rcd.N {
+		t.Errorf("Expected %d signatures, got %d", rcd.N, rcd.NumberOfSignatures())
+	}
+}
+
+// rcd2Keys builds a multisig over RCD_1 keys n..n+len-1
+func rcd2Keys(required int, keys ...uint64) *RCD_2 {
+	addresses := make([]interfaces.IAddress, len(keys))
+	for i, k := range keys {
+		addresses[i] = testHelper.NewFactoidAddress(k)
+	}
+	rcd, err := NewRCD_2(required, len(keys), addresses)
+	if err != nil {
+		panic(err)
+	}
+	return rcd.(*RCD_2)
+}
+
+// rcd2Trans builds a transaction spending from the given RCD
+func rcd2Trans(rcd interfaces.IRCD) *Transaction {
+	address, err := rcd.GetAddress()
+	if err != nil {
+		panic(err)
+	}
+	tx := new(Transaction)
+	tx.AddInput(address, 1000)
+	tx.AddOutput(testHelper.NewFactoidAddress(100), 900)
+	tx.AddAuthorization(rcd)
+	return tx
+}
+
+// keySig signs data with key n, for address index of an RCD_2
+func keySig(index int, n uint64, data []byte) *RCD2Signature {
+	return NewRCD2Signature(index, testHelper.NewFactoidRCDAddress(n), NewSingleSignatureBlock(testHelper.NewPrivKey(n), data))
+}
+
+func TestRCD2CheckSig(t *testing.T) {
+	rcd := rcd2Keys(2, 0, 1, 2)
+	tx := rcd2Trans(rcd)
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Validate(1); err != nil {
+		t.Fatal(err)
+	}
+
+	good := NewRCD2SignatureBlock(keySig(0, 0, data), keySig(2, 2, data))
+	tx.SetSignatureBlock(0, good)
+	if err := tx.ValidateSignatures(); err != nil {
+		t.Fatalf("Valid 2 of 3 failed: %v", err)
+	}
+
+	// Round trip through the wire format
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2 := new(Transaction)
+	rest, err := tx2.UnmarshalBinaryData(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 {
+		t.Errorf("%d bytes left over", len(rest))
+	}
+	if !tx.IsSameAs(tx2) {
+		t.Error("Transaction changed in the round trip")
+	}
+	if err := tx2.ValidateSignatures(); err != nil {
+		t.Errorf("Unmarshalled 2 of 3 failed: %v", err)
+	}
+
+	other := []byte("some other data")
+	bad := map[string]*SignatureBlock{
+		"too few":      NewRCD2SignatureBlock(keySig(0, 0, data)),
+		"too many":     NewRCD2SignatureBlock(keySig(0, 0, data), keySig(1, 1, data), keySig(2, 2, data)),
+		"same address": NewRCD2SignatureBlock(keySig(0, 0, data), keySig(0, 0, data)),
+		"wrong key":    NewRCD2SignatureBlock(keySig(0, 0, data), keySig(1, 2, data)),
+		"bad index":    NewRCD2SignatureBlock(keySig(0, 0, data), keySig(3, 2, data)),
+		"wrong data":   NewRCD2SignatureBlock(keySig(0, 0, data), keySig(2, 2, other)),
+		"not multisig": NewSingleSignatureBlock(testHelper.NewPrivKey(0), data),
+	}
+	for name, sb := range bad {
+		if err := rcd.CheckSigError(tx, sb); err == nil {
+			t.Errorf("%s: signatures accepted", name)
+		}
+		if rcd.CheckSig(tx, sb) {
+			t.Errorf("%s: CheckSig returned true", name)
+		}
+	}
+}
+
+func TestRCD2Nested(t *testing.T) {
+	inner := rcd2Keys(2, 3, 4)
+	innerAddress, err := inner.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outerRCD, err := NewRCD_2(1, 2, []interfaces.IAddress{innerAddress, testHelper.NewFactoidAddress(5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outer := outerRCD.(*RCD_2)
+
+	tx := rcd2Trans(outer)
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	innerBlock := NewRCD2SignatureBlock(keySig(0, 3, data), keySig(1, 4, data))
+	tx.SetSignatureBlock(0, NewRCD2SignatureBlock(NewRCD2Signature(0, inner, innerBlock)))
+	if err := tx.ValidateSignatures(); err != nil {
+		t.Fatalf("Nested multisig failed: %v", err)
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2 := new(Transaction)
+	if err := tx2.UnmarshalBinary(raw); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx2.ValidateSignatures(); err != nil {
+		t.Errorf("Unmarshalled nested multisig failed: %v", err)
+	}
+
+	// Half of the inner multisig is not enough
+	innerBlock = NewRCD2SignatureBlock(keySig(0, 3, data))
+	tx.SetSignatureBlock(0, NewRCD2SignatureBlock(NewRCD2Signature(0, inner, innerBlock)))
+	if err := tx.ValidateSignatures(); err == nil {
+		t.Error("Accepted an incomplete nested multisig")
+	}
+
+	// The single key works on its own
+	tx.SetSignatureBlock(0, NewRCD2SignatureBlock(keySig(1, 5, data)))
+	if err := tx.ValidateSignatures(); err != nil {
+		t.Errorf("Single key of the 1 of 2 failed: %v", err)
+	}
+}
+
+func TestRCD2MaxDepth(t *testing.T) {
+	var rcd interfaces.IRCD = testHelper.NewFactoidRCDAddress(6)
+	var levels []*RCD_2
+	for i := 0; i <= MaxRCD2Depth; i++ {
+		address, err := rcd.GetAddress()
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := NewRCD_2(1, 1, []interfaces.IAddress{address})
+		if err != nil {
+			t.Fatal(err)
+		}
+		levels = append(levels, r.(*RCD_2))
+		rcd = r
+	}
+
+	tx := rcd2Trans(rcd)
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := NewRCD2SignatureBlock(keySig(0, 6, data))
+	for i := 1; i < len(levels); i++ {
+		block = NewRCD2SignatureBlock(NewRCD2Signature(0, levels[i-1], block))
+	}
+	if err := levels[len(levels)-1].CheckSigError(tx, block); err == nil {
+		t.Errorf("Accepted a multisig nested %d deep", len(levels))
+	}
+
+	tx.SetSignatureBlock(0, block)
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := new(Transaction).UnmarshalBinary(raw); err == nil {
+		t.Errorf("Unmarshalled a multisig nested %d deep", len(levels))
+	}
+}
+
+func TestRCD2UnmarshalShortSignatures(t *testing.T) {
+	tx := rcd2Trans(rcd2Keys(3, 0, 1, 2))
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2 := new(Transaction)
+	if err := tx2.UnmarshalBinary(raw); err != nil {
+		t.Fatalf("Unsigned multisig transaction failed to unmarshal: %v", err)
+	}
+	if !tx2.IsSameAs(tx) {
+		t.Error("Unsigned transaction changed in the round trip")
+	}
+
+	// Too little data left for 3 signatures
+	if err := new(Transaction).UnmarshalBinary(raw[:len(raw)-200]); err == nil {
+		t.Error("Unmarshalled signatures from too little data")
+	}
+}
+
+func TestRCD2JSON(t *testing.T) {
+	rcd := nextAuth2_rcd2()
+	js, err := rcd.JSONString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := rcd.MarshalBinary()
+	if js != fmt.Sprintf("%q", fmt.Sprintf("%x", data)) {
+		t.Errorf("Unexpected JSON %s", js)
+	}
+}
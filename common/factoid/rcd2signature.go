@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// minRCD2SignatureSize is the smallest an RCD2Signature can be: its index,
+// an RCD_1 and the RCD_1's signature.
+const minRCD2SignatureSize = 2 + 1 + constants.ADDRESS_LENGTH + constants.SIGNATURE_LENGTH
+
+// RCD2Signature is one of the N signatures in the signature block of an
+// RCD_2.  It reveals the RCD behind one of the RCD_2's addresses, and
+// carries the signature block that satisfies that RCD.
+//
+// Binary layout:
+//
+//	Index (uint16) | RCD | signature block for the RCD
+type RCD2Signature struct {
+	Index uint16          `json:"index"` // Which of the RCD_2 addresses this signs for
+	RCD   interfaces.IRCD `json:"rcd"`
+	Block *SignatureBlock `json:"block"`
+}
+
+var _ interfaces.ISignature = (*RCD2Signature)(nil)
+
+// NewRCD2Signature signs for address index of an RCD_2 with the given RCD
+// and the signature block that satisfies it.
+func NewRCD2Signature(index int, rcd interfaces.IRCD, block *SignatureBlock) *RCD2Signature {
+	s := new(RCD2Signature)
+	s.Index = uint16(index)
+	s.RCD = rcd
+	s.Block = block
+	return s
+}
+
+func (s *RCD2Signature) IsSameAs(sig interfaces.ISignature) bool {
+	if sig == nil {
+		return false
+	}
+	return primitives.AreBytesEqual(s.Bytes(), sig.Bytes())
+}
+
+// SetSignature does not apply to a multisig signature; set the signatures
+// of the nested block instead.
+func (s *RCD2Signature) SetSignature(sig []byte) error {
+	return fmt.Errorf("Cannot set the signature of an RCD_2 signature directly")
+}
+
+// GetSignature returns nil, as there is no single ed25519 signature here
+func (s *RCD2Signature) GetSignature() *[64]byte {
+	return nil
+}
+
+func (s *RCD2Signature) Bytes() []byte {
+	data, err := s.MarshalBinary()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (s *RCD2Signature) JSONByte() ([]byte, error) {
+	return primitives.EncodeJSON(s)
+}
+
+func (s *RCD2Signature) JSONString() (string, error) {
+	return primitives.EncodeJSONString(s)
+}
+
+func (s RCD2Signature) String() string {
+	txt, err := s.CustomMarshalText()
+	if err != nil {
+		return "<error>"
+	}
+	return string(txt)
+}
+
+// MarshalBinary writes a signature not yet filled in as that of an RCD_1
+// with a zero public key, as an empty SignatureBlock writes a zero
+// FactoidSignature, so unsigned transactions still marshal.
+func (s RCD2Signature) MarshalBinary() ([]byte, error) {
+	rcd, block := s.RCD, s.Block
+	if rcd == nil {
+		rcd = new(RCD_1)
+	}
+	if block == nil {
+		block = NewSignatureBlockForRCD(rcd)
+	}
+	buf := primitives.NewBuffer(nil)
+	err := buf.PushUInt16(s.Index)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.PushBinaryMarshallable(rcd)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.PushBinaryMarshallable(block)
+	if err != nil {
+		return nil, err
+	}
+	return buf.DeepCopyBytes(), nil
+}
+
+func (s *RCD2Signature) UnmarshalBinaryData(data []byte) ([]byte, error) {
+	return s.unmarshalBinaryData(data, 1)
+}
+
+// unmarshalBinaryData reads a signature in the block of an RCD_2 nested
+// depth deep, refusing RCD_2s nested deeper than MaxRCD2Depth.
+func (s *RCD2Signature) unmarshalBinaryData(data []byte, depth int) ([]byte, error) {
+	if len(data) < minRCD2SignatureSize {
+		return nil, fmt.Errorf("Not enough data to unmarshal")
+	}
+	buf := primitives.NewBuffer(data)
+	index, err := buf.PopUInt16()
+	if err != nil {
+		return nil, err
+	}
+	s.Index = index
+
+	rcd, rest, err := UnmarshalBinaryAuth(buf.DeepCopyBytes())
+	if err != nil {
+		return nil, err
+	}
+	s.RCD = rcd
+	if _, ok := rcd.(*RCD_2); ok && depth >= MaxRCD2Depth {
+		return nil, fmt.Errorf("RCD_2 nested more than %d deep", MaxRCD2Depth)
+	}
+
+	s.Block, err = newSignatureBlockForData(rcd, rest)
+	if err != nil {
+		return nil, err
+	}
+	return s.Block.unmarshalBinaryData(rest, depth+1)
+}
+
+func (s *RCD2Signature) UnmarshalBinary(data []byte) error {
+	_, err := s.UnmarshalBinaryData(data)
+	return err
+}
+
+func (s RCD2Signature) CustomMarshalText() ([]byte, error) {
+	var out primitives.Buffer
+
+	out.WriteString(" RCD2Signature: ")
+	primitives.WriteNumber16(&out, s.Index)
+	out.WriteString(" ")
+	if s.RCD != nil {
+		data, err := s.RCD.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(hex.EncodeToString(data))
+	}
+	out.WriteString("\n")
+	if s.Block != nil {
+		txt, err := s.Block.CustomMarshalText()
+		if err != nil {
+			return nil, err
+		}
+		out.Write(txt)
+	}
+
+	return out.DeepCopyBytes(), nil
+}
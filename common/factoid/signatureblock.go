@@ -5,6 +5,8 @@
 package factoid
 
 import (
+	"fmt"
+
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
@@ -116,15 +118,65 @@ func (s SignatureBlock) CustomMarshalText() ([]byte, error) {
 	return out.DeepCopyBytes(), nil
 }
 
+// UnmarshalBinaryData reads one signature for each slot already in the
+// block (see NewSignatureBlockForRCD), or a single FactoidSignature if the
+// block is empty.
 func (s *SignatureBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
-	buf := primitives.NewBuffer(data)
-	s.Signatures = make([]interfaces.ISignature, 1)
-	s.Signatures[0] = new(FactoidSignature)
-	err := buf.PopBinaryMarshallable(s.Signatures[0])
-	if err != nil {
-		return nil, err
+	return s.unmarshalBinaryData(data, 1)
+}
+
+// unmarshalBinaryData reads the block of an RCD nested depth RCD_2s deep
+func (s *SignatureBlock) unmarshalBinaryData(data []byte, depth int) (newData []byte, err error) {
+	if len(s.Signatures) == 0 {
+		s.Signatures = make([]interfaces.ISignature, 1)
+		s.Signatures[0] = new(FactoidSignature)
 	}
-	return buf.DeepCopyBytes(), nil
+	for _, sig := range s.Signatures {
+		if msig, ok := sig.(*RCD2Signature); ok {
+			data, err = msig.unmarshalBinaryData(data, depth)
+		} else {
+			data, err = sig.UnmarshalBinaryData(data)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// NewSignatureBlockForRCD returns an empty signature block laid out to
+// receive the signatures the given RCD requires.
+func NewSignatureBlockForRCD(rcd interfaces.IRCD) *SignatureBlock {
+	s := new(SignatureBlock)
+	switch r := rcd.(type) {
+	case *RCD_2:
+		for i := 0; i < r.N && i < MaxRCD2Addresses; i++ {
+			s.Signatures = append(s.Signatures, new(RCD2Signature))
+		}
+	default:
+		s.Signatures = append(s.Signatures, new(FactoidSignature))
+	}
+	return s
+}
+
+// newSignatureBlockForData is NewSignatureBlockForRCD for a block about to be
+// unmarshalled from data, which must be long enough for the signatures the
+// RCD requires.
+func newSignatureBlockForData(rcd interfaces.IRCD, data []byte) (*SignatureBlock, error) {
+	if r, ok := rcd.(*RCD_2); ok && len(data) < r.N*minRCD2SignatureSize {
+		return nil, fmt.Errorf("Not enough data to unmarshal %d RCD_2 signatures", r.N)
+	}
+	return NewSignatureBlockForRCD(rcd), nil
+}
+
+// NewRCD2SignatureBlock builds the signature block for an RCD_2 from the
+// signatures of the N addresses being used.
+func NewRCD2SignatureBlock(sigs ...*RCD2Signature) *SignatureBlock {
+	s := new(SignatureBlock)
+	for _, sig := range sigs {
+		s.Signatures = append(s.Signatures, sig)
+	}
+	return s
 }
 
 func NewSingleSignatureBlock(priv, data []byte) *SignatureBlock {
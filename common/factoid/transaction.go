@@ -9,7 +9,6 @@ import (
 	"runtime/debug"
 	"time"
 
-	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
@@ -166,18 +165,27 @@ func (t *Transaction) SetTimestamp(ts interfaces.Timestamp) {
 
 func (t *Transaction) SetSignatureBlock(i int, sig interfaces.ISignatureBlock) {
 	for len(t.SigBlocks) <= i {
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+		t.SigBlocks = append(t.SigBlocks, t.emptySignatureBlock(len(t.SigBlocks)))
 	}
 	t.SigBlocks[i] = sig
 }
 
 func (t *Transaction) GetSignatureBlock(i int) interfaces.ISignatureBlock {
 	for len(t.SigBlocks) <= i {
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+		t.SigBlocks = append(t.SigBlocks, t.emptySignatureBlock(len(t.SigBlocks)))
 	}
 	return t.SigBlocks[i]
 }
 
+// emptySignatureBlock is the block to hold the signatures for input i until
+// they are added, laid out for the input's RCD if it has one.
+func (t *Transaction) emptySignatureBlock(i int) interfaces.ISignatureBlock {
+	if i < len(t.RCDs) {
+		return NewSignatureBlockForRCD(t.RCDs[i])
+	}
+	return new(SignatureBlock)
+}
+
 func (t *Transaction) AddRCD(rcd interfaces.IRCD) {
 	t.RCDs = append(t.RCDs, rcd)
 	t.clearCaches()
@@ -198,28 +206,11 @@ func (t *Transaction) AddRCD(rcd interfaces.IRCD) {
 //    all full nodes. A fee of 10 EC equivalent must be paid for each
 //    signature included.
 func (t Transaction) CalculateFee(factoshisPerEC uint64) (uint64, error) {
-	// First look at the size of the transaction, and make sure
-	// everything is inbounds.
-	data, err := t.MarshalBinary()
+	b, err := t.CalculateFeeBreakdown(factoshisPerEC)
 	if err != nil {
-		return 0, fmt.Errorf("Can't Marshal the Transaction")
+		return 0, err
 	}
-	if len(data) > constants.MAX_TRANSACTION_SIZE { // Can't be bigger than our limits
-		return 0, fmt.Errorf("Transaction is greater than the max transaction size")
-	}
-	// Okay, we know the transaction is mostly good. Let's calculate
-	// fees.
-	var fee uint64
-
-	fee = factoshisPerEC * uint64((len(data)+1023)/1024)
-
-	fee += factoshisPerEC * 10 * uint64(len(t.Outputs)+len(t.OutECs))
-
-	for _, rcd := range t.RCDs {
-		fee += factoshisPerEC * uint64(rcd.NumberOfSignatures())
-	}
-
-	return fee, nil
+	return b.Fee, nil
 }
 
 // Checks that the sum of the given amounts do not cross
@@ -391,7 +382,7 @@ func (t *Transaction) GetSignatureBlocks() []interfaces.ISignatureBlock {
 		return t.SigBlocks
 	}
 	for i := len(t.SigBlocks); i < len(t.Inputs); i++ { // If too short, then
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock)) // pad it with
+		t.SigBlocks = append(t.SigBlocks, t.emptySignatureBlock(i)) // pad it with
 	} // signature blocks.
 	return t.SigBlocks
 }
@@ -427,6 +418,43 @@ func (t *Transaction) GetRCD(i int) (interfaces.IRCD, error) {
 // UnmarshalBinary assumes that the Binary is all good.  We do error
 // out if there isn't enough data, or the transaction is too large.
 func (t *Transaction) UnmarshalBinaryData(data []byte) ([]byte, error) {
+	rest, err := t.UnmarshalBinarySigData(data)
+	if err != nil {
+		return nil, err
+	}
+	buf := primitives.NewBuffer(rest)
+
+	t.RCDs = make([]interfaces.IRCD, len(t.Inputs))
+	t.SigBlocks = make([]interfaces.ISignatureBlock, len(t.Inputs))
+
+	for i := 0; i < len(t.Inputs); i++ {
+		b, err := buf.PeekByte()
+		if err != nil {
+			return nil, err
+		}
+		t.RCDs[i] = CreateRCD([]byte{b})
+		err = buf.PopBinaryMarshallable(t.RCDs[i])
+		if err != nil {
+			return nil, err
+		}
+		sigBlock, err := newSignatureBlockForData(t.RCDs[i], buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		t.SigBlocks[i] = sigBlock
+		err = buf.PopBinaryMarshallable(t.SigBlocks[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.Txid = t.GetSigHash()
+	return buf.DeepCopyBytes(), nil
+}
+
+// UnmarshalBinarySigData reads the part of a transaction that is signed, as
+// written by MarshalBinarySig.  The RCDs and signature blocks are left empty.
+func (t *Transaction) UnmarshalBinarySigData(data []byte) ([]byte, error) {
 	buf := primitives.NewBuffer(data)
 
 	v, err := buf.PopVarInt()
@@ -489,27 +517,9 @@ func (t *Transaction) UnmarshalBinaryData(data []byte) ([]byte, error) {
 		t.OutECs[i].(*TransAddress).UserAddress = primitives.ConvertECAddressToUserStr(t.OutECs[i].(*TransAddress).Address)
 	}
 
-	t.RCDs = make([]interfaces.IRCD, len(t.Inputs))
-	t.SigBlocks = make([]interfaces.ISignatureBlock, len(t.Inputs))
+	t.RCDs = nil
+	t.SigBlocks = nil
 
-	for i := 0; i < len(t.Inputs); i++ {
-		b, err := buf.PeekByte()
-		if err != nil {
-			return nil, err
-		}
-		t.RCDs[i] = CreateRCD([]byte{b})
-		err = buf.PopBinaryMarshallable(t.RCDs[i])
-		if err != nil {
-			return nil, err
-		}
-		t.SigBlocks[i] = new(SignatureBlock)
-		err = buf.PopBinaryMarshallable(t.SigBlocks[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	t.Txid = t.GetSigHash()
 	return buf.DeepCopyBytes(), nil
 }
 
@@ -597,7 +607,7 @@ func (t Transaction) MarshalBinary() ([]byte, error) {
 		// we don't want to restrict what might be required to
 		// sign an input.
 		if len(t.SigBlocks) <= i {
-			t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+			t.SigBlocks = append(t.SigBlocks, t.emptySignatureBlock(len(t.SigBlocks)))
 		}
 		err = buf.PushBinaryMarshallable(t.SigBlocks[i])
 		if err != nil {
@@ -687,7 +697,7 @@ func (t *Transaction) CustomMarshalText() (text []byte, err error) {
 		out.Write(text)
 
 		for len(t.SigBlocks) <= i {
-			t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+			t.SigBlocks = append(t.SigBlocks, t.emptySignatureBlock(len(t.SigBlocks)))
 		}
 		text, err := t.SigBlocks[i].CustomMarshalText()
 		if err != nil {
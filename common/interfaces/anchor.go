@@ -9,6 +9,13 @@ import ()
 type IAnchor interface {
 	InitRPCClient() error
 	UpdateDirBlockInfoMap(dirBlockInfo IDirBlockInfo)
+
+	// GetAnchorStatus returns the anchor status of a directory block, or
+	// nil if the block has not been seen yet
+	GetAnchorStatus(dbHeight uint32) *AnchorStatus
+	// GetAnchorStatuses returns the statuses of the blocks start to end
+	// that have been seen
+	GetAnchorStatuses(start, end uint32) []*AnchorStatus
 }
 
 type IAnchorRecord interface {
@@ -16,3 +23,32 @@ type IAnchorRecord interface {
 	MarshalAndSign(priv Signer) ([]byte, error)
 	Unmarshal(data []byte) error
 }
+
+// Anchor statuses.  A directory block is pending until an anchor record for
+// it is found in an anchor chain, and confirmed once the anchoring
+// transaction is deep enough in its blockchain.
+const (
+	AnchorStatusPending   = "pending"
+	AnchorStatusAnchored  = "anchored"
+	AnchorStatusConfirmed = "confirmed"
+)
+
+// AnchorStatus is the anchoring of one directory block.  Status is the best
+// status of its targets.
+type AnchorStatus struct {
+	DBHeight uint32          `json:"dbheight"`
+	KeyMR    string          `json:"keymr"`
+	Status   string          `json:"status"`
+	Targets  []*AnchorTarget `json:"targets,omitempty"`
+}
+
+// AnchorTarget is the anchor of a directory block in one blockchain.
+type AnchorTarget struct {
+	Name          string `json:"name"` // bitcoin or ethereum
+	Status        string `json:"status"`
+	TxID          string `json:"txid"`
+	BlockHeight   int64  `json:"blockheight"`
+	BlockHash     string `json:"blockhash"`
+	Offset        int64  `json:"offset"`
+	Confirmations int64  `json:"confirmations"` // 0 if the depth is not known
+}
@@ -43,6 +43,8 @@ type IFBlock interface {
 	GetKeyMR() IHash
 	// Get the MR for the list of transactions
 	GetBodyMR() IHash
+	// Get the leaves of the BodyMR, for building Merkle branches
+	GetBodyHashes() []IHash
 	// Get the KeyMR of the previous block.
 	GetPrevKeyMR() IHash
 	SetPrevKeyMR(IHash)
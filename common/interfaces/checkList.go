@@ -0,0 +1,40 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// CheckList lists every check run on something, as the dry runs of the API
+// and the receipt verifier report them
+type CheckList struct {
+	Valid   bool     `json:"valid"`
+	Checks  []Check  `json:"checks"`
+	Reasons []string `json:"reasons,omitempty"` // Reasons of the failed checks
+}
+
+type Check struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// AddCheck records the outcome of one check.  A nil error passes.
+func (l *CheckList) AddCheck(name string, err error) {
+	c := Check{Name: name, Passed: err == nil}
+	if err != nil {
+		c.Reason = err.Error()
+		l.Reasons = append(l.Reasons, name+": "+c.Reason)
+	}
+	l.Checks = append(l.Checks, c)
+	l.Valid = len(l.Reasons) == 0
+}
+
+// Find returns the check called name, if it was run
+func (l *CheckList) Find(name string) (Check, bool) {
+	for _, c := range l.Checks {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Check{}, false
+}
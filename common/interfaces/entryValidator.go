@@ -0,0 +1,18 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// IEntryValidator checks the content of entries against the operator's rules
+// for their chains, see the entryValidation package
+type IEntryValidator interface {
+	Validate(entry IEBEntry) error
+}
+
+// IKeyStore holds the entry credit keys the API may pay with, see the
+// compose package
+type IKeyStore interface {
+	// PrivateKey returns the private key of an EC address
+	PrivateKey(address string) ([]byte, error)
+}
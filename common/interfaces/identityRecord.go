@@ -0,0 +1,78 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// IdentityRecord is everything this node knows of an identity, for the API
+type IdentityRecord struct {
+	ChainID              string            `json:"chainid"`
+	Status               string            `json:"status"`
+	IdentityRegistered   uint32            `json:"identityregistered"` // Heights, 0 if not yet
+	IdentityCreated      uint32            `json:"identitycreated"`
+	ManagementChainID    string            `json:"managementchainid"`
+	ManagementRegistered uint32            `json:"managementregistered"`
+	ManagementCreated    uint32            `json:"managementcreated"`
+	MatryoshkaHash       string            `json:"matryoshkahash"`
+	Keys                 []string          `json:"keys"` // Identity keys 1 to 4
+	SigningKey           string            `json:"signingkey"`
+	AnchorKeys           []AnchorKeyRecord `json:"anchorkeys"`
+
+	// The identity as an authority, nil if it is not one
+	Authority *AuthorityRecord `json:"authority,omitempty"`
+}
+
+// AuthorityRecord is a federated or audit server
+type AuthorityRecord struct {
+	ChainID           string              `json:"chainid"`
+	Status            string              `json:"status"`
+	ManagementChainID string              `json:"managementchainid,omitempty"`
+	MatryoshkaHash    string              `json:"matryoshkahash,omitempty"`
+	SigningKey        string              `json:"signingkey"`
+	AnchorKeys        []AnchorKeyRecord   `json:"anchorkeys"`
+	KeyHistory        []HistoricKeyRecord `json:"keyhistory"` // Signing keys replaced, oldest first
+}
+
+type AnchorKeyRecord struct {
+	BlockChain string `json:"blockchain"`
+	Level      byte   `json:"level"`
+	KeyType    byte   `json:"keytype"`
+	Key        string `json:"key"`
+}
+
+// HistoricKeyRecord is a signing key, and the height its replacement became active
+type HistoricKeyRecord struct {
+	ActiveDBHeight uint32 `json:"activedbheight"`
+	SigningKey     string `json:"signingkey"`
+}
+
+// AuthoritySet is the federated and audit servers as of a height, as the admin blocks up to and
+// including that height left them
+type AuthoritySet struct {
+	DBHeight  uint32             `json:"dbheight"`
+	Federated []*AuthorityRecord `json:"federated"`
+	Audit     []*AuthorityRecord `json:"audit"`
+
+	// The admin block entries changing one authority, when the set is asked for with its chain ID
+	Changes []AuthorityChange `json:"changes,omitempty"`
+}
+
+// Changes an admin block makes to the authority set
+const (
+	AuthorityAddFederated = "add-federated"
+	AuthorityAddAudit     = "add-audit"
+	AuthorityRemove       = "remove"
+	AuthoritySigningKey   = "signing-key"
+	AuthorityAnchorKey    = "btc-anchor-key"
+	AuthorityMatryoshka   = "matryoshka-hash"
+	AuthorityServerFault  = "server-fault"
+)
+
+type AuthorityChange struct {
+	DBHeight       uint32           `json:"dbheight"`                 // Of the admin block
+	ActiveDBHeight uint32           `json:"activedbheight,omitempty"` // When the entry names one
+	ChainID        string           `json:"chainid"`
+	Change         string           `json:"change"`
+	Value          string           `json:"value,omitempty"` // The key or hash, or the audit server of a fault
+	AnchorKey      *AnchorKeyRecord `json:"anchorkey,omitempty"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// LinkFaults are the faults injected on a simulated link, one way from one
+// node to another.  The zero value is a healthy link.
+type LinkFaults struct {
+	Cut       bool        `json:"cut,omitempty"`       // Drop everything, as a partition does
+	DropRate  int         `json:"droprate,omitempty"`  // Messages dropped per 1000
+	Latency   *Latency    `json:"latency,omitempty"`   // Time messages take, nil for none
+	Bandwidth int         `json:"bandwidth,omitempty"` // Bytes per second, 0 for no cap
+	Duplicate int         `json:"duplicate,omitempty"` // Messages sent twice per 1000
+	Reorder   int         `json:"reorder,omitempty"`   // Messages held back behind the next one per 1000
+	Drop      []MsgFilter `json:"drop,omitempty"`      // Messages always dropped
+}
+
+// Latency is the distribution of the milliseconds a message takes on a link.
+// Distribution is one of:
+//
+//	fixed        Mean
+//	uniform      Between Min and Max
+//	normal       Mean and StdDev, never under Min
+//	exponential  Min plus an exponential of mean Mean
+type Latency struct {
+	Distribution string `json:"distribution"`
+	Min          int64  `json:"min,omitempty"`
+	Max          int64  `json:"max,omitempty"`
+	Mean         int64  `json:"mean,omitempty"`
+	StdDev       int64  `json:"stddev,omitempty"`
+}
+
+// MsgFilter matches messages by their type name, as messages.MessageName
+// gives it (EOM, Ack, Commit Entry, ...), and the VM they are for.  So
+// {"type": "EOM", "vm": 2} matches the EOMs of VM 2.
+type MsgFilter struct {
+	Type string `json:"type"`
+	VM   *int   `json:"vm,omitempty"` // Any VM if nil
+}
+
+// LinkStatus is a simulated link and the faults injected on it
+type LinkStatus struct {
+	From   string     `json:"from"`
+	To     string     `json:"to"`
+	Faults LinkFaults `json:"faults"`
+}
+
+// ISimNetwork controls the links of a simulated network.  Nodes are given by
+// their index in the simulation, and -1 is every node.
+type ISimNetwork interface {
+	GetLinkFaults() []LinkStatus
+	SetLinkFaults(from, to int, faults LinkFaults) error
+	// Partition cuts the links between nodes of different groups.  When
+	// oneway, only links from a group to the groups after it are cut.
+	Partition(groups [][]int, oneway bool) error
+	// Heal removes the faults of every link
+	Heal()
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// LoadConfig is the mix of load a load generator submits, in items per
+// second.  Entries go into the chains the load made, so entries with no
+// chains start with one.
+type LoadConfig struct {
+	Node         int     `json:"node"`                   // The node the load is submitted to
+	Chains       float64 `json:"chains,omitempty"`       // New chains per second
+	Entries      float64 `json:"entries,omitempty"`      // Entries per second
+	Transactions float64 `json:"transactions,omitempty"` // Factoid transactions per second
+	Size         int     `json:"size,omitempty"`         // Bytes of content in each entry
+	Duration     int     `json:"duration,omitempty"`     // Seconds to run, 0 until stopped
+}
+
+// LoadReport is what a load generator has submitted, and how long it took to
+// be acknowledged by the leaders and to be included in a directory block
+type LoadReport struct {
+	Running      bool       `json:"running"`
+	Config       LoadConfig `json:"config"`
+	Seconds      int64      `json:"seconds"` // Seconds the load has run
+	Chains       LoadCount  `json:"chains"`
+	Entries      LoadCount  `json:"entries"`
+	Transactions LoadCount  `json:"transactions"`
+}
+
+// LoadCount counts one kind of load.  Latencies are in milliseconds.
+type LoadCount struct {
+	Submitted int         `json:"submitted"`
+	Failed    int         `json:"failed"` // Refused by the API
+	Acked     int         `json:"acked"`
+	InBlock   int         `json:"inblock"`
+	Ack       LoadLatency `json:"ack"`
+	Block     LoadLatency `json:"block"`
+}
+
+type LoadLatency struct {
+	Min  int64 `json:"min"`
+	Mean int64 `json:"mean"`
+	Max  int64 `json:"max"`
+}
+
+// ILoadGenerator submits load to a simulated network
+type ILoadGenerator interface {
+	StartLoad(config LoadConfig) error
+	StopLoad()
+	GetLoadReport() LoadReport
+}
@@ -0,0 +1,32 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// Lookup statuses of an entry or chain, from least to most settled
+const (
+	LookupNeverSeen      = "never-seen"      // Not known to this node
+	LookupHolding        = "holding"         // Waiting in holding, not acknowledged
+	LookupProcessList    = "process-list"    // Acknowledged, its block is not saved yet
+	LookupMissingLocally = "missing-locally" // In a saved block, but not synced to this node yet
+	LookupConfirmed      = "confirmed"       // In a saved block and the database
+)
+
+// LookupResult is where an entry or chain stands on this node.  Retry tells a
+// client whether the answer may still change, so "never-seen" from a node
+// that is behind is not taken for proof that the entry does not exist.
+type LookupResult struct {
+	Type     string `json:"type"` // entry or chain
+	Hash     string `json:"hash"` // Entry hash or chain ID
+	Status   string `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	DBHeight uint32 `json:"dbheight,omitempty"` // Of the block holding it, for process-list and later
+	Retry    bool   `json:"retry"`
+
+	// The sync state of the node
+	Synced                bool   `json:"synced"`
+	DBHeightComplete      uint32 `json:"dbheightcomplete"`
+	EntryDBHeightComplete uint32 `json:"entrydbheightcomplete"`
+	MissingEntries        int    `json:"missingentries"` // Queued to be fetched from peers
+}
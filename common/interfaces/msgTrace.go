@@ -0,0 +1,20 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// MsgTraceStamp records when a message reached one stage of the node pipeline
+type MsgTraceStamp struct {
+	Stage string `json:"stage"`
+	Nanos int64  `json:"nanos"` // Nanoseconds since the message was first seen
+}
+
+// MsgTrace is the path of a single message through the node pipeline
+type MsgTrace struct {
+	MsgHash  string          `json:"msghash"`
+	Type     string          `json:"type"`
+	Start    int64           `json:"start"` // Unix time in nanoseconds when the message was first seen
+	Complete bool            `json:"complete"`
+	Stamps   []MsgTraceStamp `json:"stamps"`
+}
@@ -0,0 +1,143 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// INodeStatusReporter returns the status of each node it runs
+type INodeStatusReporter interface {
+	GetNodeStatus() []*NodeStatus
+}
+
+// NodeStatus is the structured form of the console summary (printSummary and
+// State.ShortString) for a single node.
+type NodeStatus struct {
+	NodeName        string `json:"nodename"`
+	IdentityChainID string `json:"identitychainid"`
+	Role            string `json:"role"` // leader, audit or follower
+	VM              int    `json:"vm"`   // VM this node leads this minute, -1 if none
+	Negotiator      bool   `json:"negotiator"`
+	NetStateOff     bool   `json:"netstateoff"`
+	RunLeader       bool   `json:"runleader"`
+	IgnoreMissing   bool   `json:"ignoremissing"`
+
+	Heights     NodeStatusHeights     `json:"heights"`
+	ProcessList NodeStatusProcessList `json:"processlist"`
+	Queues      NodeStatusQueues      `json:"queues"`
+	Sync        NodeStatusSync        `json:"sync"`
+	Faults      NodeStatusFaults      `json:"faults"`
+	Counters    NodeStatusCounters    `json:"counters"`
+	Network     NodeStatusNetwork     `json:"network"`
+	Authorities []NodeStatusAuthority `json:"authorities"`
+	BalanceHash string                `json:"balancehash"`
+}
+
+type NodeStatusHeights struct {
+	DirectoryBlock   uint32 `json:"directoryblock"` // Highest saved
+	DirectoryBlockMR string `json:"directoryblockkeymr"`
+	Leader           uint32 `json:"leader"`
+	HighestKnown     uint32 `json:"highestknown"`
+	HighestAck       uint32 `json:"highestack"`
+	ProcessListBase  uint32 `json:"processlistbase"`
+	ProcessListProc  uint32 `json:"processlistprocess"`
+	ProcessListTop   uint32 `json:"processlisttop"`
+	CurrentMinute    int    `json:"currentminute"`
+	LeaderMinute     int    `json:"leaderminute"` // -1 if this node leads no VM
+}
+
+type NodeStatusVM struct {
+	VM           int    `json:"vm"`
+	FedServer    string `json:"fedserver"` // Identity of the federated server serving this VM this minute
+	Height       int    `json:"height"`    // Messages processed
+	ListLength   int    `json:"listlength"`
+	LeaderMinute int    `json:"leaderminute"`
+	Synced       bool   `json:"synced"`
+	Faulted      bool   `json:"faulted"`
+}
+
+type NodeStatusProcessList struct {
+	DBHeight       uint32         `json:"dbheight"`
+	VMs            []NodeStatusVM `json:"vms"`
+	PendingEBlocks int            `json:"pendingeblocks"`
+	PendingEntries int            `json:"pendingentries"`
+}
+
+type NodeStatusQueues struct {
+	MsgQueue               int `json:"msgqueue"`
+	InMsgQueue             int `json:"inmsgqueue"`
+	APIQueue               int `json:"apiqueue"`
+	AckQueue               int `json:"ackqueue"`
+	TimerMsgQueue          int `json:"timermsgqueue"`
+	NetworkOutMsgQueue     int `json:"networkoutmsgqueue"`
+	NetworkInvalidMsgQueue int `json:"networkinvalidmsgqueue"`
+	Holding                int `json:"holding"`
+	Acks                   int `json:"acks"`
+	Commits                int `json:"commits"`
+	Review                 int `json:"review"`
+	UpdateEntryHash        int `json:"updateentryhash"`
+	MissingEntries         int `json:"missingentries"`
+	WriteEntry             int `json:"writeentry"`
+}
+
+type NodeStatusSync struct {
+	BlockGap                     uint32 `json:"blockgap"` // Highest known minus highest saved
+	EntryBlockDBHeightComplete   uint32 `json:"entryblockdbheightcomplete"`
+	EntryBlockDBHeightProcessing uint32 `json:"entryblockdbheightprocessing"`
+	EntryDBHeightComplete        uint32 `json:"entrydbheightcomplete"`
+	EntrySyncGap                 uint32 `json:"entrysyncgap"` // Highest saved minus entries complete
+	MissingEntryCount            uint32 `json:"missingentrycount"`
+	DBFinished                   bool   `json:"dbfinished"`
+}
+
+type NodeStatusFault struct {
+	VM            int    `json:"vm"`
+	ServerID      string `json:"serverid"`
+	AuditServerID string `json:"auditserverid"`
+	Votes         int    `json:"votes"`
+	Signatures    int    `json:"signatures"`
+	Tally         int    `json:"tally"`
+	PledgeDone    bool   `json:"pledgedone"`
+}
+
+type NodeStatusFaults struct {
+	SystemHeight int              `json:"systemheight"`
+	SystemLength int              `json:"systemlength"`
+	Current      *NodeStatusFault `json:"current"` // nil if no fault is being negotiated
+}
+
+type NodeStatusCounters struct {
+	ResetTries     int     `json:"resettries"`
+	Resets         int     `json:"resets"`
+	DropRate       int     `json:"droprate"` // Tenths of a percent
+	Delay          int64   `json:"delay"`    // Milliseconds
+	DBStateAsk     int     `json:"dbstateask"`
+	DBStateReply   int     `json:"dbstatereply"`
+	DBStateIgnore  int     `json:"dbstateignore"`
+	DBStateApplied int     `json:"dbstateapplied"`
+	MissingAsk     int     `json:"missingask"`
+	MissingReply   int     `json:"missingreply"`
+	MissingIgnore  int     `json:"missingignore"`
+	MissingApplied int     `json:"missingapplied"`
+	Resend         int     `json:"resend"`
+	Expire         int     `json:"expire"`
+	FactoidTrans   int     `json:"factoidtrans"`
+	NewEntryChains int     `json:"newentrychains"`
+	NewEntries     int     `json:"newentries"`
+	FCTSubmits     int     `json:"fctsubmits"`
+	ECCommits      int     `json:"eccommits"`
+	ECommits       int     `json:"ecommits"`
+	TotalTPS       float64 `json:"totaltps"`
+	InstantTPS     float64 `json:"instanttps"`
+}
+
+type NodeStatusNetwork struct {
+	Peers    int   `json:"peers"`
+	Received []int `json:"received,omitempty"` // Per message type, only if message tallies are on
+	Sent     []int `json:"sent,omitempty"`
+}
+
+type NodeStatusAuthority struct {
+	ChainID   string `json:"chainid"`
+	Federated bool   `json:"federated"`
+	Online    bool   `json:"online"`
+}
@@ -294,6 +294,8 @@ type IState interface {
 	GetLeaderPL() IProcessList
 	GetLLeaderHeight() uint32
 	GetEntryDBHeightComplete() uint32
+	GetDBFinished() bool
+	GetNumberConnections() int
 	GetMissingEntryCount() uint32
 	GetEntryBlockDBHeightProcessing() uint32
 	GetEntryBlockDBHeightComplete() uint32
@@ -315,4 +317,27 @@ type IState interface {
 	// Plugins
 	UsingTorrent() bool
 	GetMissingDBState(height uint32) error
+
+	// Message tracing
+	GetSlowMsgTraces() []*MsgTrace
+
+	// Node status (structured form of the console summary)
+	GetNodeStatus() *NodeStatus
+
+	// Dry runs of factoid transactions and EC commits for the API
+	DryRunTransaction(trans ITransaction) *TransactionValidation
+	DryRunCommit(msg IMsg) *TransactionValidation
+
+	// Where an entry or chain stands on this node, for the API
+	LookupEntry(entryHash IHash) *LookupResult
+	LookupChain(chainID IHash) *LookupResult
+
+	// Identities and the authority set for the API
+	GetIdentityRecord(chainID IHash) *IdentityRecord
+	GetAuthoritySet(dbheight uint32, chainID IHash) (*AuthoritySet, error)
+
+	// Shared by the nodes of a network, nil if they have none
+	GetEntryValidator() IEntryValidator
+	GetComposeKeys() IKeyStore
+	GetSimNetwork() ISimNetwork
 }
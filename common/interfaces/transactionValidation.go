@@ -0,0 +1,19 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// TransactionValidation is the result of a dry run of a factoid transaction
+// or an EC commit.  Every check is listed, so a wallet can tell exactly why
+// a submission would be dropped.
+type TransactionValidation struct {
+	Type string `json:"type"` // factoid, commitentry, commitchain or entry
+	TxID string `json:"txid"`
+	CheckList
+
+	// Factoid transactions only
+	FactoshisPerEC uint64 `json:"factoshisperec,omitempty"`
+	Fee            uint64 `json:"fee,omitempty"`     // Minimum fee at FactoshisPerEC
+	FeePaid        uint64 `json:"feepaid,omitempty"` // Inputs minus outputs and EC outputs
+}
@@ -0,0 +1,146 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the time timestamps, the block timer and fault timeouts run on.
+// It is the wall clock, unless a simulation sets a VirtualClock with
+// SetClock.  A node may run on a clock of its own, see State.Clock.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+type wallClock struct{}
+
+func (wallClock) Now() time.Time {
+	return time.Now()
+}
+
+func (wallClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// WallClock is the time of day
+var WallClock Clock = wallClock{}
+
+var clockMutex sync.RWMutex
+var clock Clock = WallClock
+
+// SetClock sets the clock.  It should be set before the nodes start, as time
+// going back would confuse them.
+func SetClock(c Clock) {
+	clockMutex.Lock()
+	defer clockMutex.Unlock()
+	clock = c
+}
+
+func GetClock() Clock {
+	clockMutex.RLock()
+	defer clockMutex.RUnlock()
+	return clock
+}
+
+// Now returns the time on the clock
+func Now() time.Time {
+	return GetClock().Now()
+}
+
+// Sleep waits d on the clock
+func Sleep(d time.Duration) {
+	GetClock().Sleep(d)
+}
+
+// Since returns the time elapsed on the clock since t
+func Since(t time.Time) time.Duration {
+	return GetClock().Now().Sub(t)
+}
+
+// VirtualClock is a clock that only moves when advanced, so a simulation
+// run twice sees the same times.  Nothing advances it but Advance; the
+// simulator steps it, see engine/simClock.go.
+type VirtualClock struct {
+	mutex    sync.Mutex
+	now      time.Time
+	sleepers []*sleeper
+}
+
+type sleeper struct {
+	until time.Time
+	wake  chan struct{}
+	owner interface{}
+}
+
+var _ Clock = (*VirtualClock)(nil)
+
+func NewVirtualClock(start time.Time) *VirtualClock {
+	c := new(VirtualClock)
+	c.now = start
+	return c
+}
+
+func (c *VirtualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Sleep waits until the clock is advanced by d
+func (c *VirtualClock) Sleep(d time.Duration) {
+	c.SleepAs(nil, d)
+}
+
+// SleepAs sleeps as Sleep does, counted as one of owner's sleepers
+func (c *VirtualClock) SleepAs(owner interface{}, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	s := &sleeper{until: c.now.Add(d), wake: make(chan struct{}), owner: owner}
+	c.sleepers = append(c.sleepers, s)
+	c.mutex.Unlock()
+	<-s.wake
+}
+
+// Advance moves the clock on by d, waking the sleepers whose time has come
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+	waiting := c.sleepers[:0]
+	for _, s := range c.sleepers {
+		if s.until.After(c.now) {
+			waiting = append(waiting, s)
+		} else {
+			close(s.wake)
+		}
+	}
+	c.sleepers = waiting
+}
+
+// Sleepers returns how many are sleeping on the clock
+func (c *VirtualClock) Sleepers() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.sleepers)
+}
+
+// SleepersOf returns how many of owner's sleepers are sleeping on the clock.
+// A sleeper stops counting as it is woken, before it runs.
+func (c *VirtualClock) SleepersOf(owner interface{}) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	n := 0
+	for _, s := range c.sleepers {
+		if s.owner == owner {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package primitives_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestVirtualClock(t *testing.T) {
+	start := time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := NewVirtualClock(start)
+	if c.Now() != start {
+		t.Errorf("Clock starts at %v, expected %v", c.Now(), start)
+	}
+
+	woke := make(chan int, 2)
+	go func() {
+		c.Sleep(time.Second)
+		woke <- 1
+	}()
+	go func() {
+		c.Sleep(time.Minute)
+		woke <- 2
+	}()
+	for c.Sleepers() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Advance(999 * time.Millisecond)
+	select {
+	case <-woke:
+		t.Errorf("Woke before the time came")
+	case <-time.After(10 * time.Millisecond):
+	}
+	c.Advance(time.Millisecond)
+	if w := <-woke; w != 1 || c.Sleepers() != 1 {
+		t.Errorf("Expected the one second sleeper to wake, got %d with %d sleeping", w, c.Sleepers())
+	}
+	c.Advance(time.Hour)
+	if w := <-woke; w != 2 || c.Sleepers() != 0 {
+		t.Errorf("Expected the one minute sleeper to wake, got %d with %d sleeping", w, c.Sleepers())
+	}
+	if !c.Now().Equal(start.Add(time.Hour + time.Second)) {
+		t.Errorf("Wrong time %v", c.Now())
+	}
+
+	// Sleeping for nothing does not wait
+	c.Sleep(0)
+}
+
+func TestSleepersOf(t *testing.T) {
+	c := NewVirtualClock(time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC))
+	owner := new(int)
+	done := make(chan bool, 2)
+	go func() {
+		c.SleepAs(owner, time.Second)
+		done <- true
+	}()
+	go func() {
+		c.Sleep(time.Minute)
+		done <- true
+	}()
+	for c.Sleepers() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	if n := c.SleepersOf(owner); n != 1 {
+		t.Errorf("%d of the owner's sleeping, expected 1", n)
+	}
+
+	// Woken sleepers stop counting at once
+	c.Advance(time.Second)
+	if n := c.SleepersOf(owner); n != 0 {
+		t.Errorf("%d of the owner's sleeping after waking, expected 0", n)
+	}
+	<-done
+	c.Advance(time.Minute)
+	<-done
+}
+
+func TestSetClock(t *testing.T) {
+	defer SetClock(GetClock())
+
+	start := time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := NewVirtualClock(start)
+	SetClock(c)
+	c.Advance(1500 * time.Millisecond)
+
+	if ts := NewTimestampNow(); ts.GetTimeMilli() != start.UnixNano()/1e6+1500 {
+		t.Errorf("Timestamp %d, expected the virtual %d", ts.GetTimeMilli(), start.UnixNano()/1e6+1500)
+	}
+	if GetTime() != uint64(start.Unix()+1) {
+		t.Errorf("Time %d, expected the virtual %d", GetTime(), start.Unix()+1)
+	}
+	if Since(start) != 1500*time.Millisecond {
+		t.Errorf("Since %v, expected 1.5s", Since(start))
+	}
+}
@@ -89,7 +89,17 @@ func BuildMerkleBranch(hashes []interfaces.IHash, entryIndex int, fullDetail boo
 	}
 	merkleTree := BuildMerkleTreeStore(hashes)
 	//fmt.Printf("Merkle tree - %v\n", merkleTree)
-	levelWidth := len(hashes)
+	return BuildMerkleBranchFromStore(merkleTree, len(hashes), entryIndex, fullDetail)
+}
+
+// BuildMerkleBranchFromStore is BuildMerkleBranch on a tree already built by
+// BuildMerkleTreeStore from width hashes, so the branches of many entries can
+// share one tree.
+func BuildMerkleBranchFromStore(merkleTree []interfaces.IHash, width int, entryIndex int, fullDetail bool) []*MerkleNode {
+	if width < entryIndex || width == 0 || len(merkleTree) < width {
+		return nil
+	}
+	levelWidth := width
 	complimentIndex := 0
 	topIndex := 0
 	index := entryIndex
@@ -181,3 +181,32 @@ func buildExpectedMerkleTree(hashes []interfaces.IHash) []interfaces.IHash {
 	nextIteration := buildExpectedMerkleTree(nextLevel)
 	return append(hashes, nextIteration...)
 }
+
+func TestBuildMerkleBranchFromStore(t *testing.T) {
+	for max := 1; max < 12; max++ {
+		list := buildMerkleLeafs(max)
+		tree := BuildMerkleTreeStore(append([]interfaces.IHash{}, list...))
+		for i := 0; i < max; i++ {
+			for _, full := range []bool{true, false} {
+				expected := BuildMerkleBranch(list, i, full)
+				branch := BuildMerkleBranchFromStore(tree, max, i, full)
+				if len(branch) != len(expected) {
+					t.Fatalf("%v of %v: %v nodes, expected %v", i, max, len(branch), len(expected))
+				}
+				for j := range branch {
+					if !sameHash(branch[j].Left, expected[j].Left) || !sameHash(branch[j].Right, expected[j].Right) ||
+						!sameHash(branch[j].Top, expected[j].Top) {
+						t.Errorf("%v of %v: node %v differs", i, max, j)
+					}
+				}
+			}
+		}
+	}
+}
+
+func sameHash(a, b *Hash) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.IsSameAs(b)
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/FactomProject/factomd/compose"
+)
+
+func main() {
+	var (
+		db     = flag.String("db", "eckeys.db", "Encrypted key database, as given to factomd -eckeys")
+		dbType = flag.String("dbtype", "Bolt", "Database type, Bolt or LDB")
+	)
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 || (args[0] != "list" && len(args) != 2) {
+		fmt.Println("Usage:")
+		fmt.Println("ECKeyStore [-db file] add Es...")
+		fmt.Println("ECKeyStore [-db file] remove EC...")
+		fmt.Println("ECKeyStore [-db file] list")
+		fmt.Printf("Manages the entry credit keys factomd pays for compose-and-submit-entry with.  The database password is read from %s.\n", compose.PasswordEnv)
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	password := os.Getenv(compose.PasswordEnv)
+	if password == "" {
+		fmt.Printf("%s is not set\n", compose.PasswordEnv)
+		os.Exit(1)
+	}
+	keys, err := compose.OpenKeyStore(*db, *dbType, password)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer keys.Close()
+
+	switch args[0] {
+	case "add":
+		address, err := keys.AddKey(args[1])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println(address)
+	case "remove":
+		if err := keys.RemoveKey(args[1]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	case "list":
+		addresses, err := keys.Addresses()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		for _, v := range addresses {
+			fmt.Println(v)
+		}
+	default:
+		fmt.Printf("Unknown command %s\n", args[0])
+		os.Exit(1)
+	}
+}
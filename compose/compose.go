@@ -0,0 +1,84 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package compose builds and signs the commits paying for entries and new
+// chains, so clients need not build the binary messages themselves.
+package compose
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// NewChainCost is the credits a new chain costs on top of its first entry
+const NewChainCost = 10
+
+// EntryCost returns the credits an entry costs
+func EntryCost(entry *entryBlock.Entry) (uint8, error) {
+	bin, err := entry.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return util.EntryCost(bin)
+}
+
+// NewEntryCommit builds the commit of an entry into an existing chain, and
+// signs it with an entry credit private key.
+func NewEntryCommit(entry *entryBlock.Entry, priv []byte, ts interfaces.Timestamp) (*entryCreditBlock.CommitEntry, error) {
+	credits, err := EntryCost(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := entryCreditBlock.NewCommitEntry()
+	if err := setMilliTime(commit.MilliTime, ts); err != nil {
+		return nil, err
+	}
+	commit.EntryHash = entry.GetHash()
+	commit.Credits = credits
+	if err := commit.Sign(priv); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// NewChainCommit builds the commit of a new chain, whose first entry is
+// entry, and signs it with an entry credit private key.  The chain ID of the
+// entry must be set, see entryBlock.NewChainID.
+func NewChainCommit(entry *entryBlock.Entry, priv []byte, ts interfaces.Timestamp) (*entryCreditBlock.CommitChain, error) {
+	if entry.ChainID == nil {
+		return nil, fmt.Errorf("The entry has no chain ID")
+	}
+	credits, err := EntryCost(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := entryCreditBlock.NewCommitChain()
+	if err := setMilliTime(commit.MilliTime, ts); err != nil {
+		return nil, err
+	}
+	chainID := entry.ChainID.Bytes()
+	commit.ChainIDHash.SetBytes(primitives.DoubleSha(chainID))
+	commit.Weld.SetBytes(primitives.DoubleSha(append(entry.GetHash().Bytes(), chainID...)))
+	commit.EntryHash = entry.GetHash()
+	commit.Credits = credits + NewChainCost
+	if err := commit.Sign(priv); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+func setMilliTime(milliTime *primitives.ByteSlice6, ts interfaces.Timestamp) error {
+	bin, err := ts.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return milliTime.UnmarshalBinary(bin)
+}
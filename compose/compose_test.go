@@ -0,0 +1,90 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package compose_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestNewEntryCommit(t *testing.T) {
+	entry := testHelper.CreateTestEntry(1)
+	ts := primitives.NewTimestampFromMilliseconds(1500000000123)
+
+	commit, err := NewEntryCommit(entry, testHelper.NewPrivKey(0), ts)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if commit.IsValid() == false {
+		t.Errorf("Commit is not valid")
+	}
+	if commit.EntryHash.IsSameAs(entry.GetHash()) == false {
+		t.Errorf("Commit is for the wrong entry")
+	}
+	if commit.Credits != 1 {
+		t.Errorf("Commit pays %v credits, expected 1", commit.Credits)
+	}
+	if commit.GetTimestamp().GetTimeMilli() != ts.GetTimeMilli() {
+		t.Errorf("Commit time %v, expected %v", commit.GetTimestamp(), ts)
+	}
+	pub, _ := primitives.PrivateKeyToPublicKey(testHelper.NewPrivKey(0))
+	if bytes.Equal(commit.ECPubKey[:], pub) == false {
+		t.Errorf("Commit signed by the wrong key")
+	}
+
+	// Larger entries cost more
+	entry.Content = primitives.ByteSlice{Bytes: make([]byte, 3000)}
+	commit, err = NewEntryCommit(entry, testHelper.NewPrivKey(0), ts)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if commit.Credits != 3 {
+		t.Errorf("Commit pays %v credits, expected 3", commit.Credits)
+	}
+
+	entry.Content = primitives.ByteSlice{Bytes: make([]byte, 10241)}
+	if _, err := NewEntryCommit(entry, testHelper.NewPrivKey(0), ts); err == nil {
+		t.Errorf("Expected an entry over 10KB to be refused")
+	}
+}
+
+func TestNewChainCommit(t *testing.T) {
+	entry := entryBlock.NewEntry()
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("compose")}, {Bytes: []byte("test")}}
+	entry.Content = primitives.ByteSlice{Bytes: []byte("First entry")}
+	ts := primitives.NewTimestampNow()
+
+	entry.ChainID = nil
+	if _, err := NewChainCommit(entry, testHelper.NewPrivKey(0), ts); err == nil {
+		t.Errorf("Expected an entry without a chain ID to be refused")
+	}
+
+	entry.ChainID = entryBlock.NewChainID(entry)
+	commit, err := NewChainCommit(entry, testHelper.NewPrivKey(0), ts)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if commit.IsValid() == false {
+		t.Errorf("Commit is not valid")
+	}
+	if commit.Credits != 1+NewChainCost {
+		t.Errorf("Commit pays %v credits, expected %v", commit.Credits, 1+NewChainCost)
+	}
+	if commit.EntryHash.IsSameAs(entry.GetHash()) == false {
+		t.Errorf("Commit is for the wrong entry")
+	}
+	chainID := entry.ChainID.Bytes()
+	if bytes.Equal(commit.ChainIDHash.Bytes(), primitives.DoubleSha(chainID)) == false {
+		t.Errorf("Wrong chain ID hash")
+	}
+	if bytes.Equal(commit.Weld.Bytes(), primitives.DoubleSha(append(entry.GetHash().Bytes(), chainID...))) == false {
+		t.Errorf("Wrong weld")
+	}
+}
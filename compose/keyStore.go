@@ -0,0 +1,140 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package compose
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/securedb"
+)
+
+// PasswordEnv is the environment variable holding the password of the key
+// database, so it is not seen on command lines
+const PasswordEnv = "FACTOMD_ECKEYS_PASSWORD"
+
+// ECKeysBucket holds the entry credit private keys, by public key
+var ECKeysBucket []byte = []byte("ECPrivateKeys")
+
+// KeyStore holds the entry credit private keys the node may pay with.  It is
+// kept in an encrypted database, see securedb.
+type KeyStore struct {
+	DB interfaces.IDatabase
+
+	mutex sync.Mutex
+}
+
+var _ interfaces.IKeyStore = (*KeyStore)(nil)
+
+func NewKeyStore(db interfaces.IDatabase) *KeyStore {
+	k := new(KeyStore)
+	k.DB = db
+	return k
+}
+
+// OpenKeyStore opens, or creates, the encrypted key database filename.
+// dbtype is Map, Bolt or LDB.
+func OpenKeyStore(filename, dbtype, password string) (*KeyStore, error) {
+	db, err := securedb.NewEncryptedDB(filename, dbtype, password)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyStore(db), nil
+}
+
+func (k *KeyStore) Close() error {
+	return k.DB.Close()
+}
+
+// AddKey adds a human readable entry credit private key, Es..., and returns
+// its public EC address.
+func (k *KeyStore) AddKey(human string) (string, error) {
+	priv, err := primitives.HumanReadableECPrivateKeyToPrivateKey(human)
+	if err != nil {
+		return "", err
+	}
+	return k.AddPrivateKey(priv)
+}
+
+// AddPrivateKey adds a 32 byte private key, and returns its public EC address
+func (k *KeyStore) AddPrivateKey(priv []byte) (string, error) {
+	if len(priv) != 32 {
+		return "", fmt.Errorf("Private key is %d bytes, not 32", len(priv))
+	}
+	pub, err := primitives.PrivateKeyToPublicKey(priv)
+	if err != nil {
+		return "", err
+	}
+	key := new(primitives.ByteSlice32)
+	copy(key[:], priv)
+
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	err = k.DB.Put(ECKeysBucket, pub, key)
+	if err != nil {
+		return "", err
+	}
+	return ECAddress(pub), nil
+}
+
+// RemoveKey removes the private key of an EC address
+func (k *KeyStore) RemoveKey(address string) error {
+	pub, err := ECPublicKey(address)
+	if err != nil {
+		return err
+	}
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	return k.DB.Delete(ECKeysBucket, pub)
+}
+
+// Addresses returns the EC addresses of the keys held
+func (k *KeyStore) Addresses() ([]string, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	keys, err := k.DB.ListAllKeys(ECKeysBucket)
+	if err != nil {
+		return nil, err
+	}
+	answer := make([]string, len(keys))
+	for i, pub := range keys {
+		answer[i] = ECAddress(pub)
+	}
+	return answer, nil
+}
+
+// PrivateKey returns the private key of an EC address
+func (k *KeyStore) PrivateKey(address string) ([]byte, error) {
+	pub, err := ECPublicKey(address)
+	if err != nil {
+		return nil, err
+	}
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	key, err := k.DB.Get(ECKeysBucket, pub, new(primitives.ByteSlice32))
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("No key held for %s", address)
+	}
+	return key.(*primitives.ByteSlice32)[:], nil
+}
+
+// ECAddress returns the EC address of a public key
+func ECAddress(pub []byte) string {
+	return primitives.ConvertECAddressToUserStr(factoid.NewAddress(pub))
+}
+
+// ECPublicKey returns the public key of an EC address
+func ECPublicKey(address string) ([]byte, error) {
+	if primitives.ValidateECUserStr(address) == false {
+		return nil, fmt.Errorf("Invalid EC address %q", address)
+	}
+	return primitives.ConvertUserStrToAddress(address), nil
+}
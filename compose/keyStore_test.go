@@ -0,0 +1,69 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package compose_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestKeyStore(t *testing.T) {
+	keys, err := OpenKeyStore("", "Map", "password")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer keys.Close()
+
+	human, err := primitives.PrivateKeyStringToHumanReadableECPrivateKey(testHelper.NewPrivKeyString(0))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	address, err := keys.AddKey(human)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if address != testHelper.NewECAddressString(0) {
+		t.Errorf("Got address %v, expected %v", address, testHelper.NewECAddressString(0))
+	}
+	if _, err := keys.AddPrivateKey(testHelper.NewPrivKey(1)); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if _, err := keys.AddPrivateKey([]byte{1, 2, 3}); err == nil {
+		t.Errorf("Expected a short key to be refused")
+	}
+	if _, err := keys.AddKey("Es1234"); err == nil {
+		t.Errorf("Expected a bad human readable key to be refused")
+	}
+
+	addresses, err := keys.Addresses()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(addresses) != 2 {
+		t.Errorf("Got %v addresses, expected 2", len(addresses))
+	}
+
+	priv, err := keys.PrivateKey(address)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if bytes.Equal(priv, testHelper.NewPrivKey(0)) == false {
+		t.Errorf("Got the wrong private key")
+	}
+
+	if err := keys.RemoveKey(address); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if _, err := keys.PrivateKey(address); err == nil {
+		t.Errorf("Expected the key to be removed")
+	}
+	if _, err := keys.PrivateKey("EC1234"); err == nil {
+		t.Errorf("Expected a bad address to be refused")
+	}
+}
@@ -0,0 +1,178 @@
+// Time series charts of the node's recent activity, drawn on canvas from factomd?item=metrics
+
+var chartData = {samples: [], blocks: [], minutes: []}
+var chartLastTime = 0
+var chartMaxSamples = 900
+var chartMaxDurations = 240
+
+var chartColors = ["#2a7ab0", "#e07b39", "#4caf50", "#c0392b", "#8e44ad", "#16a085", "#7f8c8d", "#d4ac0d"]
+
+function updateCharts() {
+  queryState("metrics", chartLastTime, function(resp){
+    var obj
+    try {
+      obj = JSON.parse(resp)
+    } catch(e) {
+      return
+    }
+    chartData.samples = chartData.samples.concat(obj.samples).slice(-chartMaxSamples)
+    chartData.blocks = chartData.blocks.concat(obj.blocks).slice(-chartMaxDurations)
+    chartData.minutes = chartData.minutes.concat(obj.minutes).slice(-chartMaxDurations)
+    var last = [obj.samples, obj.blocks, obj.minutes]
+    for (var i = 0; i < last.length; i++) {
+      if (last[i].length > 0 && last[i][last[i].length - 1].time > chartLastTime) {
+        chartLastTime = last[i][last[i].length - 1].time
+      }
+    }
+    drawCharts()
+  })
+}
+
+function drawCharts() {
+  var s = chartData.samples
+  drawChart("chart-throughput", [
+    chartSeries("Entries", s, function(m) { return m.entriespersec }),
+    chartSeries("Factoid Transactions", s, function(m) { return m.factoidtps })
+  ])
+  drawChart("chart-queues", [
+    chartSeries("In Msg", s, function(m) { return m.queues.inmsg }),
+    chartSeries("Msg", s, function(m) { return m.queues.msg }),
+    chartSeries("API", s, function(m) { return m.queues.api }),
+    chartSeries("Ack", s, function(m) { return m.queues.ack }),
+    chartSeries("Network Out", s, function(m) { return m.queues.networkout }),
+    chartSeries("Holding", s, function(m) { return m.queues.holding }),
+    chartSeries("Acks", s, function(m) { return m.queues.acks }),
+    chartSeries("Commits", s, function(m) { return m.queues.commits })
+  ])
+  drawChart("chart-blocks", [
+    chartSeries("Block", chartData.blocks, function(d) { return d.seconds })
+  ], function(d) { return "Block " + d.height })
+  drawChart("chart-minutes", [
+    chartSeries("Minute", chartData.minutes, function(d) { return d.seconds })
+  ], function(d) { return "Block " + d.height + " minute " + d.minute })
+  drawChart("chart-peers", [
+    chartSeries("Peers", s, function(m) { return m.peers })
+  ])
+}
+
+function chartSeries(label, list, value) {
+  var points = []
+  for (var i = 0; i < list.length; i++) {
+    points.push({t: list[i].time, v: value(list[i]), item: list[i]})
+  }
+  return {label: label, points: points}
+}
+
+// drawChart draws lines of the series on a shared time axis, with the y axis from 0.
+// describe names the last point of a single series in the legend.
+function drawChart(id, series, describe) {
+  var canvas = document.getElementById(id)
+  if (canvas == null) {
+    return
+  }
+  canvas.width = canvas.clientWidth
+  var ctx = canvas.getContext("2d")
+  var w = canvas.width, h = canvas.height
+  var left = 45, right = 10, top = 10, bottom = 22
+  ctx.clearRect(0, 0, w, h)
+
+  var tmin = Infinity, tmax = -Infinity, vmax = 0
+  for (var i = 0; i < series.length; i++) {
+    var p = series[i].points
+    for (var j = 0; j < p.length; j++) {
+      tmin = Math.min(tmin, p[j].t)
+      tmax = Math.max(tmax, p[j].t)
+      vmax = Math.max(vmax, p[j].v)
+    }
+  }
+
+  ctx.font = "11px sans-serif"
+  ctx.fillStyle = "#777"
+  ctx.strokeStyle = "#ddd"
+  ctx.lineWidth = 1
+  if (tmin == Infinity) {
+    ctx.fillText("Waiting for data", left, top + 20)
+    $(canvas).siblings(".chart-legend").text("")
+    return
+  }
+  if (vmax == 0) {
+    vmax = 1
+  }
+  vmax = chartNiceMax(vmax)
+  if (tmax == tmin) {
+    tmin = tmax - 1000
+  }
+  var x = function(t) { return left + (t - tmin) / (tmax - tmin) * (w - left - right) }
+  var y = function(v) { return h - bottom - v / vmax * (h - top - bottom) }
+
+  // Grid and axes
+  ctx.textAlign = "right"
+  for (var k = 0; k <= 4; k++) {
+    var v = vmax * k / 4
+    ctx.beginPath()
+    ctx.moveTo(left, y(v))
+    ctx.lineTo(w - right, y(v))
+    ctx.stroke()
+    ctx.fillText(chartFormat(v), left - 4, y(v) + 4)
+  }
+  ctx.textAlign = "center"
+  for (var k = 0; k <= 4; k++) {
+    var t = tmin + (tmax - tmin) * k / 4
+    ctx.fillText(new Date(t).toLocaleTimeString(), Math.min(Math.max(x(t), left + 25), w - right - 25), h - 6)
+  }
+
+  // Lines
+  var legend = []
+  for (var i = 0; i < series.length; i++) {
+    var p = series[i].points
+    var color = chartColors[i % chartColors.length]
+    ctx.strokeStyle = color
+    ctx.fillStyle = color
+    ctx.lineWidth = 1.5
+    ctx.beginPath()
+    for (var j = 0; j < p.length; j++) {
+      if (j == 0) {
+        ctx.moveTo(x(p[j].t), y(p[j].v))
+      } else {
+        ctx.lineTo(x(p[j].t), y(p[j].v))
+      }
+    }
+    ctx.stroke()
+    if (p.length == 1) {
+      ctx.fillRect(x(p[0].t) - 2, y(p[0].v) - 2, 4, 4)
+    }
+
+    var last = p.length > 0 ? p[p.length - 1] : null
+    var text = series[i].label + ": " + (last ? chartFormat(last.v) : "-")
+    if (describe && last) {
+      text = describe(last.item) + ": " + chartFormat(last.v)
+    }
+    legend.push("<span style='color:" + color + "'>&#9632;</span> " + text)
+  }
+  $(canvas).siblings(".chart-legend").html(legend.join(" &nbsp; "))
+}
+
+// chartNiceMax rounds up to 1, 2 or 5 times a power of ten
+function chartNiceMax(v) {
+  var p = Math.pow(10, Math.floor(Math.log(v) / Math.LN10))
+  var steps = [1, 2, 5, 10]
+  for (var i = 0; i < steps.length; i++) {
+    if (steps[i] * p >= v) {
+      return steps[i] * p
+    }
+  }
+  return 10 * p
+}
+
+function chartFormat(v) {
+  if (v >= 100 || v == Math.floor(v)) {
+    return Math.round(v).toString()
+  }
+  return v.toFixed(2)
+}
+
+$(window).resize(function() {
+  if ($("#indexnav-charts").hasClass("is-active")) {
+    drawCharts()
+  }
+})
@@ -46,6 +46,9 @@ function updateHTML() {
   } else if($("#indexnav-more").hasClass("is-active")) {
     // Detailed Tab
     updataDataDumps()
+  } else if($("#indexnav-charts").hasClass("is-active")) {
+    // Charts Tab
+    updateCharts()
   }
 
 }
@@ -74,6 +77,7 @@ $("#indexnav-main > a").click(function() {
     $("#transactions").removeClass("hide")
     $("#local").removeClass("hide")
     $("#dataDump").addClass("hide")
+    $("#charts").addClass("hide")
   }
 })
 
@@ -83,6 +87,17 @@ $("#indexnav-more > a").click(function() {
     $("#transactions").addClass("hide")
     $("#local").addClass("hide")
     $("#dataDump").removeClass("hide")
+    $("#charts").addClass("hide")
+  }
+})
+
+$("#indexnav-charts > a").click(function() {
+  if (jQuery(this).hasClass("is-active")) {
+  } else {
+    $("#transactions").addClass("hide")
+    $("#local").addClass("hide")
+    $("#dataDump").addClass("hide")
+    $("#charts").removeClass("hide")
   }
 })
 
@@ -350,7 +365,7 @@ function updateAllPeers() {
 
 // Add listeners to disconnect buttons
 $("body").on('mouseup',"#peerList  #disconnect",function(e) {
-  queryState("disconnect", jQuery(this).attr("value"), function(resp){
+  postState("disconnect", jQuery(this).attr("value"), function(resp){
     obj = JSON.parse(resp)
     if(obj.Access == "denied") {
       $("#" + obj.Id).find("#disconnect").addClass("disabled")
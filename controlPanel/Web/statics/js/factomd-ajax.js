@@ -11,6 +11,20 @@ function queryState(item, value, func) {
   req.send()
 }
 
+// Actions that change state are posted
+function postState(item, value, func) {
+  var req = new XMLHttpRequest()
+
+  req.onreadystatechange = function() {
+    if(req.readyState == 4) {
+      func(req.response)
+    }
+  }
+  req.open("POST", "./factomd", true)
+  req.setRequestHeader("Content-Type", "application/x-www-form-urlencoded")
+  req.send("item=" + encodeURIComponent(item) + "&value=" + encodeURIComponent(value))
+}
+
 function batchQueryState(item, func) {
   var req = new XMLHttpRequest()
 
@@ -1,32 +1,16 @@
+// External IDs have both encodings, switch between them
 $("#entry-external-id > #encoding").click(function() {
   encoding = jQuery(this).text()
+  data = jQuery(this).parent().find("#data")
   if(encoding.indexOf("Hex") > -1){
-  	jQuery(this).find("a").text("Ascii: ")
-  	str = convertFromHex(jQuery(this).parent().find("#data").text())
-	jQuery(this).parent().find("#data").text(str)
-  } else if (encoding.indexOf("Ascii") > -1){
-	jQuery(this).find("a").text("Hex : ")
-	str = convertToHex(jQuery(this).parent().find("#data").text())
-	jQuery(this).parent().find("#data").text(str)
+    jQuery(this).find("a").text("UTF-8: ")
+    data.text(data.attr("data-utf8"))
+  } else {
+    jQuery(this).find("a").text("Hex  : ")
+    data.text(data.attr("data-hex"))
   }
 })
 
-function convertFromHex(hex) {
-    var hex = hex.toString();//force conversion
-    var str = '';
-    for (var i = 0; i < hex.length; i += 2)
-        str += String.fromCharCode(parseInt(hex.substr(i, 2), 16));
-    return str;
-}
-
-function convertToHex(str) {
-    var hex = '';
-    for(var i=0;i<str.length;i++) {
-        hex += ''+str.charCodeAt(i).toString(16);
-    }
-    return hex;
-}
-
 $("td > #entry-content-summary > a").click(function() {
   td = jQuery(this).parent().parent()
   td.find("#entry-content-summary").hide()
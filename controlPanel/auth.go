@@ -0,0 +1,530 @@
+package controlPanel
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Optional control panel accounts. When no accounts are configured the control panel keeps using
+// the RPC user and password. When they are, every request needs a session from /login, and what a
+// session may do is decided by the role of its user. Every state changing action, and every login
+// and logout, is appended to the audit log.
+
+// A Role grants every action of the roles below it
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:     "none",
+	RoleViewer:   "viewer",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return "none"
+}
+
+func ParseRole(name string) (Role, error) {
+	for role, n := range roleNames {
+		if role != RoleNone && n == name {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("Unknown control panel role %q, must be viewer, operator or admin", name)
+}
+
+// Actions a role may take
+const (
+	ActionView       = "view"       // Every page, search and status query
+	ActionDisconnect = "disconnect" // Disconnect a peer
+	ActionAudit      = "audit"      // Read the audit log
+)
+
+// ActionRoles is the least role allowed to take each action
+var ActionRoles = map[string]Role{
+	ActionView:       RoleViewer,
+	ActionDisconnect: RoleOperator,
+	ActionAudit:      RoleAdmin,
+}
+
+type User struct {
+	Name string
+	Role Role
+	hash string
+}
+
+// Can returns true if the user's role allows the action. Unknown actions are never allowed.
+func (u *User) Can(action string) bool {
+	if u == nil {
+		return false
+	}
+	least, ok := ActionRoles[action]
+	return ok && u.Role >= least
+}
+
+// ParseUser parses a "name:role:hash" account, the hash coming from HashPassword
+func ParseUser(line string) (*User, error) {
+	parts := strings.SplitN(strings.TrimSpace(line), ":", 3)
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, fmt.Errorf("Control panel user %q must be name:role:hash", line)
+	}
+	role, err := ParseRole(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := splitHash(parts[2]); err != nil {
+		return nil, fmt.Errorf("Control panel user %s: %v", parts[0], err)
+	}
+	return &User{Name: parts[0], Role: role, hash: parts[2]}, nil
+}
+
+// LoadUsers parses the accounts of the config file and of the users file, one account per line,
+// where blank lines and lines starting with # or ; are ignored.
+func LoadUsers(lines []string, filename string) (map[string]*User, error) {
+	all := append([]string{}, lines...)
+	if filename != "" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			all = append(all, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	users := make(map[string]*User)
+	for _, line := range all {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		user, err := ParseUser(line)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := users[user.Name]; ok {
+			return nil, fmt.Errorf("Control panel user %s is defined twice", user.Name)
+		}
+		users[user.Name] = user
+	}
+	return users, nil
+}
+
+// Passwords are hashed with the same scrypt parameters as database/securedb
+const (
+	hashPrefix = "scrypt"
+	saltLength = 16
+	keyLength  = 32
+)
+
+// HashPassword returns "scrypt$<salt>$<key>" of the password, with a random salt
+func HashPassword(password string) (string, error) {
+	if password == "" {
+		return "", fmt.Errorf("The password must not be empty")
+	}
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key([]byte(password), salt, 16384, 8, 1, keyLength)
+	if err != nil {
+		return "", err
+	}
+	return hashPrefix + "$" + hex.EncodeToString(salt) + "$" + hex.EncodeToString(key), nil
+}
+
+func splitHash(hash string) (salt []byte, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 3 || parts[0] != hashPrefix {
+		return nil, nil, fmt.Errorf("the password hash must be %s$<salt>$<key>", hashPrefix)
+	}
+	if salt, err = hex.DecodeString(parts[1]); err != nil {
+		return nil, nil, fmt.Errorf("the password hash salt is not hex")
+	}
+	if key, err = hex.DecodeString(parts[2]); err != nil || len(key) != keyLength {
+		return nil, nil, fmt.Errorf("the password hash key is not %d bytes of hex", keyLength)
+	}
+	return salt, key, nil
+}
+
+// CheckPassword returns true if the password matches a hash from HashPassword
+func CheckPassword(hash string, password string) bool {
+	salt, key, err := splitHash(hash)
+	if err != nil {
+		return false
+	}
+	presented, err := scrypt.Key([]byte(password), salt, 16384, 8, 1, keyLength)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(presented, key) == 1
+}
+
+// SessionTimeout is how long a session lasts without a request
+var SessionTimeout = 30 * time.Minute
+
+const sessionCookie = "factomd_session"
+
+type session struct {
+	user    *User
+	expires time.Time
+}
+
+type Auth struct {
+	mutex    sync.Mutex
+	users    map[string]*User
+	sessions map[string]*session
+}
+
+// ControlPanelAuth is nil when no control panel accounts are configured
+var ControlPanelAuth *Auth
+
+func NewAuth(users map[string]*User) *Auth {
+	a := new(Auth)
+	a.users = users
+	a.sessions = make(map[string]*session)
+	return a
+}
+
+// InitAuth loads the control panel accounts and opens the audit log. With no accounts
+// ControlPanelAuth stays nil, and the RPC user and password guard the control panel.
+func InitAuth(lines []string, usersFile string, auditFile string) error {
+	users, err := LoadUsers(lines, usersFile)
+	if err != nil {
+		return err
+	}
+	audit, err := OpenAuditLog(auditFile)
+	if err != nil {
+		return err
+	}
+	ControlPanelAudit = audit
+	if len(users) == 0 {
+		ControlPanelAuth = nil
+		return nil
+	}
+	ControlPanelAuth = NewAuth(users)
+	return nil
+}
+
+// Login checks the password and returns a new session token
+func (a *Auth) Login(name string, password string) (string, error) {
+	a.mutex.Lock()
+	user, ok := a.users[name]
+	a.mutex.Unlock()
+	if !ok {
+		// Spend the same time as a known user, so names can't be probed
+		CheckPassword(dummyHash, password)
+		return "", fmt.Errorf("Wrong user name or password")
+	}
+	if !CheckPassword(user.hash, password) {
+		return "", fmt.Errorf("Wrong user name or password")
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.expire()
+	a.sessions[token] = &session{user: user, expires: time.Now().Add(SessionTimeout)}
+	return token, nil
+}
+
+// dummyHash is checked against for unknown users
+var dummyHash = hashPrefix + "$" + strings.Repeat("00", saltLength) + "$" + strings.Repeat("00", keyLength)
+
+// Logout ends the session of the token
+func (a *Auth) Logout(token string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.sessions, token)
+}
+
+// User returns the user of a live session and extends it, or nil
+func (a *Auth) User(token string) *User {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	s, ok := a.sessions[token]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(s.expires) {
+		delete(a.sessions, token)
+		return nil
+	}
+	s.expires = time.Now().Add(SessionTimeout)
+	return s.user
+}
+
+func (a *Auth) expire() {
+	now := time.Now()
+	for token, s := range a.sessions {
+		if now.After(s.expires) {
+			delete(a.sessions, token)
+		}
+	}
+}
+
+func sessionToken(r *http.Request) string {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// requestUser returns who made the request. Without accounts everyone who passed the RPC
+// password check is an admin, as the control panel has always behaved.
+func requestUser(r *http.Request) *User {
+	if ControlPanelAuth == nil {
+		name := "anonymous"
+		if StatePointer != nil && StatePointer.GetRpcUser() != "" {
+			name = StatePointer.GetRpcUser()
+		}
+		return &User{Name: name, Role: RoleAdmin}
+	}
+	return ControlPanelAuth.User(sessionToken(r))
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// sameOrigin returns false if a browser sent the request from another site, so a page elsewhere
+// can't use an operator's session cookie to act on the control panel
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = r.Header.Get("Referer")
+	}
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
+
+// checkSession returns false and redirects pages to /login, or refuses everything else, if the
+// request has no session allowed to view the control panel
+func checkSession(w http.ResponseWriter, r *http.Request) bool {
+	if requestUser(r).Can(ActionView) {
+		return true
+	}
+	if r.Method == "GET" && (r.URL.Path == "/" || r.URL.Path == "/search") {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return false
+	}
+	http.Error(w, "401 Unauthorized.", http.StatusUnauthorized)
+	return false
+}
+
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in LoginHandler.\n", r)
+		}
+	}()
+	if ControlPanelAuth == nil {
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+	message := ""
+	if r.Method == "POST" && sameOrigin(r) {
+		name := r.FormValue("user")
+		token, err := ControlPanelAuth.Login(name, r.FormValue("password"))
+		if err == nil {
+			ControlPanelAudit.Record(r, &User{Name: name}, "login", "", "granted")
+			cookie := &http.Cookie{
+				Name:     sessionCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   r.TLS != nil,
+			}
+			// http.Cookie has no SameSite before Go 1.11
+			w.Header().Add("Set-Cookie", cookie.String()+"; SameSite=Strict")
+			http.Redirect(w, r, "/", http.StatusFound)
+			return
+		}
+		ControlPanelAudit.Record(r, &User{Name: name}, "login", "", "denied")
+		fmt.Printf("Failed Control Panel login for %q from %s\n", name, remoteIP(r))
+		message = err.Error()
+	}
+
+	TemplateMutex.Lock()
+	defer TemplateMutex.Unlock()
+	if err := templates.ExecuteTemplate(w, "loginPage", message); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func logoutHandler(w http.ResponseWriter, r *http.Request) {
+	if ControlPanelAuth != nil {
+		if user := requestUser(r); user != nil {
+			ControlPanelAudit.Record(r, user, "logout", "", "granted")
+		}
+		ControlPanelAuth.Logout(sessionToken(r))
+		http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: "", Path: "/", MaxAge: -1})
+	}
+	http.Redirect(w, r, "/login", http.StatusFound)
+}
+
+// auditHandler returns the last lines of the audit log, newest last
+func auditHandler(w http.ResponseWriter, r *http.Request) {
+	if false == checkControlPanelPassword(w, r) {
+		return
+	}
+	if !requestUser(r).Can(ActionAudit) {
+		http.Error(w, "403 Forbidden.", http.StatusForbidden)
+		return
+	}
+	records, err := ControlPanelAudit.Tail(AuditTail)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, _ := json.Marshal(records)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// AuditTail is how many records /audit returns
+var AuditTail = 500
+
+type AuditRecord struct {
+	Time   string `json:"time"`
+	User   string `json:"user"`
+	Role   string `json:"role"`
+	Remote string `json:"remote"`
+	Action string `json:"action"`
+	Target string `json:"target,omitempty"`
+	Result string `json:"result"`
+}
+
+// An AuditLog appends one json record per line, and is never truncated or rewritten
+type AuditLog struct {
+	mutex    sync.Mutex
+	filename string
+	file     *os.File
+}
+
+// ControlPanelAudit records the actions of the control panel, it is nil if there is no audit log
+var ControlPanelAudit *AuditLog
+
+// OpenAuditLog opens the file for appending, a nil log if the filename is empty
+func OpenAuditLog(filename string) (*AuditLog, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{filename: filename, file: file}, nil
+}
+
+// Record appends an action and its result. A nil log records nothing.
+func (l *AuditLog) Record(r *http.Request, user *User, action string, target string, result string) error {
+	if l == nil {
+		return nil
+	}
+	rec := AuditRecord{
+		Time:   time.Now().UTC().Format(time.RFC3339),
+		Action: action,
+		Target: target,
+		Result: result,
+	}
+	if user != nil {
+		rec.User = user.Name
+		if user.Role != RoleNone {
+			rec.Role = user.Role.String()
+		}
+	}
+	if r != nil {
+		rec.Remote = remoteIP(r)
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, err = l.file.Write(append(data, '\n')); err != nil {
+		fmt.Println("Control Panel failed to write the audit log:", err)
+		return err
+	}
+	return l.file.Sync()
+}
+
+// Tail returns up to the last n records
+func (l *AuditLog) Tail(n int) ([]AuditRecord, error) {
+	records := make([]AuditRecord, 0)
+	if l == nil {
+		return records, nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	file, err := os.Open(l.filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var rec AuditRecord
+		if json.Unmarshal(scanner.Bytes(), &rec) != nil {
+			continue
+		}
+		records = append(records, rec)
+		if len(records) > n {
+			records = records[1:]
+		}
+	}
+	return records, scanner.Err()
+}
+
+func (l *AuditLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.file.Close()
+}
@@ -0,0 +1,177 @@
+package controlPanel_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/controlPanel"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CheckPassword(hash, "secret") {
+		t.Error("The password should match its hash")
+	}
+	if CheckPassword(hash, "Secret") {
+		t.Error("Another password should not match the hash")
+	}
+	other, _ := HashPassword("secret")
+	if other == hash {
+		t.Error("Hashes of the same password should have different salts")
+	}
+	if _, err := HashPassword(""); err == nil {
+		t.Error("An empty password should not be hashed")
+	}
+	if CheckPassword("secret", "secret") {
+		t.Error("A malformed hash should never match")
+	}
+}
+
+func TestLoadUsers(t *testing.T) {
+	viewer, _ := HashPassword("v")
+	operator, _ := HashPassword("o")
+
+	dir, err := ioutil.TempDir("", "controlpanel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "users")
+	ioutil.WriteFile(file, []byte("# NOC\n\nnoc:operator:"+operator+"\n"), 0600)
+
+	users, err := LoadUsers([]string{"watch:viewer:" + viewer}, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users["watch"].Role != RoleViewer || users["noc"].Role != RoleOperator {
+		t.Errorf("Wrong users %v", users)
+	}
+
+	bad := []string{
+		"watch:viewer",
+		"watch:root:" + viewer,
+		"watch:viewer:plain",
+		":viewer:" + viewer,
+	}
+	for _, line := range bad {
+		if _, err := LoadUsers([]string{line}, ""); err == nil {
+			t.Errorf("%q should not load", line)
+		}
+	}
+	if _, err := LoadUsers([]string{"watch:viewer:" + viewer, "watch:admin:" + viewer}, ""); err == nil {
+		t.Error("A user defined twice should not load")
+	}
+}
+
+func TestRoles(t *testing.T) {
+	var nobody *User
+	viewer := &User{Name: "v", Role: RoleViewer}
+	operator := &User{Name: "o", Role: RoleOperator}
+	admin := &User{Name: "a", Role: RoleAdmin}
+
+	can := map[*User][]bool{ // view, disconnect, audit
+		nobody:   {false, false, false},
+		viewer:   {true, false, false},
+		operator: {true, true, false},
+		admin:    {true, true, true},
+	}
+	for user, allowed := range can {
+		for i, action := range []string{ActionView, ActionDisconnect, ActionAudit} {
+			if user.Can(action) != allowed[i] {
+				t.Errorf("%v can %s should be %v", user, action, allowed[i])
+			}
+		}
+		if user.Can("shutdown") {
+			t.Error("Unknown actions should never be allowed")
+		}
+	}
+}
+
+func TestSessions(t *testing.T) {
+	hash, _ := HashPassword("o")
+	users, _ := LoadUsers([]string{"noc:operator:" + hash}, "")
+	auth := NewAuth(users)
+
+	if _, err := auth.Login("noc", "wrong"); err == nil {
+		t.Error("A wrong password should not log in")
+	}
+	if _, err := auth.Login("nobody", "o"); err == nil {
+		t.Error("An unknown user should not log in")
+	}
+	token, err := auth.Login("noc", "o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user := auth.User(token); user == nil || user.Name != "noc" {
+		t.Errorf("The session should belong to noc, not %v", user)
+	}
+	if auth.User("forged") != nil {
+		t.Error("An unknown token should have no user")
+	}
+	auth.Logout(token)
+	if auth.User(token) != nil {
+		t.Error("A logged out session should have no user")
+	}
+
+	timeout := SessionTimeout
+	defer func() { SessionTimeout = timeout }()
+	SessionTimeout = time.Millisecond
+	token, _ = auth.Login("noc", "o")
+	time.Sleep(5 * time.Millisecond)
+	if auth.User(token) != nil {
+		t.Error("An expired session should have no user")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "controlpanel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "audit.log")
+
+	audit, err := OpenAuditLog(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &User{Name: "noc", Role: RoleOperator}
+	audit.Record(nil, user, ActionDisconnect, "1.2.3.4:8108", "granted")
+	audit.Close()
+
+	// Reopening appends to what is there
+	audit, _ = OpenAuditLog(file)
+	defer audit.Close()
+	audit.Record(nil, user, ActionDisconnect, "5.6.7.8:8108", "denied")
+
+	records, err := audit.Tail(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Result != "granted" || records[1].Result != "denied" {
+		t.Fatalf("Wrong records %v", records)
+	}
+	if records[1].User != "noc" || records[1].Role != "operator" || records[1].Target != "5.6.7.8:8108" {
+		t.Errorf("Wrong record %v", records[1])
+	}
+	if records, _ = audit.Tail(1); len(records) != 1 || records[0].Result != "denied" {
+		t.Errorf("Tail should return the newest record, not %v", records)
+	}
+
+	data, _ := ioutil.ReadFile(file)
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+		t.Errorf("The audit log should have a line per record, not %d", len(lines))
+	}
+
+	var none *AuditLog
+	if none.Record(nil, user, ActionDisconnect, "", "granted") != nil {
+		t.Error("A nil audit log should record nothing")
+	}
+}
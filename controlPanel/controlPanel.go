@@ -29,6 +29,7 @@ import (
 type GitBuildAndVersion struct {
 	GitBuild string
 	Version  string
+	User     string // The signed in user, when there are control panel accounts
 }
 
 var (
@@ -123,6 +124,10 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 	portStr := ":" + strconv.Itoa(port)
 	Controller = controller
 	InitTemplates()
+	if err := InitAuth(statePointer.ControlPanelUsers, statePointer.ControlPanelUsersFile, statePointer.ControlPanelAuditLog); err != nil {
+		fmt.Println("Control Panel will not be served, its accounts or audit log failed to load:", err)
+		return
+	}
 
 	// Updated Globals. A seperate GoRoutine updates these, we just initialize
 	RecentTransactions = new(LastDirectoryBlockTransactions)
@@ -140,6 +145,9 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 	http.HandleFunc("/post", postHandler)
 	http.HandleFunc("/factomd", factomdHandler)
 	http.HandleFunc("/factomdBatch", factomdBatchHandler)
+	http.HandleFunc("/login", loginHandler)
+	http.HandleFunc("/logout", logoutHandler)
+	http.HandleFunc("/audit", auditHandler)
 
 	tlsIsEnabled, tlsPrivate, tlsPublic := StatePointer.GetTlsInfo()
 	if tlsIsEnabled {
@@ -171,6 +179,11 @@ func noStaticFilesFoundHandler(w http.ResponseWriter, r *http.Request) {
 // For all static files. (CSS, JS, IMG, etc...)
 func static(h http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// The login page needs the static files before there is a session
+		if ControlPanelAuth != nil && strings.ContainsRune(r.URL.Path, '.') {
+			mux.ServeHTTP(w, r)
+			return
+		}
 		if false == checkControlPanelPassword(w, r) {
 			return
 		}
@@ -198,7 +211,11 @@ func indexHandler(w http.ResponseWriter, r *http.Request) {
 	if len(GitAndVer.GitBuild) == 0 {
 		GitAndVer.GitBuild = "Unknown (Must install with script)"
 	}
-	err := templates.ExecuteTemplate(w, "indexPage", GitAndVer)
+	page := *GitAndVer
+	if user := requestUser(r); ControlPanelAuth != nil && user != nil {
+		page.User = user.Name
+	}
+	err := templates.ExecuteTemplate(w, "indexPage", page)
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -240,7 +257,9 @@ type SearchedStruct struct {
 	Type    string      `json:"type"`
 	Content interface{} `json:"item"`
 
-	Input string `json:"input"`
+	Input   string `json:"input"`
+	Page    int    `json:"page"`    // The page of a chain or address
+	Receipt string `json:"receipt"` // The type of a receipt
 }
 
 func searchHandler(w http.ResponseWriter, r *http.Request) {
@@ -260,6 +279,8 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 		searchResult.Type = r.FormValue("type")
 	}
 	searchResult.Input = r.FormValue("input")
+	searchResult.Page, _ = strconv.Atoi(r.FormValue("page"))
+	searchResult.Receipt = r.FormValue("receipt")
 	HandleSearchResult(searchResult, w)
 }
 
@@ -297,11 +318,20 @@ func factomdHandler(w http.ResponseWriter, r *http.Request) {
 	if false == checkControlPanelPassword(w, r) {
 		return
 	}
+	item := r.FormValue("item")   // Item wanted
+	value := r.FormValue("value") // Optional argument
+	if item == ActionDisconnect {
+		// Changes state, so a link or image on another site must not trigger it
+		if r.Method != "POST" {
+			http.Error(w, "405 Method Not Allowed.", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Write(disconnectAction(r, value))
+		return
+	}
 	if r.Method != "GET" {
 		return
 	}
-	item := r.FormValue("item")   // Item wanted
-	value := r.FormValue("value") // Optional argument
 	data := factomdQuery(item, value, false)
 	w.Write([]byte(data))
 }
@@ -398,24 +428,37 @@ func factomdQuery(item string, value string, batchQueried bool) []byte {
 			}
 		}
 		return data
-	case "disconnect":
-		hash := ""
-		if len(value) > 0 {
-			hash = hashPeerAddress(value)
-		}
-		DisplayStateMutex.RLock()
-		CPS := DisplayState.ControlPanelSetting
-		DisplayStateMutex.RUnlock()
-		if CPS == 2 {
-			disconnectPeer(value)
-			return []byte(`{"Access":"granted", "Id":"` + hash + `"}`)
-		} else {
-			return []byte(`{"Access":"denied", "Id":"` + hash + `"}`)
+	case "metrics": // Value is the time of the last sample the charts have, in Unix milliseconds
+		since, _ := strconv.ParseInt(value, 10, 64)
+		data, err := json.Marshal(StatePointer.MetricsHistory.Get(since))
+		if err != nil {
+			return []byte(`{"samples":[],"blocks":[],"minutes":[]}`)
 		}
+		return data
 	}
 	return []byte("")
 }
 
+// disconnectAction disconnects the peer if the control panel is readwrite and the user's role
+// allows it. Every attempt goes to the audit log.
+func disconnectAction(r *http.Request, value string) []byte {
+	hash := ""
+	if len(value) > 0 {
+		hash = hashPeerAddress(value)
+	}
+	DisplayStateMutex.RLock()
+	CPS := DisplayState.ControlPanelSetting
+	DisplayStateMutex.RUnlock()
+	user := requestUser(r)
+	if CPS == 2 && user.Can(ActionDisconnect) && sameOrigin(r) {
+		disconnectPeer(value)
+		ControlPanelAudit.Record(r, user, ActionDisconnect, value, "granted")
+		return []byte(`{"Access":"granted", "Id":"` + hash + `"}`)
+	}
+	ControlPanelAudit.Record(r, user, ActionDisconnect, value, "denied")
+	return []byte(`{"Access":"denied", "Id":"` + hash + `"}`)
+}
+
 func disconnectPeer(hash string) {
 	if Controller != nil {
 		fmt.Println("ControlPanel: Sent a disconnect signal.")
@@ -784,6 +827,9 @@ func doEvery(d time.Duration, f func(time.Time)) {
 }
 
 func checkControlPanelPassword(response http.ResponseWriter, request *http.Request) bool {
+	if ControlPanelAuth != nil {
+		return checkSession(response, request)
+	}
 	if false == checkAuthHeader(request) {
 		remoteIP := ""
 		remoteIP += strings.Split(request.RemoteAddr, ":")[0]
@@ -182,6 +182,80 @@ func TestSearching(t *testing.T) {
 	}
 }
 
+func TestExplorer(t *testing.T) {
+	InitTemplates()
+	s := CreateAndPopulateTestState()
+	StatePointer = s
+
+	// A directory block with an entry block, and the first entry of its chain
+	var dblock, chainID, entryHash string
+	for i := uint32(0); entryHash == ""; i++ {
+		d, err := s.DB.FetchDBlockByHeight(i)
+		if err != nil || d == nil {
+			t.Fatalf("No entries found")
+		}
+		for _, ebhash := range d.GetEBlockDBEntries() {
+			eblock, err := s.DB.FetchEBlock(ebhash.GetKeyMR())
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			for _, ehash := range eblock.GetEntryHashes() {
+				if !ehash.IsMinuteMarker() {
+					dblock = d.GetKeyMR().String()
+					chainID = ebhash.GetChainID().String()
+					entryHash = ehash.String()
+					break
+				}
+			}
+			if entryHash != "" {
+				break
+			}
+		}
+	}
+	_, _, fa := NewFactoidAddressStrings(0)
+
+	for _, test := range []struct {
+		Search   SearchedStruct
+		Expected []string
+	}{
+		{SearchedStruct{Type: "chainhead", Input: chainID}, []string{chainID, "Page 1 |", "receipt=entry"}},
+		{SearchedStruct{Type: "chainhead", Input: chainID, Page: 1000}, []string{chainID}},
+		{SearchedStruct{Type: "dblock", Input: dblock}, []string{entryHash, "Factoid Transactions", "Entry Credit Transactions"}},
+		{SearchedStruct{Type: "FA", Input: fa}, []string{fa, "Factoid Transaction", "receipt=factoid"}},
+		{SearchedStruct{Type: "EC", Input: NewECAddressString(0)}, []string{"Entry Credits", "History"}},
+		{SearchedStruct{Type: "receipt", Input: entryHash, Receipt: "entry"}, []string{entryHash, "Directory Block"}},
+	} {
+		content, err := searchfor(&test.Search)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if strings.Contains(string(content), "It seems there has been an error while ") {
+			t.Errorf("%s %s was not found", test.Search.Type, test.Search.Input)
+			continue
+		}
+		for _, e := range test.Expected {
+			if !strings.Contains(string(content), e) {
+				t.Errorf("%s %s does not contain %s", test.Search.Type, test.Search.Input, e)
+			}
+		}
+	}
+
+	// Not found
+	for _, c := range []SearchedStruct{
+		{Type: "chainhead", Input: primitives.RandomHash().String()},
+		{Type: "receipt", Input: entryHash, Receipt: "nothing"},
+		{Type: "FA", Input: NewECAddressString(0)},
+	} {
+		content, err := searchfor(&c)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if !strings.Contains(string(content), "It seems there has been an error while ") {
+			t.Errorf("Expected %s %s to not be found", c.Type, c.Input)
+		}
+	}
+}
+
 // Trip some code that is sometimes tested in other unit tests. These lines don't have
 func TestRequest(t *testing.T) {
 	LastRequest = time.Time{}
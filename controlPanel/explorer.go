@@ -0,0 +1,473 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/FactomProject/btcutil/base58"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts"
+)
+
+// The chain, address and directory block pages of the explorer, for
+// support staff answering questions without an external explorer
+
+var (
+	ExplorerPageSize     int    = 25   // Entries, or address transactions, on a page
+	AddressHistoryBlocks uint32 = 1000 // Blocks searched for the transactions of an address, per page
+)
+
+// Pagination is where a page is in a longer list.  Pages count from 0.
+type Pagination struct {
+	Page  int
+	Pages int
+	Total int
+}
+
+func newPagination(page int, total int, size int) Pagination {
+	p := Pagination{Total: total}
+	p.Pages = (total + size - 1) / size
+	if p.Pages == 0 {
+		p.Pages = 1
+	}
+	if page < 0 {
+		page = 0
+	}
+	if page >= p.Pages {
+		page = p.Pages - 1
+	}
+	p.Page = page
+	return p
+}
+
+func (p Pagination) Number() int       { return p.Page + 1 }
+func (p Pagination) HasPrevious() bool { return p.Page > 0 }
+func (p Pagination) HasNext() bool     { return p.Page+1 < p.Pages }
+func (p Pagination) Previous() int     { return p.Page - 1 }
+func (p Pagination) Next() int         { return p.Page + 1 }
+
+// slice returns the start and end of the page in its list
+func (p Pagination) slice(size int) (int, int) {
+	start := p.Page * size
+	end := start + size
+	if end > p.Total {
+		end = p.Total
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+type ChainHolder struct {
+	ChainID     string
+	Head        string // KeyMR of the newest entry block
+	EBlockCount int
+	EBlocks     []ChainEBlockHolder // The entry blocks of the entries on the page, newest first
+	ChainPagination
+}
+
+// ChainPagination is where a page is in the entries of a chain.  They are not
+// counted, as that reads the whole chain, so only whether older entries
+// follow the page is known.  Pages count from 0.
+type ChainPagination struct {
+	Page int
+	More bool
+}
+
+func (p ChainPagination) Number() int       { return p.Page + 1 }
+func (p ChainPagination) HasPrevious() bool { return p.Page > 0 }
+func (p ChainPagination) HasNext() bool     { return p.More }
+func (p ChainPagination) Previous() int     { return p.Page - 1 }
+func (p ChainPagination) Next() int         { return p.Page + 1 }
+
+type ChainEBlockHolder struct {
+	KeyMR    string
+	DBHeight uint32
+	Sequence uint32
+	Entries  []EntryHolder
+}
+
+// getChainPage returns a page of the entries of a chain, newest first, with
+// the entry blocks they are in.  The entry blocks are read back from the head
+// only until the page is filled.
+func getChainPage(chainIDString string, page int) *ChainHolder {
+	chainID, err := primitives.HexToHash(chainIDString)
+	if err != nil {
+		return nil
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	holder := new(ChainHolder)
+	holder.ChainID = chainID.String()
+	holder.Page = page
+
+	// The entries of the page, and the entry block each is in
+	type chainEntry struct {
+		hash interfaces.IHash
+		eblk interfaces.IEntryBlock
+	}
+	var entries []chainEntry
+	skip := page * ExplorerPageSize
+
+	dbase := StatePointer.GetAndLockDB()
+	head, err := dbase.FetchHeadIndexByChainID(chainID)
+	if err != nil || head == nil {
+		StatePointer.UnlockDB()
+		return nil
+	}
+	holder.Head = head.String()
+	eblk, err := dbase.FetchEBlock(head)
+	if err != nil || eblk == nil {
+		StatePointer.UnlockDB()
+		return nil
+	}
+	holder.EBlockCount = int(eblk.GetHeader().GetEBSequence()) + 1
+	for eblk != nil {
+		hashes := eblk.GetEntryHashes()
+		for j := len(hashes) - 1; j >= 0; j-- {
+			if hashes[j].IsMinuteMarker() {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			if len(entries) == ExplorerPageSize {
+				holder.More = true
+				break
+			}
+			entries = append(entries, chainEntry{hashes[j], eblk})
+		}
+		prev := eblk.GetHeader().GetPrevKeyMR()
+		if holder.More || prev.IsZero() {
+			break
+		}
+		eblk, err = dbase.FetchEBlock(prev)
+		if err != nil {
+			StatePointer.UnlockDB()
+			return nil
+		}
+	}
+	StatePointer.UnlockDB()
+
+	for _, e := range entries {
+		n := len(holder.EBlocks)
+		if n == 0 || holder.EBlocks[n-1].Sequence != e.eblk.GetHeader().GetEBSequence() {
+			blk := ChainEBlockHolder{
+				DBHeight: e.eblk.GetHeader().GetDBHeight(),
+				Sequence: e.eblk.GetHeader().GetEBSequence(),
+			}
+			if keymr, err := e.eblk.KeyMR(); err != nil {
+				blk.KeyMR = "Error"
+			} else {
+				blk.KeyMR = keymr.String()
+			}
+			holder.EBlocks = append(holder.EBlocks, blk)
+			n++
+		}
+		ent := getEntry(e.hash.String())
+		if ent == nil {
+			ent = new(EntryHolder)
+			ent.Hash = e.hash.String()
+			ent.Content = "Entry not found"
+		}
+		holder.EBlocks[n-1].Entries = append(holder.EBlocks[n-1].Entries, *ent)
+	}
+	return holder
+}
+
+type AddressHolder struct {
+	Address string
+	Type    string // FA or EC
+	Balance string
+	History []AddressTransaction // Newest first
+
+	// The blocks searched, newest first.  The pages are of blocks.
+	From uint32
+	To   uint32
+	Pagination
+}
+
+// AddressTransaction is a transaction of an address, that changed its
+// balance by Amount.  Receipt is the type of receipt of the transaction.
+type AddressTransaction struct {
+	Height  uint32
+	DBlock  string // KeyMR of the directory block at Height
+	TxID    string
+	Type    string
+	Amount  string
+	Receipt string
+}
+
+// getAddressPage returns the balance of a human readable FA or EC address,
+// and the transactions it made in a page of the saved blocks, AddressHistoryBlocks
+// blocks to a page, newest first
+func getAddressPage(address string, page int) *AddressHolder {
+	var ec bool
+	switch {
+	case primitives.ValidateFUserStr(address):
+	case primitives.ValidateECUserStr(address):
+		ec = true
+	default:
+		return nil
+	}
+	hash := base58.Decode(address)
+	if len(hash) < 34 {
+		return nil
+	}
+	var fixed [32]byte
+	copy(fixed[:], hash[2:34])
+
+	holder := new(AddressHolder)
+	holder.Address = address
+	if ec {
+		holder.Type = "EC"
+		holder.Balance = fmt.Sprintf("%d", StatePointer.FactoidState.GetECBalance(fixed))
+	} else {
+		holder.Type = "FA"
+		holder.Balance = fmt.Sprintf("%.8f", float64(StatePointer.FactoidState.GetFactoidBalance(fixed))/1e8)
+	}
+
+	top := StatePointer.GetHighestSavedBlk()
+	holder.Pagination = newPagination(page, int(top)+1, int(AddressHistoryBlocks))
+	holder.From = top - uint32(holder.Page)*AddressHistoryBlocks
+	holder.To = 0
+	if holder.From >= AddressHistoryBlocks {
+		holder.To = holder.From - AddressHistoryBlocks + 1
+	}
+
+	dbase := StatePointer.GetAndLockDB()
+	defer StatePointer.UnlockDB()
+	for height := int64(holder.From); height >= int64(holder.To); height-- {
+		var history []AddressTransaction
+		if ec {
+			ecblk, err := dbase.FetchECBlockByHeight(uint32(height))
+			if err != nil || ecblk == nil {
+				continue
+			}
+			history = ecTransactions(ecblk, fixed)
+		} else {
+			fblk, err := dbase.FetchFBlockByHeight(uint32(height))
+			if err != nil || fblk == nil {
+				continue
+			}
+			history = factoidTransactions(fblk, fixed)
+		}
+		if len(history) == 0 {
+			continue
+		}
+		if keymr, err := dbase.FetchDBKeyMRByHeight(uint32(height)); err == nil && keymr != nil {
+			for i := range history {
+				history[i].DBlock = keymr.String()
+			}
+		}
+		holder.History = append(holder.History, history...)
+	}
+	return holder
+}
+
+// factoidTransactions returns the transactions of an fblock that spend from,
+// or pay to, a factoid address
+func factoidTransactions(fblk interfaces.IFBlock, address [32]byte) []AddressTransaction {
+	var history []AddressTransaction
+	for _, trans := range fblk.GetTransactions() {
+		var in, out uint64
+		found := false
+		for _, input := range trans.GetInputs() {
+			if input.GetAddress().Fixed() == address {
+				in += input.GetAmount()
+				found = true
+			}
+		}
+		for _, output := range trans.GetOutputs() {
+			if output.GetAddress().Fixed() == address {
+				out += output.GetAmount()
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		t := AddressTransaction{
+			Height:  fblk.GetDatabaseHeight(),
+			TxID:    trans.GetSigHash().String(),
+			Type:    "Factoid Transaction",
+			Receipt: receipts.ReceiptTypeFactoid,
+		}
+		if out >= in {
+			t.Amount = fmt.Sprintf("+%.8f", float64(out-in)/1e8)
+		} else {
+			t.Amount = fmt.Sprintf("-%.8f", float64(in-out)/1e8)
+		}
+		history = append(history, t)
+	}
+	return reverseTransactions(history)
+}
+
+// ecTransactions returns the commits an entry credit address paid for, and
+// the entry credits bought for it, in an ecblock
+func ecTransactions(ecblk interfaces.IEntryCreditBlock, address [32]byte) []AddressTransaction {
+	var history []AddressTransaction
+	for _, entry := range ecblk.GetEntries() {
+		t := AddressTransaction{
+			Height:  ecblk.GetDatabaseHeight(),
+			TxID:    entry.Hash().String(),
+			Receipt: receipts.ReceiptTypeEntryCredit,
+		}
+		switch e := entry.(type) {
+		case *entryCreditBlock.CommitChain:
+			if *e.ECPubKey != address {
+				continue
+			}
+			t.Type = "Chain Commit"
+			t.Amount = fmt.Sprintf("-%d", e.Credits)
+		case *entryCreditBlock.CommitEntry:
+			if *e.ECPubKey != address {
+				continue
+			}
+			t.Type = "Entry Commit"
+			t.Amount = fmt.Sprintf("-%d", e.Credits)
+		case *entryCreditBlock.IncreaseBalance:
+			if *e.ECPubKey != address {
+				continue
+			}
+			// The factoid transaction that bought the credits has the receipt
+			t.Type = "Purchase"
+			t.TxID = e.TXID.String()
+			t.Amount = fmt.Sprintf("+%d", e.NumEC)
+			t.Receipt = receipts.ReceiptTypeFactoid
+		default:
+			continue
+		}
+		history = append(history, t)
+	}
+	return reverseTransactions(history)
+}
+
+func reverseTransactions(history []AddressTransaction) []AddressTransaction {
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+	return history
+}
+
+type ReceiptHolder struct {
+	Hash    string
+	Type    string
+	Receipt *receipts.Receipt
+	Valid   string
+	JSON    string
+}
+
+// getReceipt returns the receipt of an entry, factoid transaction or entry
+// credit commit, linking it to its directory block
+func getReceipt(hash string, receiptType string) *ReceiptHolder {
+	h, err := primitives.HexToHash(hash)
+	if err != nil {
+		return nil
+	}
+	switch receiptType {
+	case "", receipts.ReceiptTypeEntry, receipts.ReceiptTypeFactoid, receipts.ReceiptTypeEntryCredit:
+	default:
+		return nil
+	}
+
+	dbase := StatePointer.GetAndLockDB()
+	receipt, err := receipts.CreateReceiptOfType(dbase, receiptType, h)
+	StatePointer.UnlockDB()
+	if err != nil || receipt == nil {
+		return nil
+	}
+
+	holder := new(ReceiptHolder)
+	holder.Hash = h.String()
+	holder.Type = receipt.Type
+	if holder.Type == "" {
+		holder.Type = receipts.ReceiptTypeEntry
+	}
+	holder.Receipt = receipt
+	if err := receipt.Validate(); err != nil {
+		holder.Valid = err.Error()
+	} else {
+		holder.Valid = "Valid"
+	}
+	data, err := json.MarshalIndent(receipt, "", "  ")
+	if err != nil {
+		return nil
+	}
+	holder.JSON = string(data)
+	return holder
+}
+
+type DblockTransaction struct {
+	TxID       string
+	Inputs     int
+	Outputs    int
+	ECOutputs  int
+	TotalInput string
+}
+
+type DblockCommit struct {
+	Type      string
+	Hash      string
+	EntryHash string
+	Credits   string
+}
+
+// addDblockContents adds the transactions, commits and admin entries of a
+// directory block's other blocks to its holder
+func addDblockContents(holder *DblockHolder) {
+	if ablk := getAblock(holder.AdminBlock.KeyMr); ablk != nil {
+		holder.AdminEntries = ablk.ABDisplay
+	}
+
+	fkeymr, ferr := primitives.HexToHash(holder.FactoidBlock.KeyMr)
+	eckeymr, ecerr := primitives.HexToHash(holder.EntryCreditBlock.KeyMr)
+
+	dbase := StatePointer.GetAndLockDB()
+	defer StatePointer.UnlockDB()
+
+	if ferr == nil {
+		if fblk, err := dbase.FetchFBlock(fkeymr); err == nil && fblk != nil {
+			for _, trans := range fblk.GetTransactions() {
+				in, _ := trans.TotalInputs()
+				holder.FactoidTransactions = append(holder.FactoidTransactions, DblockTransaction{
+					TxID:       trans.GetSigHash().String(),
+					Inputs:     len(trans.GetInputs()),
+					Outputs:    len(trans.GetOutputs()),
+					ECOutputs:  len(trans.GetECOutputs()),
+					TotalInput: fmt.Sprintf("%.8f", float64(in)/1e8),
+				})
+			}
+		}
+	}
+
+	if ecerr == nil {
+		if ecblk, err := dbase.FetchECBlock(eckeymr); err == nil && ecblk != nil {
+			for _, entry := range ecblk.GetEntries() {
+				c := DblockCommit{Hash: entry.Hash().String()}
+				switch e := entry.(type) {
+				case *entryCreditBlock.CommitChain:
+					c.Type = "Chain Commit"
+					c.EntryHash = e.EntryHash.String()
+					c.Credits = fmt.Sprintf("%d", e.Credits)
+				case *entryCreditBlock.CommitEntry:
+					c.Type = "Entry Commit"
+					c.EntryHash = e.EntryHash.String()
+					c.Credits = fmt.Sprintf("%d", e.Credits)
+				case *entryCreditBlock.IncreaseBalance:
+					c.Type = "Purchase"
+					c.Credits = fmt.Sprintf("%d", e.NumEC)
+				default:
+					continue
+				}
+				holder.Commits = append(holder.Commits, c)
+			}
+		}
+	}
+}
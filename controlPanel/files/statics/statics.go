@@ -24,11 +24,11 @@ type staticFilesFile struct {
 
 var staticFiles = map[string]*staticFilesFile{
 	"css/app.css": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xacY\xddn\xa4:\x12\xbe\x0eO\xe1\xd5h\xa43\xb3\r\x03tӝ\xa6ur\xb1:\xbb/q4\x17\x06L\xb0\xc6\xd8ȘIg\xa2\xbc\xfb\xca\xc6\x06\x03\x86\xee쬸Hp\x95\xeb\xcfU\x1f\xe5\xeao_\x01G-\x12-\xf8\xfa\xcd\xf32V\xbc\x827\xe0\x01\x00@\x06\xf3\x1fϜu\xb4\xf0sF\x18O\xc1\xa78\x94\xcfE\x91KF\x85\xdf\xe2_(\x05ѱ\xb9^\xbcwσ\xe0\r\x18\xde}~:\xc3\xf8\x02\x04\x87\xb4\xc5\x023\x9a\x02\x04[\x04\xc2 Jڞ\xed\x02\xde=\x98V\xec'\xe2\xd6N\xf4\x98%q$i^\x15\xed@\x15\xef@\xb5߁\xea\xb0\x03U\xb2\x03\xd5q\a\b\xcc\x10\xd9\x01m\xae\xd9xޟ\x93\xf3\xa3\xdcXE\xe0mn\xe5>\x96V\x0e\x8b/\b?W\"\x05\x94\xf1\x1a\x92\x9e \xd0U\xf8\xca\xe0\x92\xf1:\x05]\xd3 \x9e\xc3\x16I\xef\xaa\b\xb45$DK\x9e)u\xc7\xc4%\x942\xaa\xe4y\x01\x87\xf4\x87\xcfQa\xb9P\x1c\xe2\xc7X\xb9\xd0S\x9f\x19\xb1\xc9e\tQ\x18Zd\x8e\x10\xb5\xe8q\x06\x938S\xa1\v\xb2N\bFw\xc0\xfcŴ\xe9\xc4\xdf\xe2\xb5A\u007f\xb6]Vc\xf1]{\xe2:\xe8\xc7\xc39)/\x13G\x8f\xf99ϊ;\x02\xa55\xf7\xe7j\xf4\x9b\xb7\xa5\x15\xc3\xf9;\xec8D\xa7\f\x1e\x94\xbf\xbd\x98\x00\x12ą\x9b9.\xa22*/\x8et\x98l\xde\xd2w\x8a\xe3S|\xbaX\xf1.U0-\xab\xa5\xebߧ~ \xc8\xf3j\xba\x86j\x88\xc9t\xa9\x81m\xfb\xc2x\xf1}\aZDP.v*\x8c\x90#\xa8\x0f\xa2\x81E\x81\xe9\xb3OP)R\x10qT_f\a$\xbd,\xe5\xa3\t\x8c\x17\x88\x9b\x94\x1aW|\x0e\vܵ)x4I\x98\xb1\xab\xdfV\xb0`/6\xb7q\x13B(\x0fn\xeeeZ\xb2\xbck]\xbe:(\xbd\xc7\x0e\xc2\u0de1\xf5ޛ7\x13\x83\xfe}\x91\x92\x12IJ\xf9\xac{<\xf7\xec\xddS\xf0\xa0EՐ?c\xeagL\bV\xa7\xe00\x01\x81)~\xa5\xa9\xff\x82\xb2\x1fX\xf8\xca|\xbf!0G\x15#\x85Lܩ\xff\xeb\x9cV5&\xa5|.Z\x95x%(\x05X@\x82s\x99T\xa9_\xb3_[:\xe6\xf4\xfb%\xdf\x14\xfd\x1b\xb2\xfd\xba\xbd\x1d\x1e'\xd3\xdd:\x82~\xabL\x80\xc6$\xc4J^\xcbR.\x89<\xfb\n\x17\x05\xa2\n\u007f\xec\xed\xcb\xca]#\x0fe\xbc\xc6`jz\x8d>$\xbaĖ\xa9\xbd\xe1\xdc-_\xa7\xa8\x13\xf4\xa0|.\xd3\\w\xa1\x9a\x80Y;\x11ax\xa7;5\xa7/\xb0 \b\xbc\x99\x8a\xb0PƉ0\xae\x98/\xe3=\x95\x9e\x96\x98\xb7\xc2\xcf+\xac>[\xbd&\xe3\xfe\xc8\xf6\x04M\xf5O\x16\xff\x86\x1cC\xbf\x87\aT\xfc)x\x87\xbe\xcf\xfc3_\xa69BO\x85/\x11^\x86\xf5$\x9f\xad\x9d\xc0\xee_$&\x0e\xe4\x9cQ\x81\xa8\xf8@\xb0\x1bH\xd5\xf9jL\xef\x03\r\xc2>\x14\x02f\x04\x01Q!X\xdc\xf7\r^\xc2\xde\xe6\x17ؖ/*\xd3\xd0َ/ \xf0\xb0\xd9\x1c\r\"\xfb\x8ek\xd5\xe4\xd5o\xd3T\x80\xe0)\x15U\x9f&\u007f\xa0\x9f\x88~qW\xc2\xfe(\x1f+bz7\x102\xbb\xe6\xe6\x8fl%cb\xddJ\x9d\b[V*\x01\xa2\x98\xb5z\xffC\xe0\xee=*\xe9\x92]<;0\x12\xaaYU\xcdڄ Q%\xfc\x82\vQ\xa5 \n\xc3Ϫe\xf1\xbco_A\u07b5\x82\xd5@!l\xdf\xe1\a%\xcc\x05\xab\xfd\x1e\xed|\xc49\xe3\xe0\xcd{\x18J\x15\xfc\x03\xd7\r\xe3\x02Rq\xf1\x1e\xb4\xd4\xe4\x146\xd7\tE6\xe7\bJT\xff'\b8{\x19q\xc5|iU\xbf--\xf9DX\x0eI\xef\xd0\x0e\xd8o}\x8a\xce\xd7\xe4!\xcf\xd7\xfa\x135N\x9e\xa49R\xf6o\t+p\xdb\x10\xf8\x9a\x82\x8c\xb0\xfc\x87-N\x17\xce\xf4@\f\xb1\xcf\xc1\xc2Mԉ3;1s6\xfb\xc3\xd4l\xa3g,\x86\xf8\xcbR\x91\x9bj4\xd9\xd4Q\xd5cxK\xd3~S\xd3~S\xd3\xde\xd2\x14\xddRt\xd8Tt\xd8Tt\xb0\x15E7}J6U%\x9b\xaa\x12[Urӫ㦪㦪\xe3DUrK\xd5iS\xd5iS\xd5i\xa2\xea\xe0\xaa\x1as\xe7\u007f\x98ՃĄ\xab_i@\x8b\x8f\xaa\xe7z0\r\x80\xff\x9a\x826猨\xaf\xc3'\x05o0\x97\xd7\xfcv\xa8\xf4\xe5\xe2P\xa3.\x92.U\x17iZ\xfeQ4\xd4\xff\xff]\x85\x03\x14\xd6\x04\xdd\x0eZ\x12\x86[Ak \xfd\x8fDc\\\xb4k\x983aq \xcf\x18\x94cbb\xe2\x14;\x03\x11\xa7\\7\x94D\xa7\xe4>\xc1\xfb;\x04O\x90\xe3x\xa7Ň;\x04\x1f\xd6,\xfe7\x15\x1c\xa3\xad\x00\x0f\x1c\x9b\xf1M\xe2ӆ\xd0ex\x17R\xddѽW\xec\xfe\xb6X;\xb6\xe7\xf3(\xf5/\xccQ.\x18\u007f\xfd\x97Lэ@\xcc\x197㱷\xf3mM\xc52,k:\xd6>c{\xad\xa4\x80\x02\xfe\xd5Ս=3y\xa81]\xd4ڤ\xe7\x0f\xc3p\xa5\xfc\x1eT\xbbV\xc2\x1a\x93\xd7\x14Ԍ\xb2\xb6\x81\xb9j\xc9>\xa1kC\x18G\\\x17\xfb[\xff\xd7'\xf0\x95u\"\x05%\xbe\xa2B\x195cT]\xe9xC\x82\x9d`n\xb6\x95\xf6 Q\x9d\x9bn\xab&#\xa1\x14\xc4qs\x05!H\xf4\xa8B3\x05}\x1bg5\x85\x825)\x88L\xeb\xd5\"\x05[\xeeQ\x88\xea\x11FYO\xaa\x8f\xd3C\xd5'`\xb6>\r\xed\x1d\xa6\xbe\xb14\x0e\xf5\xe1\x1b\xaea\xe0:\xf6\x91\xa1\x9e\xb8\x8eLA\x8d\x04ǹۚ\xf8\xd1\xcd\x1c4\x9c=sԚ\xd1\xd0\xf0A\n\x0e\xfbS☑\r}~t\x92\xcf\xe5\xc3\xf3\x83u\v\x86\xff\xfc\x1a\x89ጜ\x83\xf2\xfd9\x8a>$,\xa8Q\x81\xbbze$p\xdc?\x86\xa5\x1d\xf0\xdb\xf2\x88:5\x97\xb0$\n\xa3\xf0C\xc2\xe6ﾬB\xed\xfe\xe4\x1a\xd2_xƻ\x8e\xfa\x97@\x81\xfe\b?\uf01f\x84\x9f\xbf\\\xee\x1f\x9f/-\xec+H\xcfs\xe7\x13\x06\xeb\xb2\xce\x191W\xf0\xc9u\xa9\x1fw\x98%\x93{\xfd\xea\xbb\x17\x14]\xdd\xf8\x02foҜ\xe5\x88T\x19I\x18\x14\xa9\xf2\xba_\x98w\x00*&\x98\xa2\x01\x99\xa2\x85w\x81\x9d\xb8\xf3IѴ\x8c\x14 )\xb3\xa4[\x10\xd3\x11\x1a\x98\xfeY\x85#\x02\x05\xfe\x89$g\xd9\x11\xd2\xe6\x1c!\xea\xb3FR{\xde_>\xa6\x05\xba\xa6`\xbfR-/\x15\x16z\xb2\xa0͎\a\v\xb9z\x8f\x92\xe1\a\r\t1\xa1y\xcb;\xde\xca\b5\fS\x81\xf8\x8a|L+ı\xf0ށ\x17\xc0\xace\xa4\x13\xc8_\x18+q\xccx\x05\f\x9b:\x18\x85\xb976\f\xb8\xbc\b\xd8\x13\bƭ\xb3\x88ė\xfe\xa0'\xf7\xe7\t\xd2XK\veV@\xf4K_\x0e\xea\xed\xdd\x03\xc0\v(\x13~\xa9Ba.\xdb.IV\xa4A\x12~\xb6\"\xadޔS\xb8\xcd\x19\xa5(\x1f[\xe1sh\xee\xd8\rB\xdc/:\x0euX\xe6Ǣ>Ԓ\a7[Զ\x9fr\xad\xd29\xca\x11\xfe\xd9\xffd\xb5\xe4\xf9o\x00\x00\x00\xff\xff\xbcg\xeeIM\x1c\x00\x00",
-		hash:  "0ac97184badb3ed10d956c6cdaa5c692615e83f85aebf93dc268604a17da953f",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xac\x19ێ\xa3:\xf2\xb9\xf9\x8aZ\xb5F:3\x1b\x18 !\x17\xa23\x0f\xbbg\xf7'\x8e\xe6\xc1\x81\xa2\xb1\xc6\xd8\xc88\xd3\xe9\x89\xfa\xdfW6\x98pOZ\xb3\xe2\xa1ۮrݫ\\\xae|\xfd\x02\x12+T\x15|\xf9\xea8'\x91\xbe\xc1\x15\x1c\x00\x80\x13I~\xbcHq橛\b&d\fϡ\xaf\xbf\xa3\x01g\x82+\xb7\xa2\xbf0\x86`[^\x8eλ\xe3\x10\xb8\x82\xc5]'\xbb\x03\t\x8f\xa0$\xe1\x15UT\xf0\x18\x90T\b\xbe\x17DU\x8dv\x84w\x87Ĺ\xf8\x89\xb2s\x12\xf7\xa7(\f4\xccɃ\x15\xe4\xe1\n\xf2\xf5\n\xf2\xcd\n\xf2h\x05\xf9v\x05\x8c\x9c\x90\xad\xa0\x11\xd7\x1e<\xac\x0f\xd1a\xaf\x0f\xe6\x01\\\x87R\xaeC-e\xbb\xf9\x8a\xf4%W1p!\v\xc2j\x80\u008br\x8d\xc0\x99\x90E\f\xe7\xb2D\x99\x90\n\xb5vy\x00UA\x18k(\x0f\x98N\xdbd\x8a(\x17\xdc\xd0s<I\xf8\x0fWb\xdaQ!݄\xfbШPC_\x04낳\x8c\xa0\xefw\xc0\x12\x91w\xe0\xe1\x89D\xe1ɘ\xce;\x9d\x95\x12|\x05\xf6/\xe5\xe5Y\xfd\xad\xdeJ\xfc\xb3:\x9f\n\xaa\xbe\xc3u\xd6\xd1\xfb\xcd!ʎ=E\xb7\xc9!9\xa5\x0f\x18\xaa\xe1\\\xfbu\x05\xfd\xd5X\x8a\xd6\xff\x13rl\x82݉l\x8c\xbe5\x19\x8f0\x94j\x1a9L\x83,Ȏ\x13\xe1\xd0;\xbc\xc4o\x17\x86\xbbpw\xec\xd8;3\xc6\xecH\xadU\xff\xde\xd7\x03\x89L\xf2\xfe\x1e\x16\x84\xb2\xfeVI\xaa\xeaU\xc8\xf4\xfb\n*d\x98\xa8\x951#\x91H\x1aG\x94$M)\x7fq\x19f*\x86@bq\x1c8Hk\x99\xe9\xaf\x01\b\x99\xa2\xb4!u\xdbq%I鹊ao\x83\xf0$.n\x95\x93T\xbcv\xb1\xad\x9a\x84\x10\xed\xb8\xa1\x96q&\x92s5\xa5\xeb\x04\xa4\xd6x\x02\xd0\xeama\xb5\xf6vemP\xafG!\xa9+I\xa6\xbfy\x8d\x87\x9a\xbd;\xa6<4\xa4\n\"_(wOB)Qİ)/3\xb9\xfa\xeeı\xfb\x8a\xa7\x1fT\xb9F|\xb7d$\xc1\\\xb0T\an_\xffy\xccN6F\x99\xfe\x8e\r+\xf5\xc60\x06\xaa\b\xa3\x89\x0e\xaa\xd8-į%\x1eC\xf8\xe3\x94\xef\x92\xfe\r\xdanQ\xdd7\xcf$\xd2\xc3<\xbc\xfa\xa8\x0e\x80\x12\xae\x1d\xbf\x8f\xe3Z\xa7rƴ\xefs\x9a\xa6ȏ\xce\xe0\xf88s\xe7\xc0m\x1a\xcf!\u061c\x9e\x83\xb7\x81\x0eס\xbc\xfeP-\xb7\t\xd1ɢG\xf4w\xec\xc7\xfaTUS\xe4T\xf5HX\xdc\xfe\xc9\x06\xd3UT1\x84\xab͈N\x95\x99\xac0S6\x1fۻO=Ψ\xac\x94\x9b䔥-'\xab\xfe\r\xed\x1b\xb1\xd9\xdf\xdb\xfc\x9bHJܺ<`\xfa\xa7\x92g\xfc>\xd0\xcf\xdeL\xc3\n\xdd'>\xae\xf0ڬ;\xfd-\x9d\x84n\xff\xa2kb\vN\x04W\xc8\xd5\a\x8c]\x12n\xfc\xdb\xd4\xf4\xda\xd0\xe0צP\xe4\xc4\x10T\x8e$}\xec\x0e\x1e\x97\xbd\xc5\x1b\xb8K_嶡\xeb*>*\x81\x9b\xc5\xe6\xa8%Yw\\\xb3\"\xcf\xdeM}\x02J\xc6\\\xe5u\x98\xfc\x81?\x91\x7f\x9e΄\xf5V\x7fG\x18\x9e\x06\xa5\xa3k(\xfe\r-\x13B\xcdK\xd9\x04\u0092\x94\x86\x80J\xe1\xfa\xbb\x86{\xd4UZ\xa5n\xf2\xac\xe0\x06\xc8\aY5h\x13\xbcȤ\xf0+MU\x1eC\xe0\xfb\x9fL\xcb\xe28_\xbf@r\xae\x94(\xc0Tغ\xc3\xf72\x92(Q\xb8u\xb5sQJ!\xe1\xea<\xb5\xa9\n\xff\xa0E)\xa4\"\\\x1d\x9d\xa7\x86j\xb4\xf3\xcbK\x0f\xa2\x9bs$)J\xf8'xR\xbc\xc2uxӚ~[K\xf2\xccDBX\xad\xd0\n\xba\xab:D\x87{\xda\xc9ýڣV\xc9\xddίi\xff\x16\xb1\x94V%#o1\x9c\x98H~t\xc95\x89\xd3w\x88\x05\xd61\x98N\x03\x9b\xc0\x19x\xcc\xfaf\xbd)/S|n\xc9\x10~\x1e3\x9a\x86ZN]\xe8\x8d\xd5\u07bf\xc7i\xbd\xc8i\xbd\xc8i\xdd\xe1\x14\xdcc\xb4Yd\xb4Yd\xb4\xe92\n\xee\xea\x14-\xb2\x8a\x16YE]V\xd1]\xad\xb6\x8b\xac\xb6\x8b\xac\xb6=V\xd1=V\xbbEV\xbbEV\xbb\x1e\xab\xcdT\xd6\xd87\xff\xd3 \x1ftM\xb8\xb8yS\xd0\u00ad鹞l\x03\xe0\xbe\xc5P%R0s;<\x9b\xf2F\x12\xfd̯\xdaL\x1fo\xb69:\x05jRu\n\xd4O\xff h\xf3\xff\xff\xceb\xa2(\xcc\x11\xbao\xb4\xc8\xf7\x97\x8cV\x12\xfe_]\x8diZ\xcd՜\x1e\xcaD\xe5\xb9\x19e\x1bY\x9bL\x92\x1d\x14\x91I\xbaӥ$\xd8E\x8f\x11^?@\xb8W9\xb6\x0fJ\xbcy\x80\xf0fN\xe2\xffp%).\x19\xb8\xc5X\xb4o\x14\xee\x16\x88\x8e\xcd;\xa2:m\xddGɮ\xef\x93\xed\xda\xf6p\xb8Q\xfd\x8bJL\x94\x90o\xff\xd2!\xba`\x88!\xe2\xa2=\xd6\xddx\x9bc16\xcb\x1c\x8f\xb9kl\xdd0I\x89\"\x7f\x9d\x8b\xb2;3y*(\x1f\xe5Z\xaf\xe7\xf7}\x7f&\xfd\x9eL\xbb\x96\x91\x82\xb2\xb7\x18\n\xc1EU\x92Ĵd\xcfx)\x99\x90(\x9bd\xbf\xd6\x7f]F\xde\xc4YŐ\xd1\v\xa6G\x18#\x9a\xae\xf4\xf6B\"g%\xa6\xd1fڃ\xc8tnM[\xd5\x1b\t\xc5\x10\x86\xe5\x05|\x88\x9aQE\x83\xe4\xd5m\\\xa7)T\xa2\x8c!\xb0\xadW\x85\xa6lM\x8fB\xf6~\x8f\xd67\xd3\xc75C\xd5o`\x8f~k\xdb;\xca]+i\xe87ηXy\xd0\xe3\xa1\xfbH\xbf\x99\xb8ސ\xbc\x02\x95\xa4ɴ4\xe1~\x1a\xd9+\xa5x\x91X\xd9\xd1P{!y\x9b\xf5.\x9a\x98\x91\xb5}~\xb0\xd3\xdf\xf1\xc3\xf3\x83y\t\xda\xff\xdc\x02U\xeb\xa3\xc9A\xf9\xfa\x10\x04\x1f\"\xe6\x15\x98\xd2s13\x12خ\xf7~v\x84\x8f\xd0c\xe2u\x86X\x14\xf8\x81\xff!bõ\xab\xb3\xb0Q\xbf\xf7\f1;\x9d\xb7\x8e\xf9\x97\x11\x85\x7f\xf8\x9fV\xe0F\xfe\xa7\xcf\xc7\xc7\xc7\xe7c\t\xeb\fj\xe6\xb9\xc3\tC\xe7\xb1.\x05\xb3O\xf0\xdes\xa9\x1ew\xd8-\x1b{\xf5\xee\xbb\xe3\xa5\xe7\xa2t\x159]\x01\x00\xc6#R#$\x13D\xc5F\xebzc\xd8\x01\x18\x9bP\x8eme\nF\xday\xdd\xc0\x1dN\x8a\xfaid\n\x92\x11K\xabE(\xbf\x95\x06\xd1\xfc\xac\"\x91\x11E\x7f\xa2\xc6\xccΌU\x89D\xe4\xae(5\xb4\xc6\xfd\xe5R\x9e\xe2%\x86\xf5L\xb6\xbc\xe6T5\x93\x85F찕P\x9au\x10\xb5?h\xe8\x12\xe3\xdbUr\x96\x95\xb6P)(W(g\xe8S\x9e\xa3\xa4\xcay\a\xc7#\xa7J\xb0\xb3Bw$,\\oZ\x81E3\x8e15\xf7\u0381\xb6.\x8f\f\xf6\r\xbc\xdbсE\xc2c\xed\xe8\xde\xfb\xb9Wi:[#f\x1d\x834\x8b:\x1d\xcc\xea\xdd\x01p<.\x94\x9b\x19S\xd8\xc7\xf6\x14\xa5\x8e\xa5!\xf2?u,mVF)Z%\x82sLn\xad\xf0\xc1\xb7o\xec\x12Q\xba\xe9Y\x92\xc6,C\xb7\xc0{\x83C\xcb%hUO\xb9f\xe1\x12\x13\xa4?1\x9d\xc6\xd1#\x87\x7f\xe7Dֿ&>'\xf5\xbf\x9e\xf9;\x1e\x10\x84\xb6\x81\xe8\xe3%\x84\xff$U\xe7Z4淃\x9a\xa0\xbc@%\x18M\xe1\x19\x11\xc7\xc7]\x86/\xc8\a\xe3!\xdf\xdb\xeb\x04o\xa7\xcfQ\xa4\x0f\xfeo\x00[+\xd9\x1c\xfb\x1c\x00\x00",
+		hash:  "05fce11e43b1b35fd08770a4df3d15be08e1b739b6ca6160d9b3a4e353b113d8",
 		mime:  "text/css; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  7245,
+		mtime: time.Unix(1792361767, 0),
+		size:  7419,
 	},
 	"css/font-awesome.min.css": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xcc}M\x8f\xe4\xb8\xd1\xe6ݿ\"w\x06\xde\xe96J5%eV~T\xc1\xeb\xd9\x0f\x180`c\x0f\xf6a\x0f{\xa1\xa8P\x8a\x9d\x14\xa9!\xa9\xac\xcan\xf4\u007f\u007f!\x89AQYAy^`\x0e\xafa\xd8\xd5\xe4C\x8a\x1f\xc1`0\xe2!\xf3\xe7?\xfd\xb7?l\xfe\xb4\xd9\xfcU+\xb7\xf9\x9fo`u\v\x9b\xdd\xe3\xfeq\xbb)o\x9b_*v\x853S\xd5m\x93m\x1a纗\x9f\u007f\xae\xb5rl\x02>\n\xbd\xc96\xbfD)c]\u007f\x17\x1c\x94\x85T\x91\x9f\xa5\xcf\xff4|\xf4e\xf3Ͽ\xfd}\xf3\u007f\xff\xfa\xf7M\xfe\x98?l\xfe\xf7?\xff\xf9\xb2\xf9\xc7\xdf\xfe\x85\x95|\xfe\xc3\xe6O?\x8f_\xc8j\xc6\xe1\x9b\xff\xab\x15\xf2\xf6\xf2\xd3P\u07b7\xf9\xa7Wk\xf8Ko䧟\x1e\x1f\xc7\x0f\xda\xf8\xb3\xd9\x1b\x94\xc3?\x1fA\xbb\xbf\\\xff<v\xf0\xa7\xcf\xff\x892?\n\xa8\xc5\xfb\u007f\x0fE7\xb56-s\x9f~\x82\xb6\x84\xaa\x82*\xd3\x1d(w\xeb\xe0\xa7\xcf\x0f\xff\xbe\xca7]\xd7\xc5_>\xd66\xa6\xff\xe6\x1a\x12\x15\xfc\xa6\xf2\xceQŝ\xe9\xe17w\xc2^\xcfXŏQ\xbe\x81s/\x99\x89j\xb5\xd7\xf3O\x9f_ǩ{\x03qn܋\x1ar\xe4\x94d\xddM\x82O\xf9\xfeX\xb3o\x95\xb0\x9dd\xb7\x17\xa1\xa4P\x90\x95R\xf3\xcb\b\xf5\xa0\xcd\xf2\xff\xf2]\xf7\xfes\xbe\x89\x84\xc1\xd7+\xbe\u008bP\r\x18\xe1^\x1d\xbc\xbb̀\xaa\xc0\bu~a\xbdӯCG.\xc2e\x13\xba\xd5\xda5c\x9er\x82I\xc1,T\xafY\xab\xbffھ\xdfcΆ\xdd,g\x12\x86\x06g\xf2\xfcm\xfeb\xfe\xb8\xf5\xff\x81\xf6u\xec@3\xf5\xf9\xf1\xf0\f\xed\xeb\x15\x8c\x13\x9cɌIqV/Y\xfe\xfcǱ\x8e\xe2=\xaa\xa3\x80vL\xdcƉ[\x9f\xb8\x8b\x13w>\xf19N|\xf6\x89\xf5۷7Q\xb9\xe6%\u007f,\x8eχ|W\x9c\xa0\x9d\x86b\xfa:\a\xe5\xc0\x8c\xd8^~\xebXU\tu\xce$\xd4\xee\xe5\xe9\xb5e\xe6,\xd4\xf4\xaf\xe21\xdfMU\x8c\x9d\xb2~ֲAV^\x94V\xe0\xeb\xf8\x1fR|\xeb\xb4\x15Nh\xf5b@2'\xae~\x8c\xa2\fVZ-{\a\xafc\xdd٢\xf2\xa9\xc1\x8b$\xa7\xbb\x97ſ\xc9\x0eH\xe1\xa7b\xaa4\u007f\x9cz|\xdc\xfb\xc1(\xb5\xa9\xc0`'_\x1e\vh7\x8f\xc5\xf3\xf0\xbf\xf901S\xfe\x8b\xd5RT\x9bǧ#\xb4\x9b\x1f\x01\xc0\xa7g\x86U\xa2\xb7/\x8f\xb9\xaf\xae\xeb\xa5\x1c\xc7\xe6[-5s/ßs\x86\x19f\xdc\xe7\x8c\u007f\x0fY\xcbb~x\xcd$\x1b~r\xef*\x88\xa7`\x82\xa4jO5\xe7\xf1\xdf}p\xf5k5\xcbl'\xd47\\)L\x89\x96\x8dS\xe8s6\x85\xdd\bU\v%\x1cl\x06ag\xe6\xf5\xb7\x80p\xa4,\xacԝGŬ\x83\xce~:~~\xfdm\xb0\xef\xbf`\xb5\x17\xb8Ն\xb5`7ؙ\xa7?\x86o:Ô\x1d\xb4ԋю9\xf8\xf4T\xc1\xf9\xf3+\x9d\xfc=\u007fZ+\xba}>х}\xc6\xf7\xef\xbf\xfc\x17j\xcb0\xfeSZvz\xfa\x96\xb56\xab\x85t`^~\xe8\x8c>\x8b\xea\xe5\xff\xfc\xbf\xbf\xb5\xec\f\xff\xc2\x1a\x1e\xff!\xb8\xd1V\xd7\xee\xf1\u007f1+\xf8\x98\xfbi\xacBh\xf5\xe7\xfc\xf3\x0f\xaf\xc9朦\xae\f_I\xe5%\xd2\xe3v\xe6\xc7ߣ\xa1\xc5ZC\xf3\xe3JK13\x95\x11\xb7\xb58\xfc\x1emݮ\xb5\xb58\xac\xb4\x153S\x19\xd3\xf6 E\x975ڈ\xaf\xc3\xe6-\u007f\x87\x06?=lZa\x8c6\ty\x18\xb7\xccOY\xfe\xb0\xc9\xef\x1b\xbeȢ\x93\xe7F\xe3&\xfa{\xc8\xc3ojr\xfe\xb0\xc9\x12M\xf6Yt\xf2\xf7\x17\xa3\xb5\xdb,V\xdbÇ\xb4\xfcH$\x16\x878\xf1n\xae\xees\u0080\xf8\xc1\b[\xb2u\x8c_>nɯ\xa4\x8d\xe5\xf7^h_\xbd\xc9Rܙ0\xc5G\x03\xa6\x15U%\xa3oe\xf9\xfb\xc3\xfc\x8f\xe2=\xb5\xeb?\xf9\xaf\r\x8a,\xb1\xa3c}\xdf\xe2&x\x83\xee\xfb\xe2\x1b\x1f-'\xa1\xae`,|\xe3Zj\xf3\xf2c]\xd7c\xf2Y2k_J\xa8\xb5\x19\xf2\x94\x03\xe5^~\xf8\xff\xf5\xd3\xd3\xd3\x0f#\xa0\xed\xad\xe0$ \x9f\x00\x16\x98\xe1\r\x89(&\x04\xa8+H\xddA\xa6I\xd4vB5\xc0\x8c#\x01\xbb\x1f\xb0s\x86\xcc\u007f\x9e\xf3\x13\x9f\xd8O\x88\xde\x02]\xc3aʯ\x85l\xc9\xfc\xe3\x94\xef\x9aL2s\x06\x12sB\f\x99\xcb\xe6\x1a\x84\xa5\xbbYN\x10\xde\x00\xbf\x90\x00>\x01\f\xb4\xfa\x8am\x18E\x8bKm\x17\tN\xb4@\xcfj\x15OZ\xd6ɞ\x86\xc1\x02\xd6\nE\xe3r/$\x9d~\x03\x93\xe9\xba&A((⬘$\x11^P\xce\x10fx\xea\x97>\x93p/1\xce0\xdb\xd03\x9e{\x99itK\xceV\xfe\x1cf<!\x96\xb9\x97\x19>(\x82\x04ċ\x8dѬ\"\xf3\xbd\xd8T\xfaM\xc9\x14Ƌ\r3F\xbfe\\\x18>4h,B\xc2\x19\t\xef;\x12\xec%J\xa8R\xbf\x93\x00/Q\x83\xe2\v\x95\x91\xc0\xea\x87x3\x9f,\xe6h\xa2\ft\xc0H\xa9\xce\x01\x85\xb66`ɵQx\xf9\x18OQL\x92\xd5\x14^B\x86\xc9 \xf3\xbdHԒ\x91\"S삒\xa9\xbaF+zu\x14^*\xaeZ\xf6-\xa4\xe4\xb9\xd8/P\xa9\xa9*\x0e\v\x18=E\x85\x17\x91_\r\xd7\x15)\xa9\x85\x17\x90\x92\xa5!\xa8\\\x12}/C6\xddk/\x05\xa5\xd6\xf4\xd8Vs~\xcb\f\x8d\xf1\xd3\xdc\x19\xa1\xe8\t\xac\xfdrb-\x18F!\xb6^\x97\x8c~\r*?\xc7fHr%m\xbd\x88\b\xc7$\xbdcmQo\f\x9b\xab\xdf=)\xd8.\x82M{2\x85\xf2\xb22n\xd2\xd3\x11\x91B\xedcԴ\x95\x93\xb8C\x8c3ɦ\x1dcؗ\xde:Q\xdfH\xe0i^Sd\xbe\x17\x99\n*P\x8b\xa5\xac{\x17%-\v\x05}\x92DxQ\xba\x8a\n\xf4\xda\\{\x91\xea\x1a\xedt\xfcu1آqB'\xb8\xebMB-mQ\xea@qA\xee,;\xb4bX\x97\r\xb2K\x8f\xfe\u038b\x16\xab\x86!%\x11^\xb8\\B\xbcw^\xb4\xa0\x12\x8bќZ\x96\xd9_{\x96\xea\xc4\x0e͛f\x80\xac\"\x9f#\va\x1d\xb9\x8f\xb6\tr\xd1\xef\x0eh4A\x97\x95\x8c_ޘ!\x97\xd5\u038b\\ͬ[\a\x06E\xb5\x82a\xf3\x86C\xe6{\t\xebXoIU\xb7\xe3\xd8lM\xea\xd3]\x85J\xc4$\xdb\x00Q\x87Vp\xcfO\xd1\b\xad\xe1\xbc\xf0\xc0\x17\xe0\xa4l<\x17aڮF\xa7u\xc5\xf3v\x89Kj\x81\xe7\x1d\x8ebo\xfd\xb6M¼\xb8\x8c\xd6\xdb\x1an\x8f\xb2\xdd\xc2*\xee\x10\x8b\xdf\n\x0e\xf7\xb4\x1e\xecp\xceY\x83\x9eP\xa3\xd4z\r\xe6\xc5f8\xb6چ\tC\x8a\xf4s\xf9\xb1\x1f\xf4\xf2x\xe6\x1f{\x92@\xe2\xde\xc7\xc8=\xfe\x19bs,5\xb1\xfb\xa7\x18\x95\x9c\xd6}\x1e\xc3h{a_Ę\x94\xe9\xb1ߢ\xd6\x13\xf2Nt\x1f\x82\xaa!\vzɂ\xf7\x8e)R\xda\xf7\xa8\x83t\xdb\x19\xa0\x8f\x8e\xfb\xfd,\x9fd\xfe!\x12L\x12\x80ۜu`\x84%-\x8e\xfd\t\x9b\xca%\x9b\x1c\xa0+\x12\xb4\xf7\x12t\x16\x89)\xf2\xb2#\x81\x916ߞ\xe3y!1p^N\xe0FgC\xc8άd\xb4\x1d|\xf0b\xf2ƌ\x12\xea\x1cOX\xdcIg\x04Sg\xba\x9b\x87<\xe8WE\x03P\x171\t\xaa\xa2\xcf\xd3\a/=\x86\xa9J\x93\xe7\xe1\xc3.\bA\x9b\xb0\x04\x0e\xa8|\xd8Y\x01\x8d\xd8/\xd5\x1d-\xf0\x87\xc3\x12\x95\x12\xf9\xc3\x11\xcf\x19\xee\r\x12\x1f<\xe1F\xab\xbbN\xa8s\xc6\x13\x0e\x87\x03\xc3MDV\xb4\xc1p(c\xc4\x18\xe9$a<ކ\xb3+\x89\xa9\x16\x18Z2 \x9c\x012\xde0㲅\xd1\x14\x92\xa9\xb2G/U\xeeM8\aƛ\r$2Ǎ\x91\xc3`\xeb\xafA\x8bؚ\x1f\x06ݐ\xea\xf3\xe8E\xe9\x02\xe4v\u007f\xdc\xcd\xe7~{w\xf0'\x15\xc3\xf1y!x4\x06\xb7\xb3\xa6oK\x9b<\x1c\x1f\x0fw\xb0\x94d\x1d\x8f\x91{\xa9a\x92\xd4\x0f\xc7S\xe4Ģ\xb7\x92#\x9b\xdd \x83\x95MbP\r\tu\x81J\xa8\xb5)\xe0Q\xfb3\xc7\xe8s\xf1\x11\xf5һ\x03\xa3\x98\x1ck&\x81\x10\xb5N\x90\x03qBI2\xbak\xc8\xf9<\xe5\xa8e]ӗ+\x8d?y\xf9黔_\xe4\xb4E\x85\xdcjE\x0f\xe8i\x17\x0e\x11\xb4\xb2;\xa1cp\xc5R>헇\xdb\x04\xea\x10}j\xad_\xc7\xc5b#!\xa7\xbbUVǂ\x8f\xa9dI\x16\x8f.\x89\xf0\xe2ӫ\x94\x9f䄖\x8f\x19N*\x83\x06\xa4G\x1f\xcdh\x80\x85\xe1`\xe8\r\xff䅧\xa9*z\xfc\x98\x17\x9d\xb2\x97\xb2ц\x14/\x86\xe7{\x90\xe4Q\xaeF\xf3\x18\x8c\x13\xb5\xe0̑S\xc0Н\xccT\x95鴵\xc5v\v\\\xcavc\xcf\v\x18\xadK\xd8~\x01Ji\x12v \x9cw\xc9\x0f\x1f\tp\xba7\x94\x1b1\xd1Xʅ\x98l\xb2\x97\xa8\xb3\xd4%=\xdc^\xa0\xde\f(:\n\xc0*t?\xd9\v)=\f\x82/6\xb1fJ\x14\x1f#\xa0\xe6\x8c>\x1a\x96\xf9b#M8\x12Kt5\x1b\x1d\x86\xe7\x01c\x03d\xf3\xf8\x13Z ,\xe8\xc5\aT\xd2d\x81<x\x8e{ru\xf1\"8+iۖ\xa3\xa8\xf7\vg\x82\xe5\xc2Z\x9dhe0ɺ\xdbB\xa1\b\t\x96^\x94\xfc\x19\x0f\xdb\x1d\x18.\x05)-܋\xb6eːC-u\xd7\xdd\x12\x15\x1fb\xbdK\"\xbcp+v\x15\\\xab\xa5+y\xa2\x9d,\xed\x1b\xbaϑ\x93+\xebI\xa5\xc1Y\x04\xd14\xa4\xc4\xfd݈\v\xb8\xc6\xe8\xfeL\n2\xe7\xa8]+0R\xd0{\x0e\x0f\xd2^\xd2&:\x87`\x18\xd3^\xca*l\xb3=\xad\xc3+4\xf2\x85r`\x80vXU\xc5\x1dhe6*/ng\xad\xcf\x12&\xb7\xc2\nz\xf7\x01M\xc2\xf0\x00\xa0\x15m\xfcUh\xff3\x03.\xa9\x80\xaaC\x8c\xa25Zu\x8c1)\x85Z\x9dbTR\x93V\xe8kвo\x15ݵ\xb0\xcdZm\xdcr\x87\x1cR\xc82\xe8\xb8\xd2f\xd1ه9\x11,-\x0fUTr\xa9\xb3\xc6$\x96(\x06\xcb\xe0+\x85\x81\xa7\xa5\xb9Ib\xf2E\xe8'\x1a\xdd\a\xbf\x18H\x1d\x00\x18\xb0\x81s\x88\xf9\x8d\x05\xce\xec\n\xe4:\x04/\x85\x15\xb3M\xa9\xef<\x16\x8e\xf1F\xb7\x90\xd8\x1f`y\x1e\xa5\xb5\x12ܝ\x1d\x12\xa8\xfd\xac\x9b\x9b\x85\x12\xd2\xf4\x86\x02\xa8섃\x96\x91\xf2\t^>\xfb\xb64 %\xe9\x11\x87\x13*c\xeb\xeeb\xbb\xa2\x8b\x87cY*\xa8\xb7s\xe3\xca^\x96\x89N\x95\xc1W\xd20Eǯ\x81G\xbb\xd6j\xc8\x12\xaa\x18\x996\xe1\x01\xe6\xa8{֒\x90z\xf6\xb1\xbaF[\x9e\x90\xd3\x1a#Ƚp\xa9\xed\xbf.f\xf31a\x84\xe2\xa1U\xd75\xd0u\xec¾+\xc1\x90Z\xab\x8e\x03\xc7c\x84\x88\xfcV\x8dǊ^ȑBJ\xa3\x0e\x18\xa5\xb6\x9dp,\xd1\xee\x1a-\xc1\xb6\xec%S\x9cn\xba\x97\x9f\x16\xaa\x8b \x05\xb5F\xaf\xc6 +`\xb2/\xb4\x97\xa4.q\x1c\xe9\xb5V{9iV\xb6\x88\xba\x8a\x1c\xd4+0\xf4\x9e\xaa\xf3h\x82\xf6e\xda\x1eΑ\x8f\xb2\x00\xa7\xb4x\x8e\xe4\x94\x05\x9a\xdc=rd\xa9,\xa0\x89\xed(G\xb2\xca\x04N\xb6u\x17\xa3ҍ|\x8ea\x89\xd6헭K4\xeb\x80\xe1<{\xa1##9rX$\xebR\x88Sd\xba\xd0\rf\xb8\xa7\x97\x83\xf8\xc7\a\xef\x879\x9d,\x89䖴\xaf=G~˯\xbdv+c[Ũ\xf4آ?\xa3\x13J\x91\x92\x9c#y%\xe95Α\xb92\xfa\xd1\rt\xf2vGz\x907\xb2X\xb1p\x83\xd0'\x91\x1cY,\xe8C$1\xbb\x0f~\xc6\x04\x10]\x1dm\x8aВ#\x1b\xa56\xfa-UKP\"M\x02\x80.\b\xd6BGj\xfd\x1cI'\x17\xb8\x8d\xdbV\xa2\">\xf35\x12\x88*B\x8c\x81\x1a0@\u007f\x110^oZA3\x8c\xf2\x02皦P\xe4Ň\x99Θ\x94\x1ff;J\\\x16/\xee|\x84\x19\xb4\x9d[H˜U\xf7˚\xe7\x1cr \x90\xda\"5\x9f\x02\x00\xe3\x01\x97D\xeeB\x98\x8c\\\xe0\xc5\xf3<\bY\xadI&G\x8e\xec\x96^E\xe7ۇp\x00\xceJ\xa3/\xa4\xb7;G\xc2\v\xc6\xfdH\xccq\x0e\xf8\x91\xf9\x1f\xa3:$\f}\xa9}\a\xc6r#:r\x89!\xf3\xc5\xf6\xe5\nȋ\"\x18F\xd3\x03s$\xc0t\xfd\u05ef\x83\xda\x13\xc0i!£\x9d\x18f \xe1\x95̑\xe72\xa3\x92!\xa1\x1c9/\xb6\x11@\xb2^\xf2\xed]@\x87\x96!d\xbe\xd4\xc2@\x06\xefN\xa8s/lCw\x17\xf9/F\xf3\v\xbd\x0flCp\xe7\x9dW\xe4\x04m\xef\x82;\xebή|{ \xe1I\xed\x8e<\x98;<\xbd\x85\"\x17\xe6\x0e\x9c\xdaK\x91\x1aӸV>\x93\x00\xdcˬݒ\xf9\x18\xf5Q\xbc\xd1\xf4\bW\xb1\x9f6\xb5A \xbb\xa5쥴t`1G~\vH):+\xe8\xf8Q\x8e\xfc\x96\x80\xba\x92(/JƮ\x18m92]\"\xce \tC\x12\x95H\t\xd1nAOX\xf9\xe0\xfe#\x8e\x96r\xe4\xb4H\xb8\x82L\b\x03\xd2Y&LJ\x06v\xa7\x8fL\x1b\x12\xc7b\x02\xd2\x1a\xb0$\xe20kx\xfe\x91\x14D\xe2\xaa9\x1cO\x13\xb9s\xe4\xbb8}\xbe3!\x1ffw\b\x0ekrD\x90\r\xe3kY\xfa!\xee\xea\xa0G\x1ey2\xbe\x86\x0f\x1cһJ\x92k\x1f\xe94ЛE\x14\x14zr\xad!\xab\xe6\\ҭ\xda!GW\xca%\xf9\xb8\xb7\xa4\xc2EV\x8d\xe9;X\x18\xbfB\xd1\xdfGM\xa8\x96\xc6c[\xc6\xff\xbc\xc1bR\xbet\xa4a\x89\f\x1c\xd3\xcf\xceũ6\xfd!\x85\x8c\x10\xe5\xc8\xcdy[:]/\x864(\x90\x9eS\n\xc7\xf5\xd2\xf9]:N\x96`\xf3\xf1\x98\xcc/\xef\x8e\xcf$(\xf6\x951\xd95,\xe1\xe4ʟ\xab\x0fȄ\x1b-G\xaa\xce\x04mu\xafR\xae\xb3\x1c\xf9:14U-\x92vF\xac\xea[0\x82'\xeb-\bl\xb2\xe2\xed\">M\xaf)\xe4\xebxPj\xf1\"i\xe7\xa6{חk\xfa\x04\xa9;\x1eIB\xbc\x10\xbe\xcf\x1c\x95e\xfeq\xce_\xfb\xd2i٦\x04%0G\xe6NetG\xd3\xd8\xf3}\xf0\xe03~\xc9\xf4\x15L-i\v\x19i<BY\xc7Ά\xb5$(\x9c<\x04\xbf\x90k\x1a\xe9<\x8c6~\x90\xc8S\nW\xf6\xa9\xed\x0f\xa9:\x01\xb42V\xc8\xdaq}[J\xb2E\xc8ٙ\x10kU\xed\xf04\xa1\xce\xeb,\xb2\x1c)<\x11\x94\x96Bd\xf2D\xc0\x94\xa1\x87t\x9e\b\x9aT\xf4H\xe9a]G+\x13$\xf4\xbc\tU\xd14\xd7\x1c\xa9<LUF\vR\xa1\x1ff\xceEO\x8a\x17\xb2x*#ʲL4\x05\x15\xd1\xe5\xd6\xd1\x00\fr\xeaޤg'\x90u\fHI\xda8\x81\xa4\x03-\xa3\x9b\x82ܜd\xfe6x'\x9cXl\xe3gÜ\xe8\xe8e\x88T\x1d\xdb'|\a\xc7\x10\xef\xd1)D %\xf3F\\\xe9\xb6\x1d\xd0\xe4%U\vRq\xae\xe4\xc9\x1598o J\xba\x01\f9b\xca\xd0G\xd8c\xe0\x1f\x9fA\n\xfa\x96H~䱺Y\xf1\xa9\xe7Ǌ\xbc\xad\x93\x94\xf8#\x90\xf8\xd4b:-m\xb2\xfb\xd0̝A\x95\xac%G\vȭ\xba琳\xf3\xd6\x00H\xde0A*\"\xe4\xed\\E\vzE\x0f!y\xc7\xf5\xe6\"l\x93I\x11n\nL\x01\x1f\xd2\xfd\x9e#\xa5'\xf2,'Z\x8bq\xeb\x8eq\xc8l\xd3;G/\a\xa4\xf6XIS\xa8r\xe4\xf4\x84[\x8c+\x9dB\tԦJ\xb1cs\xa4\xf1\xe8\x0e\x14\xad\x91N\xe5\xbcK\t\xd7GN\x10\x1f\x0e_\xfaez%\xae`\xacp\xf4\x88q\\\x97\xc61\x93}\x88\xac\x9d\r\xabzO\x9f%\xc3W9\x92\x81n\xac\xd1\xf4XC\x1c\x11\xa6\x10\xc8\xff1PUdt\"G\xf6τX\x19c\x16\\m\xc3V\a}\xb7\xc6\xfb͑\r\x14\xa1I\x18\x9e\x02@\n.4\x19\xd1Α\nT\x893\xa9\x9d\x90\x05\xd4\t\xa8\xb2Nt`\xb2\x8e\x1cP\xe4\x01E\xc0\x84\v\x00I@\x95\xe9;ڱ\x89ğ/Z\xb7dd1G\xbe\x8fd\xea\xdc3ZQ!ͧf\xe4\x0e\x88$\x1f\x8cf\x91\x18<\x896\x82\xf6T!ͧc\xa4i\x86\x1c\x1f\xdbiz\x8e\x90\xdf\xc3\x13v)2{\x86|r\xfe\xca-F\xb6\x9aD\x14-/w\vȊ\x18\x96\xe1\xd21\xd0Vd\xb9\x8f\x00k\x15\xe1\x99\x0e\xf8-!\xc4%\x1aB\xbdӋ\x10\v\xaay\xb2P\xa0;\x94\xcb@\xfa\xbb \xe1,X\x1et\x1b\xca0=\x89;gy\x89v\x12\\\x05S\x8e\xe6#\xe7e8\xb2\xf5\xaaJQ\xa4\xf2\x12\x90\f\xe0XIG|s$f\x8dgɮJ\xb8ϑ\x8d5\xa2\x06Ŝ\x80\x15\x11\f\xde9\xc8\x04n\x1b\u007fT\xbf\x81\xe9\xb4HP\x0er$e\xd5>Z\xe6\xf4\x92\xb8=\xa5\xdf\xddp\x9bs\xc6\xcbp\x89\x9a\xe30\xf4W\xd1\x11\xa5\xbd\x99\x95(\xbf\x8fʏSA\xd5\xd0WB'\xca\x1f\xa2\xf2\xad\xbe\n\xaa\xfd\xd3-@\xba\xfc1*?\xc6#h\xd8\t\xad\tڗ\xce\xd9\x1cѠ9\xf89һ\xbe\xd8z|\x98\x81\xc4p<\x02Ԑ\x95\xba\xbf-i~Cb\xaf?&Zv]\x12\xd5l\xdfu3\xffg\x06\x9a\x84\xceD\xb2X\xb0\xf4\x94v$\x892G\xd6\xd8\xd2H2`\x85u\x912\xf3\xa9%ɰɑY\xb6\xbca\tmG_jɫ\x99ν\xa2\xc0\xaa9\xdaIf\xfb\x05s˸nK\xa1\x98\xd3w\xe7\xd3\xc9\x01ƉĆ\xf1\v\x98L\x01}\xb8\xab\xc2E]\xc5azJ\x8d6\xfc\x91\x8b\xf6\xeb\xafd\xee\x1e\xcf\r\xbca\x8b\xc9{\x03\xf1N\xb2\xa2r\xa4\xa5YP\vkj\xe4R&\xaf\xdf\xe4\xc8T\x1b\x8a-\x17LT\x90^\nH_k\x84u\x9a6\x8e\x03um\x12'\xd7$\xda^\xcew\xe1\xe9\b\x10\x92\xd5:f\xd8ٰ\x8e\x14\xc9\xc0K\x93\xa2\xa2\xa9\xb39r\xd0&/v\xc2\xc6A\x12Z\x00\xad\xc8\x1a\x84;\xe0-\xe9ބ\xe0a\xe3\x1cLV\xb2\x88\v4\xe9\xa5ޕ:\xa1ڑ\x80\xe6hC\x1aIf\xa5P\x9a\xf7\x92\xe6\xa4\xe60\x1fP\xc8%\x8f\xfc\xb2q\xd4R\xf7\xf0r$\x95\xb97\x91P\b\xc8)\xbb\x81$mL\xe4\x93\rkg\x92-\xba\xcf\f](5i\x16 s\x8c39\xf4\xd9\xd1\xf1,\b\xf2rK\x98\xa9\xc8\x19\xf3\xa4\xd17&\x13\x14\x13$\x8dq\x9e]\x85%\rZ$\x8dq\x9e\xb5\xe3\r\xc1\xc4-\x86\x1cic\x9cg\x95\xb0\\_iiG\xe6\x18\xe7\x19k\x814\x80\xc3\xdd\x03\xbe\xd2\xc7\xc0\x1e\xe3#\x97\x98\xf6\x03!\u007fl\xa4\xa9%㾁>\x16P\xf4\xf4!\x81l|\t\x85\x04\x1cgZx\xd2\xed\x80\xdc1F\xe7\xb2pw\xb12\xba\xeb\x12\x83\x18<'\x835T\x9a>\xd1\x1e<I\b㚊\xdd2\xce.\xf48\x05\xd7\t\xb0\xe4\xe5\xb6\x1c\x19d\x9d\x80$\xa8x\x9aY\xaek(|\x85\x84YW\xb7$\xa2\x88\x11i-U I\xcc\xfbe\xe8gD\n$\x89!J\x91 \x14\x17\x91:\x1d\x14O\x81jh\xc9l/\"B\xbf7\xda\xd2]?\x06\x8e\x19\xc8ċ\x11\x05\xb2\xc38's\x91\x90\xd1\xc0\x05\x96\xec\x96\x06~]\xa6\bI7\xb4D\xf2\x11S\x1d#\x1f\xdd(\x9e\xc2A\xf4fAJV\xd15\x85\xa0\xa8R\xc0]\x05\xa3\xe3\x86D\xc2\xcc9N\x9c+\x8b<\xbcKb\xfa\xb6\x04\x1a\x93\x87\xebê##o\x05\x92\u0086v\xdb\x1b\x89\xd8\x06\xb2\x87qV\xa8\xb2\x97\x17\x12\x87\xaeX\xd1v\xf26\x9c\xc9\xc9\t\v\x94\xb0ˍ9\xc9ȑ\xcag\x0e\xbeK1\xf9\x8b\xfc\x10\x81VC\x06Ex\xf1H\xb0V\x93\x17ȋ<\xdc\xc2%/\x9c\x14H1\x1bY\xc3\x16\xb8\x01\xbaw(-\xdai\x93\\\x1byp\xd5\x1a\x00\x97]\x05\xbc\x91\xb0*\xba\xbdY\xd2/\x19\x15H3\xbb\x02}k\xbd\x989d\x86\xceG\xc7<\x18ޓ\x16\\\x81\\\xaf\xf1҆\r{\x91\xf7\x882e\xcfヽd\xd1]\xd8\n\x10\x96\xb0\xb8\x8a\xf0\xcc\xd1\xd0\x13\xcfx%q\xfb\xb9Ck\xb0C\\]\xb2\xf7Ǩ2댾Е\x9d>\xc0H\xb6J\x81\x14\xb0\x18H\xea\f$\x81)\xe8]b\xe88\x9e\x8bƛ=\xb4߶@\x16X\xb8\xb8\xa9\xebZpA\xda\x02\x05\x92\xbc\xe6\xeb7\xa4\xb0#\xbf\xeb\xadaβ\x8e\xc6\x04\xc5ah\x03\xa6@v\u05f8dR\xab\x18Y]#(\xf5>\\\x81̮F\xbb\xa5\xd2.I\xeee\x81<\xaf\xab`\x11C`\t\x99m\x94\x04\xe0\x18Xzod\xf0\xa9\xd8\xce\xe4vѓ{3\x92\xb6n|q\xa8\x8cΜd\xa9\x12]\xf1N\xa8\xac\xd5\xca&$\x04)]\xba\x03\x95x5\xa0؆\xab\xd6\x1dT\xc2Ae--\x1a\xe12\xbfs`n\xd9n\xe9۟\x12#\x8e\xe8\xa20\xf2\xbd\x10\xb7\xa5\n\xbb\xc6\x00d\x83y\xe2\xe8\x83Y\x81\x840,QP\xd5$\xee\xba\x17\xc8\x13C\\N\x15\xf6_'\xcbo\x97埨\xf21\u007fvYz\x87\x8a\xbf\xb7\x90\x8dη\xc4g\xbc(\x8b\x8c\xf7\xc6\xd2\x02\x80\xdc2]~\x01\xee\x16\x97B\x97\xb8\xc3\x02\u05eb42\xbc\x16 \xf8\xe5\x96)M\xdeR.\x90`\x16\xc1H\v\xbf@\x86\x19\xe7\xd9\x17N\x1a\x16H-\x1bO9\n\x8c\u0378\xa4M\x90\xdd|\xc5Gѭ\n\xaf\xdd\xc8\xc9\xfd\xcd\xe9\x18r\x81\x9c\xb2F\xf7f|A\x94n<\x92\xc6f\xd8BV\xe6d\x9b\xf0\x16\x17H\x1a\x9b\xa1\x05]CJX\x91-6#\xb7t\x05@\x9b+H\x1f\v@\x12\x14\xdf%?\x1bV.\x9d\x0ec\xb2I=#Y<\xc7W̭\xd3\x1dQ:}\x84/\x9e\xe3\xcb\xe7xE8\x01=DP)\xbe\xa6\xe8\xf9\x05\xd2Ħ:\xbbt\xd3O\x11ί\xc5\x04\x92\xc5H`<!\xef\xe1\x11'\xc3*H\xbc6X<\x87\x97P\xcfbP\xd9\xf4ބ\xe40n`|\xe2w\xbc\xf9\xa7\x15=\x83\x18¤\x9c6\x05\x12\xc2\xce\xe7t\xa8\xb1@&\xd8p\xf4g\xd5U$T\x0e\x92\xc0t\xa5\xf4e\x10(%.\x82\x04n\t\xe0ʩs\x1f\x1eSqY\x97\xa2n\x17H\x05{\x13\x171\xecS,#-\xe2}\xb8\xf6]3C7/P\xb6\r\xfd\xc6j\xb1?Τ\xf3\x9adn\x15H\x02\xd3\x1d\xfdP`\x81\xec\xafQ\xb2\x14\xb8aw\x95\xda\xd0\x1a\x1fy`\ueeb0\x9bA\xc2UX\xfa&A\x81\x8c\xb0!\x8d\x91\x12\x89t\xb0秧\x8e\xee\x04\xb26Z\xf6\x95\xfe\b\x12\xc2\x02I\u007f\xa4/\x90_CZX\x80N\xb4g\x1a{\xcf\xfd\x9f^>\xa3\xb1\xdb;\xecDm\xa6\xb1\xbb\xf0\xcecoi\"F1\xbf\xef\xd4\rF.\t\xd9\xcf\x10+\xce\xf4\xc2CB\xd8\x00\xa2\x1b\x13\xce\r\xe4~\x8b\x1c0\u007f\xab\x97\x0e\xb4\x14\av\x8fJ|\xac\f\xda\xfe\xebW\x12\xc0#z\v\t\xc0-T2~ɜ W\xc6L\x06SN\xf0\x84J:.8\x13\x19\x93\x82\fp\x15\xc8\b\x83\x8a\x8c\xed\x17᭦\xf9ɗ\xd4\xc1\x10\xa9a\\W\x02:\xba\xed\xc7`\u007fU\xe4j@\x1eX=\x92g\xa7\x9f\x9f!q\xe1Mn\xd2VA&Xgt\xd5s\x975\xbd\xa2\x9b\x8c\xe2!\xdeS\xc1\xe4\x02\x89a\x96\x1bQ\xd2\b\x16\xbd;\xb9\xa2\xe3\x8f\xe5G\x1c-I\xc7\xe8\xa1ʵ\n\xab\x0f\xb0D}p\xf7xYI>\xf6[\x9c\x9e>\xe0lb+@\xe2W\xc3l\xe3\x12\xb5\xa1\xa9/{pZ\xd3N9\xa4|\x05PF\xcehx\xae\t\f\xa7\x1f\x8d+\x90\xddu\x16N2\xba\x12\x8c\xd5u%\x9cS7;\v\xe4t\xbdu\xb56-\xb9\xba\"V\x97\xa0\xb7\x1e$syz\x15\x93\x19\xe3<\xe1\x1b@N\xd7̍K-1\xe4v\xdd=\x8eIO:r\xb7J)h\xeb\x14\xa9YS\xa4\xbe\x82\xe9\x96[b\xabC\x9a\x96\u007f\x90z\xc82Z&\x9f\xcf*£M\x86\tI\x8b/\xb2\xb6\x98\xb5\u008e6\x96\x1c\f25R\xbfo\xd6\x01=\xf8\xc8\xe1bVN;{g\xc0\xdd=y\xc8Fz<SӋdH^\xa2\xe0˪\xf1\x15\t`\xb5\x9ag\xcb[҃\xbd[\x8fn\xbe\xbb\xafU\xf4\xbb\x8f\x05\x12\xc3\xceRT\xf4\b<G\x80\x8cn\xd2~~Z\xed\ueccb\xbe\x91e\x03_\xfa-K\x1b1H\x13\xbb\nV\xd1\xdb\x12;ň\x15+\x12\xd9bV\xb1.\n\x87/1\xe5\x12\x93\x9dS\x91\x05$\x8e\x05\xe4ʇ\xab{R\x1c\x89\noE\x19\xeb\xb2\xf8\xa5\xa0\x05\f\xc9d7\xcd\xe8\x86!\x99\xcc5Ђ\xb0\xb4t\x87\xb7\xa2\xa2wq\x16\xaa\xfc\xe1>w\xcd%\x88\xf4\xb3zA\xe1\x18\u007f\xa8ce\x9b\x1c\x19i\xd6dZ\xc9\x1b\xf1\xcb!\xfe'C\xbaw\xfc\x81\x92\xe1O\xfcE/\xfcŲ\x97lH\r\xf7\"\x1aQU\xa0^\xc7\x17\x9f\fp\xf7\xe9\xe9a\xe3\xff\xfb\x19\u007f\xf4\xeb)|4\xab5\xef\xed\xf8\x9a\x11\xe3\xc3\x12\u007f rƿ\xe6\xe6Yǜ\xe0\xbeq\xe3/\xcb\xf9֍\u007f\xfbF=\xcd-\x1a$\xbb\x9405i\xc0|\xff\xc3\u007f\x04\x00\x00\xff\xff\xa0\xd0\xc6\xe4\x87q\x00\x00",
@@ -142,26 +142,33 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  0,
 	},
+	"js/charts.js": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xacX\xdbr\xdb8Ҿ\xd7S|\xc5̟!c\x8a\xa2\x1c;N\x9c0\xa9\x99d\xf2o\xb6\x92Lj\x9d\xaa\\\xb8|\x01\x91\x90\x04\x9b\x028\x04t\xf0f\xf4\xee[\r\x80\a\xc9rƳ\xb576\xd8\xdd\xf8\xfa\x88FC\xa3\x11\xbe\x8a\x05\x87\xe6\xb5\xe0\x1a\xf9\x9c\xd5FCMa\xe6\x1cR\x15\xfcg\x8d\x9a\xe7\\\x1a\xb0܈\x950\xb71\x8a\x9a\xad%\x94D\xce\xe4\x8aiLk\xb5\xc0\x94\xe5F-\x8a7\xc2\xf0E\xb6\xe0\xa6\x16\xb9\x1e\fV\xacv\xa0\xef\x98a\xc8\xf0]\xb3EUr}\x8e˫\x18\x93R\xe57~\xbd\x10ri\x1cc\xdbm\xfbȴ\xb1\x06fH;\xea'\xb6\xb9p8\xc8\xf0\"\xdd\xe5\xbc[\xd6\xcc\b%\x89w|\x92\xf6lx\xabJU\x13\xf92xt\xcc\xce\xd8$\rb\x04\x8fxz6y\xfa\xc2.Or6=u\xd4<}\xfa\xe2xb\x97\xcf\xf9\xc9\t+\xecr\xfc\x8c\xa5\xcfO\xed\xf2l\xfa<\x7f\xee\xa8\xc5\t\xcb\xd3\"\xb8\x1a\f\xa6K\x99\x93r,\xab\x82\x19\xfe\xd6\xc63\x8c\xf0}\x00\xfc\xb1\xe4\xf5\xed\x85a\x86\x87\x81\x0fP\x10\xef\xba\x19\xa3\x01\bk\xae\xab\x88\xb6\x01䀚\\۵\xa9o\xe1\xa8 \x1a2\xfc\xf3\xe2\xf7\xcfI\xc5j\xcd\xdd\x1e\xcb\xdc\"g&\x9f\x87<j\xa5kn\x96\xb5t\\\xfb\xb7\xcdK\xa2\xdb`ޡ%\xb9\x9293\xa1\x9a\\7\xa4(ѥ\xc8y8\xdc\xcbE\xb4\x87겋\xec\x0e\xa9\x8f\xe9(w \xdb$\xee\x83\xfa2Av\x97և\xf5\xa4\xbf¥ȖL\x1b\xaa\x89\x9e\x871:\xd3b\xf4\xf0\xae쮩\xaa\x11\xd2VAe\xf9\x12\x02\xaf,JRr93\xf3\x97\x10GG]\xdc\xc5\x14!q/ŕ\x17\xc0k\xa4x\xfc\x18\x9ez\xb9\xc7\x1db|\x95\x18*\xfa\u05fb\xd5\xd1a\xe2\xce\xe9\xf8+,\xbfq\xdb\xcb?\x1d\xe3\xa6@\a\xc06\x1al{\x05\xdc\xe7Z\xbd\xe4\xf0\xc1\x12\x19\xf4\xa0\xc2\xc0\xb2\x87f^\xab\xe5l^-M\x10\xe3\xb2\xcb\xe1\x85\xed3a\xf0\x9b4\xb4\bb\xe8^\xcd/\"|\xf7u\x8aE\u009dL\xc5k\xcdsl\xa3\xf8.\xcc{j:\xa2\xc0ךI\xcd,ȏ1\xa7n\x83\xa94\xf9\v\\E\x87\xac\xffcɗ\\\x1f\xb6\xfc\x83\xc4'=\xfb\xa1\x12\xb7=\x11r\xa1g\x87\xed~ ½\xfb\x7f\xf9\xf2\xe1!\xfbY%\xeeٟ\xdf<h\x7f~sx\xffgn֪\xbe\xc1\xefK\xf3\x10\x1c\xe9\xc4\xd5\xd2\x1c\x86\xfb\x87*\v!\x1f\x14\x92\xb9\x13\xbd\xd7-\xfd@\xbf\xf4a\x84\xb7j\xb1\x10\xe6A \xb9\x13\xfdQ\x1d\xb9\x0er\xb8\x8e~%^\x10\xf7\x8eS\xd3oZ\xb5EOm\x91h\x9e+Y4\xfa\xee\x11s\xb0\bp\x84\"\x99s1\x9b\x1bl\x0f\xda\xe6;\xdaa\xe3>Y\xe6\x8eu^\xfe\x7fo\xde\x11\x02\x7f\xf9{\xba\xff8lv\xc5y}\x8f\xd1_<\xeb\xfe\xcc\xd9\xcdm\xc2\xfaݮ\x0fT\xb2\t/c\x94B\x9b\x18+V.y\xd7\x00+%\xa4\xb1#\xc4\xd5\xe0\x9e\x8b@\x1c\xbe\b\xdcΤZ\xeay\xf8ݜ[\xb9K\xe1\x9as\x8cչ\xd3\x14zr\x14\x83\x06\xa9V\xccڼ\x1d4\xb78\xbe[#\xcf\xe1mu\xe0\xe7\xfe\xff\x96\\\x1b\x8d\xba\xe0ٕF)$o\x87;?\xf1)\t\x06=g5/@\xa6\x80m\x84\x8e\xb1\x16fn\xc5n-\xc1\x8dxibQ\xb9\xcek1\xe1\x90l\xc1\xb5\x15\xb2W\xa8\xd5M\xe8\fZ\xc8Y٪\x10\xd2\t\xf1\x19\x97Er\xe0\x86\tE\x11{ḅ\xefb\xee\xa7\xcc\f\x85ʗ\v.M2\xe3淒\xd3\xf2\xd7\xdb\x0fE(\x8ah\xe0\xee\xd9F4\x83\\\x96e\x13\xfav\xf2\xa1\x00:\x91d-\n3G\xd6|\xe6\xa5\xe0\xd2|#b\xa3\xd5l:\xf6\x8c\x9b\xb7J\x1a\xbe1ap\\\x04\x91\x97Y#\xdb\xc1\x8bуt\xe5\xed%K>5\xc8pr\x1a\xa3&22\x8c\xd3\x18FU~5Qƨ\x05\x8d\xac\xc7d\xa4\xd9$y\xc9Y\xfd/\x9e\x9b0\x8d\x91\xc6XǘG\x03\x8fg\x16B\"\xc3\a9\x15\xd2\xce\xe4f\xc1\xc8\xdeaGY9JzO\x9d\xbax\x1f\xaaT[\xe7ȼ\x04ը+\xab\xdd\xd9\xe7\xdaA]\xe3\x15\xaa\x16\xe5\xbaCAc\xe3'f\xe6t\xa6C\xfa\x8eQ]^_%&jeئ\x95a\x9b\x90\xbe\xf7eV{2\xabNf\x15\xb5\xf3\xccv\xe0\xe36U\xd2 C0\x1eW\x1bh&\xf5\x90\x1c\x99\x06\r[\x94兹-9\xc9<:;;k\x18\xda\xd4\xea\x86w\xac\xa2(\x1a\x16\x1d\x9co\xbe`ƾԜw]\n\x1a\xbf\x1b\x1d_m\xb1|c\xc2\xd0uEa+\x98aAlK\xc1e\xfe\bǩs\xe0'_\xb9Q\xa2Ť\x14r\xa6\xc3 q\r\xcf\x1d\x9b J\\\xf5\x05\xd1ݒ&s\\\x902\xa4m\x16-\x01c/\xe3?-\xe6g\x91\xf3O>\x90Q\xeb\x8e\xdbOn5\x10>\x81\x965\xc48M\xd3\x06\x8c\xd5 \xb4\xb6͚^\x9b%\xffp\x84\xd0`\xe8\xd1F\x1e\xbe\xf9~\x82p\x8d\xa1\x13\x1c\xba\xf3\x10\xb5\xb8\xb7}\xdcU\x0fw\x8easJ\x86Xa\xe4\\z\x82\x90\x18\x14Ά\x1d\xb9Z\x18\x8d\xf0\xff\xb5(\xc0d\x01\xb6\xb1c*\xe5\x86\xc2\xf8K)f\xe4Y`u\a\xfd\x13r\xe3\xca\xfa\x06\xaf2\x9c\xbc\xc4\xcd\xee\xb1X!k\xd4\xde`\x84\x936\xe3\x13>\x13\xf2\v3\xf30ji\v\xb5\xe2_U\xe8\xf2}\x1b\xae\xa2\x8eE\x05\xf5U\xd9(X\x13\xf6\xf9\xae\x16\xc3\xe8nE\xd9\x04\xbeW\xf5\x82\x99p\x15\xc5M\x10O\x1c\x02\x8ep\xd2\xdc\x14w\x9c\xa5W<\xaf\xff\x86\xb7\x06\xae\x1el6w\x13\xb8\xeb}k\x9d\xe4k\xbc\xa3\a\xae\x89\x12\xa3>\xaa\x9c\x95\x9c\x1e(\x17\xa6\x16r\x16Fq\xd7\r\xda\xe3\xbc\tM\xe3\xc7\x11\x8eO\xa3\x18mX0t\x04\xca\xf0\xb3\xa89\xe4\xa3\x11>\xd2MֶV: ?\xba\x92\xff\xcbVG̜~2@\xd6\xff\xfd\xe0R\xe0\xff\xfa\xdf\x1e\xf7j/yM#\xb1\b;\x91:\xcc\xd9\xe91\xc9齕\xf57\x1a0\x9d\xeb띦\xb0W\x9a\x9b\xd0wZ\xaa\x1e\xdfP\xa3\xe6\xa5\b^j\xbe\xb7\xcf\xd7\xed\x0f\xf7\xf5\x7fa\xd8/e\xb2\xa81\x96\f\x1bw\x865ѱ7\x1e)HI\x01U\x80S\x92\x92\x12\xf7y\x12\xbb*\xf7\xf5\xb0\xf3\x8c\xaf\xfao\xec7\xa8.\xab\xfeS\x18\xe7v0h7\xd1\xf9\xd8I\xbf\x9d\xa7p\x84\xe0\xdc\x0e\xa3\xf6\xe1\x8e7\xe8\x9f:\"\x91)\xe7\b\x86A\xe7V;\x16\xf9\x87}\xe7\x99W\xd2\b8\x00\x9a\xee\xa2N\xd1\x01\x05\xbd8\xfa\xd1\xc9Ύ\xc1+]1\tME\x94\xfdlk\xe8\xdc\"Њ\x00\x7f~\xfd\xf8ыgO\x8f_\xbe\x1a\x91\xe4k\x8bO64\x8d\xe1!\x97\xcd\xdc,\xcaЫ\xbdVB\x86\x01\x1eˉ\xae^\"\x88\"?^\xf6\xef\x12\xd4jI\xd3\xff\xb2\x82Q\x18\xc78\x86\xaaqjGJ\r\x86J\xadymGO.\xf7\xa6\xee\xf66\xeaM\xd9\xcdu_\xa9uHӑ\xfd\x98\x96Jծo\x94jF\xf2#\xc7\xf8\xf8y\x9cF\xcdL\xa6\r\xaf\xec\x80NF\xc48\x8d1N\xefm\f${\xa8/PB-\xf3R\\\xe1\t*\xbcΰ\xda\xff)\r}\x89\xde\x1c\xd2\xf2ǩ\xe5\xdcyg\xb4\xed\xdb\x02\xdaۛ\x14\x8c\xd3\x14\x7f\xfe\x89\x15\x1d\x8c\x9eëhw\x8eu<\x1b\xefpEm\xb6i\xae\xbb\xcaW\x89Q\xefņ\x17\xe1\xb1M\xd8O\xe1Z\xc8B\xad\xa3\xa4\xe6Z\xfc\x9b\x87\xed%ۙ\xf1S\x18<\x12\xb2\xe0\x1b\xc9V\xee'3M\xb5\xc0\xf4ےi\x1d\x06B\x0f\xed\xaf\xc0<hm\xda\xff\x11i\xb0\x8d\x06\xff\x19\x00\x18ȹ\xdbU\x16\x00\x00",
+		hash:  "cffcf9878c7eb2d513500f7754c62c1be88052156664f21a6d91248f2248455d",
+		mime:  "text/javascript; charset=utf-8",
+		mtime: time.Unix(1792361770, 0),
+		size:  5717,
+	},
 	"js/controlPanel.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\\[s\xdb8\xb2~\u05ef\xe8a\xb2+r,Qr2\x93:gm\xb9ʎ';\xde\xc9mb\x9f=\x0f9~\x80HHBB\x02\f\x00\xdaVM\xfc\xdfO\xe1B\x12\xa0H]ffS\xfb\xb0S5\xb1\x05t\u007f\xdd\xe8n4\x1a\x17\xf9\x0eqHJ\xce1\x95?c\xb2\\I\x98\xc1t\xa0Z3\x8cR̝Ɓ\xc0\xf2\x8aJ\xcc\xefP\x16\x96E\x8a$\xfe\xf9\xe6\xcd\xeb\xd1\xf3\xe9t\x1a\x9dh\x1e\x81\xf9\x1d\xe6\xefhF(\x86\x19,P&\xf0`2\x81\xff\x118\x05\xc9\xc0p\x81`9\x06\xb9\"t) \xc3B\xc0\x82\xe3/%\xa62[\x1b\x98Ϥ\xa8$\xd50\x83\xa7\xe1=\xa1)\xbb\x8f⌡4\x1c\x00\x00,J\x9aH\xc2h\x18\xc1o\xba\x01\xa0\xd1,\x8cl\x93\xc0\xf2\x86䘕2\xac\x18\xc0\xe1\xe8\xe5{\x1c\xc1\xb1\x19\x9c\xfe4\x88N\x06\x83\x1a\xc0\xa5\xd7POc\xf4\t=\x84\xc3x2\x1cYlQ&\t\x16\xe2o\x8e\x9e\xbf\xd5:y\xa6\x92\xbc\xc4F\xcaH\xff\xc0\x9c3\xbe\a\x9f\xb1\x8dQ\x0f\xe0Qi\b@\x16\x10~\xe7\x12Vc}\x1a\x06OL\xfbXH$K\x11D\xb1\xc4\x0f2\f^\xa1D\xb2<\x85\xb7L\u0087\x92RB\x97\x811\x03ǲ\xe4T\x81\x03\xce\x04\xde\x1b\xc9Ey\xac\xb4Rl\x84\xa6\xf8\x81\xa2\xbbq\x8e\b\r\xa2x\x85\xc4\xcb\f\t\x11\x06D\x8cQ\"\xc9\x1d\x0e\xa2J\xe3\xc9\x04\xde B\xe1\x06\xcd\a\x8e\x97tT\x86\x118m\xe7Y\xf6\x1ec.\xac\xf7&\x13\xb8dX\x00\xbe\xc3|\r\x882\xb9\xc2\x1c\x92u\x92\x19s\x91E\xf8\x9d\x1bg\x91\x1f?7\x1cQ\x81\xb4\xedE\x13G~\\6>s-\x03\xdd\xe1[\xbb\xc8ВE\xcb\x16\x8c\xe3=lq\x89%\"\x19N}{\xa0K\xf5\u007f\x99\x17F\xd5\xc7\xc1\xe0q\xa0\xa7\x9d\x99n\xf7+L\xe1\x1e\x83\xb8'2Y\x81Ds1x\x1a\x06\xb1\xfae\x9c0*9\xcb\xc6\x05\xa28\x83\x8c\x00\n\xa28\xc9H\xf29\xf4\x83ϙD\x03o\xe2\r\xc0\xeaךA\x8f#x>\x9dF\x83\xc7H\xcd\xdd\xe0IZ\xe6\x85\x16\x87\b\xc5\x1c\x9e,\xca,\x13\tǘ\x8eY\xa1\xb0j\xc1\xad\xb0\x97\x0f\xf2\x9cc\x043\xf8\xf4k\x89\xf9:\x94+\"\xa2X\x90y\xa6RH\x18Ď\xb1\x1a\xfaX\xb2\xe52\xc3֞\x8d4M\xe3!y\x84h.XVJ<\xee\xd0o+\xe3\x82<ഓKY@\xf9\xe3\x86\x15\xda\xfa\xc0(h\xcf\x0f6\xe6\x03\x9cu:@[XM O\xfc\x96h٘\xac\xd2\t\xe8 \x8a9\xce\xd9]\xa5\xf9\x8a\xa4\xd8\xcevE\x9a\xb1\x04e;hR\x1bqA\x14\xa34m\xd3<\xd6N\xf7\x02\xfc[\r\xaeC#\u007fd\xbd\x04ΰ\xbaGoF\xe6/\x02\xee\xf4\xd3Jq,\n\x98\xc1\x175\x9ak\x89$\x0e\x83\x1ax\x04A0\xaaǮ(m\xe6a\xf3O0\x83\u007f\\\xbf{\x1b\x17\x88\vl\xfa\x1a\xcdʼ8\x06\xfd\xe3zŸ\xac\xf2-\x9b\u007f\x8a+\xf9Ǳ\xeeR\xbfv2~@\xf7\xddl\x1fнa\xf2\xb8\x9em\xe5z\xd6\xc3\xf5|+\xd7\xf3\x1e\xae\x1f\f\xd7y)W]l?Ī\x87q\"\t\x16Q\x17\xe7U\x8a\xa9\xecf\xd5]\xfd\x9co\xd6o\x99\xf2n\x17\xab\xe9k\xe9\xfa\xa3\xe1{\xc9h\xcf \u007fl\x06\xd9\xc1w\xdd\xe3\xbd\x1fcՃӊ\xf11Ri\xbcUo\xf8\xebR_\xb4q\x9c`*]\xda`tx\xd8M&v\x95\xba\xbc\xb8\xc8X\xf2٬\xba\x95\xea\x11|7\x03\xad?\xe18\x91\x8c\xaf5Q|ya\xe8\x9a\xfa\xca@\xfc\x82\xd7o>\xd8\fЌ\xdd\xe7\xd54\x91\xc7v\xc1ҵn\xde\xc2V\xd3\xf8\xac\xaf\xca,\xfb\x19\x89\xd5\x16Ί\xa4%S\xf5\xa9\xc5NH\xa4sA\x1f{M\xd3\xc1\xef[k\x9b\xa1\x1c^\xe3\xb8qZQ\x8e\xe7\x8at/\x10\x8bB\x16\x9aLWa$uC@\xf9\x8b\x96YS\xe9h\xe7wQ\xc6\v\xc6\u007fBɪ\xc9\xd1:\xc1\xfa\x153Y\x98\xd6\xf8\x86I\x94]Ѣ\x94p\x06\xd3x:\x9d\x1e\xb7kk\x1bG\x05\xa2V\x9a\x803PY\xfb\xe15\x11\x8aM\xceY\xba\x86'\x01\x1c\x81\x05}\xb8\xba\x8c\xe2\fӥ\\)\xd86b\xab\xe4\xaa\xfe\xdbCJ\x10\xc5\x05\xc7\x05\xa6i\x18\xfc_\x8b\xfdTr \xe9l\xe8\xeb\x01G\x10\f\xcfڴ\x86>=;E\x9ae\xa1\xcbޱ\xc0\x88'\xabqF\xe8\xe7!\xc8u\x81m\x0fIQ\xf2yx\xb6\t|:Ag\xa7\x13\x99\xf6\xe2;,\x8d\xa15\xe3\x81L\xe2P\xaew\xa5\xdc\xcav:\x91\xfc,\x88Z\xadU\x99\xbf\xcb\xd9g y\xe0\xb8\xf8x\xba\xe1\xe4==\ng\x06\t\t\x19V\xebvh7mn\xc4\xf8\x014\xe8\xfa\xfd\xb1\xde\xfa\xf9\xd3\xe9'*9\xc1}S\xc8\xf6nN\x1bL%_\xfb\xa3\xd2e\xa0D\xd9\xc0\x1f\xa2\x9d\xf8\x9aa,\x15A\xbd\xa1\n\x95[\xac\x19*=v\x1b\xf4\b\x82\xc8\xf3\x8d\xe3\x97\nE\xcf7-2\xd6Ip\xcb|\xb3388jȕ\bx\x92\xac\x10\xa1W\x97MZ\xd7낡\xd2}$\x8d:\xa7\xe9\x1eP>J\xaf\xfb\x9a\xb1\xed\xab^\xf0\x9e3\xb51\xd7\xfb\xd3=\xb53\xae\xd1\xff\xaeT\xa3BDR\xf20P\xd3\\\xd5u\xba/خg\x8f\x9a8I\x98\x90\x1d&\xfc\xe9\xe5K&\xe4\xde:z0\x1eB\u007f\xf0we\xd2\xdd\xe1֟F\xdd$\xea+ؕDOe\xaa\xa9[\xe6\x1d\xeeΫ\x9a\xb6Ϊ\xbe\xa4-Y\xb5\x12h#c\x0fA\x9ar\x85Q\xeaJ\xb2Q\t{J3\x9eq\x01\x8c_z\x92kWj\xed\x98\xc0\xbf;\xaf\ue0f3;\xa9\xee̡\x83Μ\xe7\xe4;\xbb6n\xc9x\a\xac!u\xca3\x95\xf3d\x02\xcfA\xed(\t\xe6\x02\b\x85\v$\x93\xd5\xc6\x01^u\x94\xe4\x94\xd2sE\xf8\xabSO\xe7kC6r\x0fEG\tˋ\fW\x10#s\x1c\x96\xb0\x92\xcaQ\xb2B\x94\xe2\xec\xb5V\xec\xe0»\x12\a\xba\xc0\xfe8\xbd\x8d\xcdgݙy}\xc7^\x9f\xd2\xc8\xeb~\xe6u/p*l\xc7\xf3\xdbx\x81S݊J\xb7\x15\x95\xa9=\xf6\x13\xc5+r\x87m\xcf\x0f\xb7\xd6ʃ\xd6\xf1\xdf\x02\xa7z\xc8A\x14ߡ,T\"\xa2\x16\t*=\x12%ϖ\xab\xed\x93gm\x88+*\xc3\xca\x02\r\x14e)\xaeKj\x05Ӑ\x18\xb3\xf8\xc7\xd55R\xe6\x02\x19\x97\xbf\xe7lɱ\x10\x17\x88+\x1d\xd74yE\xb8\x8e\xaa\xb8\xb0]\xe3\x1cK̃\x91\xaf\xe1ȓb \v\xccU$\xeb\x13r\x9b\xe2}Uf\xeeb\xdaP\x1fO\xa7]g\x87\rA艞x\x92\xe1\xfb\x9a\xdfey\x83\xe4*^d\x8c\xf1\xd06F\x83fn>\r\x87\xdb\x06\xbb\xd92V\xb3qh'e%\xe5\b\x82\xbf\xc0\xf5\x9a&8\x05=O}\x1f\xaae\x88-@uxf\xb0s\xd3\xee+;\x1d\xdaĿ?\xb1\\o6\x01\xbeݡ\xd78a4\xed\xf6\xa8?k\xfb]j1\xfe\\\xc7֠\xa1\xaf\xc7n\xff֜\x9b^6]]\xbe\xee\xb3\xc3~ζܛ.\xf7\xfd\xd3\xebs\xd7\xe5pID\x91!\x93Q\xe1\xa5ɏ`s\x8a%I\x18\x15,\xc3qƖa\xa0H\xc0$п\x05\xa3:\x1f\xf5\x9e\x8c\xb8A@\xd2z\xe6\x8e G\x0f\xd5\x11c\x98\xa3\a\xcfq\x9b\xd3m\xa2\xc9\x1b\xfb?\rI\x1a\xc5\xf7$\x95\xab08\x9eN\xffbV\x18\u05f9\x87\x81Xje\xd4\xeaDq\xa0o\xbd\n\x8c\xb9\xaa_\xb0\xca\xc6\x1f\x83\xe0V/a\xcf\xec\x12ֿ\x825\x17\x1fZ\x9f\x8d\xc5K\xe1\xea\xe5W\x8cԯ\"\x18\x81\xb7\x1e}@\xf7ۖ$\xd5]\xaf\b\xef(\xae\x17\xa5\xba\xb1^\x8a\x9a\xf3X%N)\xf5\xd2\xe6}\x1dU\x8a֮\"MX\x18ͪ\x19feT+\xba?\xc7ꛨ*\xcaUE\xa4J4\xb6h\x94\x9bAP\xd2\x14/\bũSۛ\x9c\xa3\xc6_\x15\x10\v\xc6\xf4O5\x17tǗ\x12eD\xae\xeb*dj\xeb\xaf\xd6D>\x1ci\xc1x\x8e䯦Qo'\x95i\xec\xe7\xf3\xbbe\xe4\x9e\x00\xf5\x02\x97\x85\x8fw\xb1\x96X\xd4\x06ӟ\xae1\x95ڞ\xa3\xca\x1e\xf1\x1b,\x04Z\x9a\xae\xfd\xe4\xa4\xec\x9e\xee\x94\xf4\x01'\x98\xdc\xe1\xb4GZ\xd5\x1d\xb99I9\x1b\xcd3\f\x17,]\xbb\x0e\xef\xf6vKMS\xf0\xe9r\x0f{{\xecȭm\xbd\x1b\x84\xaahݬL7\"\xa9c\xee\xd5\xf1\t莑\x14V%M\xb9*\xa0\xd8\x02(\xbe\x87\x95\xcc3\xc0\x19\xce1\x95\xc2N\xc5TMR\x04_J\x92|\x06Q :\x02\"\xe1\x9ed\x19\xcc1d$'\x12\xa7\xb1\x86\xa6\xf8^\xcf\xdaz}Y0\x0e\xa1\xbe.Q z1t\x16\x0f\xcca\xa6\x1b?j\x92[\xa7\xc3\xe8\x1d\x17\xa5P\xc9\x05s\x1d]\xee\xa1f\xb5\xeb\x84#M\xbfu\xaf\x9f0\xaa\xd6[E\xf6\x92Q\x8a\xb5\x8d\a\x1b\xfbl\x1fjA\xd4.\xf0\t)\xecJ\xae\xf7\xaf\xbe*\xde\x0ed\x1b\x846[\x15\u007f\t\xa3\x1a\xe2<MUj\x8f\xf6İj\xb44\x98L\xe0\x9f(\xb3W\xb6\xbb@R\"\x123\xfez\x9b\u007f\xa7\x98\x83Qk`\x83\x1dp\xac\xa4)2\x81:\xd8\xdc+\xed2he\r{\xd0@d\x86\x03m]e\x99\xc6Ao\x99ĭ\xe3Y\x1b\x990\xdb\xc7\xda\xee\xd1\xd8=IV\x86k\x85\xc4Xjk\xea\x98\v-dt\x02\xfe\x98c\xc9Xf\b\xf1\x97P\xf1G\xb1\x9a\x1da\x97\x92'08\xd8\x0e\xd6\x13*\xa17\xf1\xe5\x83\xeb\xb5n\xdf(k\xe3uB\x1d\x14..b\x1d\xb9mȁ\u007fz\xeeN2\x9c\xaa\xec\xa7_\nD\xf0\x9b\x92\xfd\x16\x9b'3*\x85\xa9\x9f\x98\xa6\x9b'4N\x0e\xb7g2Z\x9f\r5;r\xa1\xb7c\xdf\xd3\x0f\xfe\xda\xe6y\xc2Y\xd1\xf6\xf5\xc2&\xda\x06P\x87\x0f\xc8\"\xfc\xaeK\xc5\xfa\xba\xd8_mېQ\x14m\x9et\xb5\xa0ܛ\xdfh\x17q}\x95\xbcCn\x8f\xe1\xf7\xb4\xbc\xc0\xf5\xf69r\x97K\xf8\xfa\x15\xf6\xe3\xaa\x1cU\x17\n\xfb\xba\xc9\x01i\xf1\x1f4C\x84s=\xeb\x96\x15\x1e\xe6H\xab\xd8Y\xb3\xec\x1f\xa5\xdc\x16\x1f\x87۫\xcd\xe9٬\xaei\xf6\xb4[\v\xac\x03\xe7 \xfb9p\xfd6\xac\xb0};\xb6\xaa\xb1Cl\x993\x95\xf1;\xf3o\xabF\xb8!9~\xa5u\x92\xfbۨ\x1b~;\xf2AV\xdb\x14`\xf7\xb7[$l\x18i\xe3\x84ܩ\xdd\xea_\x8f\xe0سi\xddq\nϦ6\xa7_-\x80\xdda\x0eϦ\xba\x82Ի\xb1\x110\x9a\xad!c(\x85g\xd3\x18\xfeW\x15\x8bK,\x81\xe3\x1c\x11J\xe8\x12(~\x90P !\xe2\xf6e\x82\xad\x8d^q\x96߰\xe2F\xbfFr\x17\x92\xaeS\xdf\xcd5c\xaf\xeb\xd0ڶ[oC59)\x86g\xa7\xaa\xb0\x80\x14I4\xb6\xd5\x01$*MΆ\xb6\xaa\x00Ɋ!\xe8\x8af6\x1c\x9e\xbdf(%t\x19\xc7\xf1\xe9D\xb1n\xbd\x135\xe7\xf7\x95S\x87\xbbi\x9d\xa5f\x0f\xeaV\xd0\xec\xc1\xa1\x92\xdb\x10t\x818\x1b\x8e\x8f\xa7{\xb0T\xf3y\u007f\xb6ꢢ)M\x87\x95M祔\x8c\x82$t\r(\xc3\\\x0e\xcf.k\xaa\xdeۉ\xaeK\x86m\xf7ꛑ\x83\x8a\xff\x04\xce\u007f\x02\xa7\xaf\xa8y\xf47\xff/3\x8chY\xc0\aVJB\xf1\xe0wl\xf1U\xf1\xe7m\U0007bdcdjÒde\x8aE\x18\xd8\xf8\bܺO\xc1؇\xa8\"l\xb6\xd0#\xe8ƮV\xbd\xc8˨;\x8e\x1a\xfa\xd6\x0e\xb2\b\xf7\x19\x81>\xc8jb;\x0e\x0e9\xe6\u061c\xc3{\x88\xf4\xa5m\x0edP\x9f\xa0<F`\xef\xd7\xce\xd3\x142\"$\xa6j\xa5\x93\f\x9a\x10\x03\x13Z\xfa\xa5\xb1\xcd\x16\x8c\x86Ü\x95\x02\x97\xc5p\xe4\xf8\x1d\xdc\xddvsWf\x170\xef\x15\xa5C\xe7\x8f\xc9ݢG\xad\x03\xce\xdd\x17n\xf6\x95ǹ~\xad\xafM\x9fbJ\xbc\x03Ī\xc6PtWi\xcf9A\xfd\xac4%\x02\xcd3\x05p\x00\xbbqå\x95<\xe8t\xe5\x1fT\xe3\x10EΥ\xc4y!\x9bo\x02<ڣ\xf7h0\xb8f\\V\xe5\x86y\a\xdf]\x8a\x98\xbe\xc9\x04\x14\x03\xa1\xcb\xeaW\x98\xaf\xe1\xb2\xe4\xfa`dP%\x81qj[ڱ\x02NL\xe8oZ\x84A,\f\xe0\x98\xe4\xcb\xee\a\xbdd\x11\xbaZ\x1aU\xdc/Bx\"\xc7\nςm{\xf7\xdc\xcbd\x02P\xf0$\x18\x05$_N\xca\".\xaao?\xb4\x9f+\xffk%\xa7\xec\x9e6\xb2U2F\x9c\xa3\xb5=\t\xeaʶK,u\xfa\xb8C\xd9\xf9\x0e\xd2\u07ba\xda`8\u0096*)\xa0L\xf9 \xacԾ\x12\xaf\xb1\x107+DG\x86nT\xcbԼ\x9bR\x03{\x94\x84j\x9a\x9e@k|\xad\x03ԉ\xb3\xab\xf7M\x84\xe9d\xfe\xadb\x8b\x14\a\xf9\xd6'?8\x9e\xfeTi\xdf\"\x86\x9a\xf5gk\xec\x90\xe2\x0fGM+ \xae1\x95MH\u0603\x89o\x15\x14J\xdcA\x8ej3\x1c\x1c\x18\u007f\xba\xc4o\x11\x1c\xd6+[##\x17\xcb?\x1c\x1a\xbf#\x9fT\xc7)M\b9g3\xdf*\x8c*\x91\a9\xb6\x8b\xe9\xe0p\xfa\x97I\xfe\x16a\xe5x\xea\xdf&\xb4\xea\xeb|W\x81\xcc\xca~e;]\x1dJl\xb4\xa8\x1e5\xf4\x87\x8bW\xa6U\xdfC\xf4ܺYș:O\x8f\fS\x989\x02\xe3\xfa\xb5Ƃq{U9\x83\xe9\x89\xf9.\x1b\x9cVL\xb6\xe1\xe8\xa8RC\xe6\xc5?\xf5W!\x1b,\xf7\x1aS\xe6\x05\xcc\xcc\xf8\xaa\xe6\xaa*\xef\x1f\x9aQBU\xf4F\xfa\x18\x8eO\xe0\x13\x9c\xc1\xf8\x18\xfe\xfaW\xf8\xaem\xc0Б\xfd\xe96&\x94b~\x83\x1f\xe4\xc8j״D'\xf0i<v\xf7z.\xeb\xd1\xf1\xad?\x90O\xb75\x1drI\x90\xdf\xfb\xd8U\xcfo\x1d¿\xe9\b\x8co6\x01\x8d\x12\x83\r\x14\x99\x176\xa6̭\xbb\xe9\xf5\x1e\xf0x\xb3-D#\x98ױm\x9fw \xfdj[H\xaev#\xf0\xf5+\xd8\xf6\xb9\xdb^\r\xd8ʙZ\xb1d\x11\xa2\xf6\x1d\xc0\xbc\xeb́ǧ\x12\xc3u\x91\x11\xa9\f\x11\v\xf5[\x18\x84:7\xe9\xef\xfb\xa9f\xdd\xff\xf1\xf8\xb6\xea\x06\xd3mb=a\xf4\x0e\xab\xe85g\xf4\x9a\xe9\xe3\xf4vd\xd8?\x1e\xdf\xea,2\xafd\xcc}\x19s+c\xde-c\xde)c^˘\xbb2\x94\x01\x14\xfd\xa9fk\x8d\xf6\xd8w\xcet\xe0y\xc6-\xbe\xfe4ǌ+\x99\xb5]́\xc3\xdc\xfd\xa8\xbaM\x02BMޙ\x9b\x96yӢƦ\x1aOu_\xe7ت|es\x15\x9cj\xe0\x13 GG\xf6d\x80,·e>\xc7<\x9c\u007f$\xb7\xe6\xec\xe5-z\x1b\xb4\x9f\x1e\xd9#\xfe\xe6+ܖ\v\xf9\\-\xa6\xa9;o\xdaL\xa7\xe0J>L\xe0\x99\xcf\xdb#־0\xab]\xba\xb9\x17s\x1c\x8b\xaeq\xe2ƕy\x01(B\xa4\xfd\xd3\xd39\x8f\x06\xed\xd7{h\xa4\xa1F\xc1\xd7`4\x1fiN[\xdb\x18\t3\x95\xe3\xd4<\xac?\xf5\xc5H\xc5r:3(-\x0f\xeb':v\xd9rske\x04\xd5\xff\xaaZ\xf8<;l\fC\x92\x1c\xb7\xc3[\xb5\xed\x13\xc9\xe6\x8f_h\x1c\x95\xf2H\x8e\x9d\xf9zb m\u007f\x95yN\xe1Y7\xda\x00\xec]\x91\\a\x8e\x81\b@0\x85\x9c\xd0ɊORU\x03\x10\tb\xc5\xca,\x05!\xf5u\x11\xc7Hbn\x18\xe5\nQ\xc8\xd8=\xe6\x90b\xcarB\xb5\xbbc8OSB\x97p\f\t\xbb\xd3\xc7u+\fSH\x90\xb6\x8dU\xee\xe3\xf4\xf6\xe8\xc8SW\xa5\x9e\xe64U\xe0\xa4\xf9{\x04V\xed\x86\x15\xbe7S\xae\x0e\xd7N\x8c\x9c\xd0\xed\x18/\xa6\xbbAV|;\xc6\xf3\x17\xd3=PR\xb4\xde\x0e\xf3_/~08ݡc\xd2.ճp\x04&F\xea\x102\x1f\x1di\xbf\\l\b3\xac\xe6\xa5hK\xdf6\xf7\x9b\x1d\xdc;\x01\xfe\xbe\x1f@{\xa4\xe6\x94|\x85\xd6B\xa2\xe4\xf3\b(\xc6iV\x97a*\xf0Uf\xad\xfamt\x9b\xef\x89ܯH\x86!$^-B\x16P\xa3}$\xb70\x9b\xcdZ\x98\xe0\xe51U\xf4\xd5_;q\xaf\x14l\xbf.kO<\xad\x97X^\xbd\xd7OO\xf9:D\xf6\xed\xd8o\x03\x98|\x0fOU\xdd\xff\x8f\xebwo\xc3\xe1J\xca\xe2o\x93\t)\b]\xb0\x98\xb0\xc9\x10\x8e\xc0R\xc3\x11\f\xdd\xfd[\x8a$\xb2\t\xd6Ms\xaa9N\x8c \xf7\xaf\xa5@pu\xfd^?\x95\xd6\x14\x8c/\xf5\x03xx\xc7ɒЦò\x9a\xd7\xf1\xfat\xf5\xfbI\xf5\xb7;\x84\x9a\xac\xf2\x9eAƖDH\x92\xd4ڈf\xa4\xfe\xa3\x93/\xee\x03\x1c\xb2\xa8>\xc3\xd9\xcc\xfd\x16P\xa5\"G\xf4\xf3x\xa9\xff\"\x86\x178\x0e\xd7\xf8\xc7\x1e.\x96\xa5AO\xca5\x14\x1c\x1b\x02\xcf/\ue6c5\xb9\xfaw\x04\xb9}\xa3\xd0\xfc\x11\bݡք\xfa\x19\xafZ(*:\xaf\xa3\xad\xdb\x14\xc2)\xfc27\xa6T\v\x16\xcc\xea%R\xa3N\xe0\x18\x1f=\x8fb\xc9^\x91\a\x9c\x86\xc7Q%\x14N]\x13)ƹ~\xd7\xfe˅g\x1c\b]\xa4\x17\xd1&ۦ\xbc\x17-y.\xfc\x9b\x8b\r3\xf6\xc1\xfc\xf7\x16\x98\xbf_TC\xceaV\xdbʎ-7_\x02\xab\xb5\xcc\x1b\xf8ڪ\x13Cі\xa0ь\x1d\x02\xbfNԭ:\x90\xe76z\x1f\xff?\x00\x00\xff\xffy\xcd;2KJ\x00\x00",
-		hash:  "0869984dd0709d9f41ea5b5698ba8f90842d92dc74e3df452dee73ea597fa817",
-		mime:  "application/javascript",
-		mtime: time.Unix(1507657100, 0),
-		size:  19019,
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec<ks۶\x96\xdf\xf9+N\xd9\xdc+\xb2\x96()i;\xbb\xb5\xe5\x998nn\xbdm\x1e\x8d\xbdw?d\xfd\x01\"!\t\t\x050\x00h[\x93\xfa\xbf\xef\xe0A\x12\xa0H=\xfa\xc8ܝ\xb9\x9dil\xe1<q\xce\xc1\xc1\xc1\x01\xe4;\xc4!-9\xc7T\xfe\x84\xc9r%a\x06\x93@\x8d\xe6\x18e\x98;\x83\x81\xc0\xf2\x8aJ\xcc\xefP\x1e\x95E\x86$\xfe\xe9\xe6\xd5/\xc3g\x93\xc9$>\xd54\x02\xf3;\xcc\xdfМP\f3X\xa0\\\xe0`<\x86\xff\x168\x03\xc9\xc0P\x81`k\frE\xe8R@\x8e\x85\x80\x05ǟJLe\xbe1l>\x92\xa2\x92T\xb3\t\x9eD\xf7\x84f\xec>Nr\x86\xb2(\x00\x00X\x944\x95\x84\xd1(\x86\xcfz\x00\xa0\xd1,\x8a\xed\x90\xc0\xf2\x86\xac1+eT\x11\x80C\xd1K\xf78\x84\xa9\x99\x9c\xfe\x14ħAP3p\xf15\xab'\t\xfa\x80\x1e\xa2A2\x1e\f-oQ\xa6)\x16\xe2\aG\xcfϵN\x9e\xa9$/\xb1\x912\xd4?0\xe7\x8c\x1f@glc\xd4\x03xT\x1a\x02\x90\x05D_\xb9\x88\xd5\\\x9fD\xe1\xd7f|$$\x92\xa5\b\xe3D\xe2\a\x19\x85/Q*\xd9:\x83\xd7L»\x92RB\x97\xa11\x03ǲ\xe4T1\a\x9c\v|0'\x97\xcbc\xa5\x95\"#4\xc3\x0f\x14ݍֈ\xd00NVH\xbcȑ\x10QH\xc4\b\xa5\x92\xdc\xe10\xae4\x1e\x8f\xe1\x15\"\x14n\xd0<p\xbc\xa4\xa32\x8a\xc1\x19{\x9e\xe7o1\xe6\xc2zo<\x86K\x86\x05\xe0;\xcc7\x80(\x93+\xcc!ݤ\xb91\x17YD_\xb9q\x16\xfb\xf1s\xc3\x11\x15H\xdb^4q\xe4\xc7e\xe33\xd72\xd0\x1d\xbe\xb5\x8b\f.Y\xb4l\xc18>\xc0\x16\x97X\"\x92\xe3̷\a\xbaT\xff\x97\xeb¨\xda#\"]!.\xc5\x01B^hĖ\xc9\xcd`d\xbd\xf9\x18\xe8e\xad!p\xbf\xc2\x14\xee1\x88{\"\xd3\x15H4\x17\xc1\x93(L\xd4/\xa3\x94Q\xc9Y>*\x10\xc59\xe4\x04P\x18'iNҏ\x91\x1f\xdc\xce\"\r\xbc\x85\x1d\x00|v\x14\xa9W\xe8\xe3\x10\x9eM&q\xf0\x18\xab\xdc\x10~\x9d\x95\xebB\x8bC\x84b\x0e_/\xca<\x17)ǘ\x8eX\xa1xՂ[\xcbJ>\xc8\xe7\x1c#\x98\xc1\x87_K\xcc7\x91\\\x11\x11'\x82\xccs\x95\xa2\xa20q\xec\xd4\xe0'\x92-\x979\xb6\xa6l\xa4i\x1c\x8f\x93\x87\x88\xe6\x82\xe5\xa5ģ\x0e\xfdv\x12.\xc8\x03\xce:\xa9\x94\x05\x94?nX\xa1\xad\x0f\x8c\x82v{\xb0\xb5\xde\xe0\xbc\xd3\x01\xf0\xd9.PO\xfc\x8e@\xd9J\x06\xd2Y0a\x9cp\xbcfw\x95\xe6+\x92\xe10\xaeQs\x96\xa2|\x0fNf#:\x8c\x13\x94e\xdd8u@w`<\xd6a\xe1-\xb1/5\xfd^\x9d\xab\xb9\xf7\"8\x13\xdfe\x9f#\xe7n\xd0\xff_\xcd~\xbfۻ\xedcf\xefo\xd3n\x82\xd4js,\n\x98\xc1'5\xdfk\x89$\x8e\xc2Z\xf4\x10\xc2pX[Gaڽ\x81\xcd?\xc0\f\xfe\xeb\xfa\xcd\xeb\xa4@\\`\x03kt/\xd7\xc5\x14\xf4\x8f\xeb\x15\xe3\xb2\xda\x11\xd9\xfcCRɟ&\x1a\xa4~\xed$|\x87\xee\xbb\xc9ޡ{C\xe4Q=\xddI\xf5\xb4\x87\xea\xd9N\xaag=T\xdf\x1a\xaa\xe7\xa5\\u\x91}\x9b(\b\xe3D\x12,\xe2.ʫ\fS\xd9M\xaaA\xfd\x94\xaf6\xafY\x86\xbbI\r\xac\xa5\xebw\x86\xee\x05\xa3=\x93\xfc\xae\x99d\a\xddu\x8f\xf7\xbeK\x14\x04g\x15\xe1c\xac6\xc2VE\xe8W\x0e}\xd1\xc6q\x8a\xa9tq\xc3\xe1\xf1a7\x1e\xdbM\xfe\xf2\xe2\"g\xe9GS\x17U\xaa\xc7\xf0\xd5\f\xb4\xfe\x84\xe3T2\xbe\xd1H\xc9\xe5\x85\xc1k*`\xc3\xe2g\xbcy\xf5\xce\xe6\x88f\xee>\xadƉ=\xb2\v\x96m\xf4\xf0\x0e\xb2\x1a\xc7'}Y\xe6\xf9OH\xacvPV(-\x99\n\xa6\xca\x05!Ѻ\xd8A^\xe3t\xd0\xfb\xd6\xdae(\x87\xd68n\x94U\x98\xa3\xb9B=\x88\x89\xe5B\x16\x1aM\xd7\xc9$sC@\xf9\x8b\x96yS\x8b\x02\xf4`&\v\xc6\x7fD\xe9\xaa\xc9\xe2:\x05\xfbg\x1a\xb20\xa3\xc9\r\x93(\xbf\xa2E)\xe1\x1c&\xc9d2\x99\xfa\x98P\x15\x8b\x05\xa2V\x9a\x80sPy\xfd\xe1\x17\"\x14\x99\x9c\xb3l\x03_\x87p\x02\x96\xe9\xc3\xd5e\x9c\xe4\x98.\xe5J\xb1msl\x15\xc5\xd5\x7f\aH\t\xe3\xa4\xe0\xb8\xc04\x8b\xc2\xffm\x91\x9fI\x0e$\x9b\r|=\xe0\x04\xc2\xc1y\x1b\xd7\xe0g\xe7gH\x93,\xf4\xc1d$0\xe2\xe9j\x94\x13\xfaq\x00rS`\v!\x19J?\x0eη\x19\x9f\x8d\xd1\xf9\xd9Xf\xbd\xfc\x1d\x92\xc6К\xf0H\"q,՛R\xee$;\x1bK~\x1eƭ\xd1\xea \xb6\xcf\xd9\xe7 y\xe8\xb8x:\xd9r\xf2\x81\x1e\x85s\xc3\t\t\x19U\xfbvd\x8f\xd5\xcd\x7f\x8f\xe0\aP\xd0\xf5\xfbc}8\xf7\x97ӏTr\x82\xfb\x96\x90\x85n/\x1bL%\xdf\xf8\xb3҅\xb4Dy\xe0O\xd1.|M0\x92\n\xa1>\xf2F\xca-\xd6\f\x95\x1e\xfb\rz\x02a\xec\xf9\xc6\xf1K\xc5E\xaf7-2\xd1Ip\xc7z\xb3+8<iЕ\bP\xf5\x12\xa1W\x97\x80\xbc}\xc1`i\x18\xc9\xe2\xceez\x00+\x9fK\xaf\xfb\x9a\xb9\x1d\xaa^\xf8\x963\xd5:\xd1\x1d\x84\x03\xb53\xae\xd1\xff\xaeԠ∤\xe4Q\xa8\x96\xb9\xaa\xeb4,ܭg\x8f\x9a8M\x99\x90\x1d&\xfc\xf1\xc5\v&\xe4\xc1:zl<\x0e\xfd\xc1ߕI\xf7\x87[\x7f\x1au\x93\xa8\xaf`W\x12=\x93\x99\xc6n\x99w\xb0?\xafj\xdc:\xab\xfa\x92vd\xd5J\xa0\x8d\x8c\x03\x04i\xcc\x15F\x99+\xc9F%\x1c(\xcdx\xc6e`\xfcғ\\\xbbRk\xc7\x02\xfe\xddy\xf5\x10>\xfb\x93\xea\xde\x1c\x1at\xe6<'\xdfٽqG\xc6;b\x0f\xa9S\x9e\xa9\x9c\xc7cx\x06\xea\xccI0\x17@(\\ \x99\xae\xb6Z\xacU\xb3\xcf)\xa5\xe7\n\xf1W\xa7\x9e^o\f\xda\xd0m[\x0fS\xb6.r\\\xb1\x18\x9a\x86e\xcaJ*\x87\xe9\nQ\x8a\xf3_\xb4bG\x17ޕ8\xd0\x05\xf6\xfb\xc9mb>k`\xee\xc1\xa6\x1eLi䁟z\xe0\x05΄\x05<\xbbM\x168ӣ\xa8tGQ\x99\xd9Ƭ(^\x92;l!\xdf\xdeZ+\a\xad\x06\xed\x02gz\xcaa\x9c\xa8ν\x12\x11\xb7PP\xe9\xa1(y\xb6\\m\xdf\rhC\\Q\x19U\x16hXQ\x96ẤVl\x1a\x14c\x16\xffB\xa1攻\x8c\x8c\xcb\xdfr\xb6\xe4X\x88\vĕ\x8e\x1b\x9a\xbe$\\GURX\xd0h\x8d%\xe6\xe1\xd0\xd7p\xe8I1,\v\xccU$\xeb;\f\x9b\xe2}Uf\xeef\xda`O'\x93\xae\xeen\x83\x10y\xa2Ǟd\xf8\xa6\xa6wI^!\xb9J\x169c<\xb2\x83qЬ\xcd'\xd1`\xd7d\xb7GFj5\x0e좬\xa4\x9c@\xf87\xb8\xde\xd0\x14g\xa0ש\xef\xc3\x13\b\x81-@\x01<3صiϕ\x9d\x0em\xe2\xdf_X\xae7\x9b\x00\xdf\xed\xd0k\x9c2\x9au{\xd4_\xb5\xfd.\xb5<\xfe\\\xc7\xd6L#_\x8f\xfd\xfe\xad)\xb7\xbdl@]\xbe\xee\xb3\xc3aζ\xd4\xdb.\xf7\xfd\xd3\xebs\xd7\xe5pID\x91#\x93Q\xe1\x85ɏ`s\x8aEI\x19\x15,\xc7IΖQ\xa8P\xc0$\xd0\x1f\xc2a\x9d\x8fz;#n\x10\x90\xac^\xb9CX\xa3\x87\xaa\t\x19\xadу\xe7\xb8\xed\xe56\xd6\xe8\x8d\xfd\x9fD$\x8b\x93{\x92\xc9U\x14N'\x93\xbf\x85q\xbbGy\x1c\x13\x8b\xad\x8cZu\x14\x03}/Y`\xccU\xfd\x82\x05\xcc\xe0}\x18\xde\xea-\xec\xa9\xdd\xc2\xfaw\xb0\xe6jJ복y)\xbez\xfb\x15C\xf5\xab\b\x87\xe0\xedG\xef\xd0\xfd\xae-I\x81\xeb\x1d\xe1\r\xc5\xf5\xa6T\x0f\xd6[QӱU\xe2\x94R/l\xde\xd7Q\xa5p\xed.҄\x85ѬZaV\x86\xc5j\xad\xb1\xfa\xae\xb0\x8arU\x11\xa9\x12\x8d-\x1a\xe5f\x10\x964\xc3\vBq\xe6\xd4\xf6&\xe7\xa8\xf9W\x05Ă1\xfdS\xad\x05\r\xf8T\xa2\x9c\xc8M]\x85Ll\xfd\xd5Z\xc8\xc7sZ0\xbeF\xf2W3\xa8\x8f\x93\xca4\xf6\xf3\xf3\xbbe\xecv\x80z\x19\x97\x85\xcf\xefb#\xb1\xa8\r\xa6?]\xab\xa6\x9f\xb2簲G\xf2\n\v\x81\x96\x06t\x98\x9c\x8c\xddӽ\x92\xde\xe1\x14\x93;\x9c\xf5H\xab\xc0\xb1\x9b\x93\x94\xb3\xd1<Ǡ\xdau\xaeû\xbd\xddR\xd3\x14|\xba\xdc\xc3\xde\x19\xdb9\x8b\xb7\uedea\xa2u\xbb2݊\xa4\x8e\xb5W\xc7'\xa0;F2X\x954\xe38\x13\xc0\x16@\xf1=\xac\xe4:\a\x9c\xe35\xa6Rإ\x98\x01\xa1\x80\xe0SIҏ \nD\x87@$ܓ<\x879\x86\x9c\xac\x89\xc4Y\xa2YS|\xafWm\xbd\xbf,\x18\x87H_\xa9(&z3t6\x0f\xcca\xa6\a\xdfk\x94[\a`\xf4N\x8aR\xa8\xe4\x82y\xf2\xd6\x0eƁ\x7f\xea\x84\x13\x8d\xbf\xf3\xac\x9f2\n3\x83\xf6\x82Q\x8a\xb5\x8d\x83\xads\xb6\xcfjA\xd4)\xf0kR؝\\\x9f_}U\xe0s\xab\xe1\xd1\xc7B\x9b\xad\x8a\xbf\x94Q\xcd\xe2y\x96\xa9\xd4\x1e\x1f\xc8ê\xd1\xd2`<\x86\x7f\xa2\xdc^\xaa\xefc\x92\x11\x91\x9a\xf9\xd7\xc7\xfc;E\x1c\x0e[\x13\v\xf6\xb0c%͐\t\xd4`\xfb\xac\xb4Ϡ\x955\x8c\x06\x92\xc8\x1c\x87ں\xca2\x8d\x83^3\x89[\xedY\x1b\x990;\xc4\xdank잤+C\xb5Bb$\xb55u\xccE\x96e|\n\xfe\x9c\x13\xc9Xn\x10\xf1\xa7H\xd1ǉZ\x1dQ\x97\x92\xa7\x10\x1cm\a\xeb\t\x9cY\xc7vX@\xefu\x87FY\x9b_'\xab\xa3\xc2\xc5\xe5XGn\x9be\xe0w\xcf\xddE\x863\x98ٷ\x1c1|V\xb2_c\xf3\xa8I\xa50\xf5\x13\xd3l\xbbC\xe3\xe4pۓ\xd1\xfal\xa9ّ\v\xbd\x13\xfb\x81~\xf0\xf76\xcf\x13Ύv\xa8\x17\xb6\xb9m1\xea\xf0\x81zYӥb}\xa1\xec\xef\xb6m\x96q\x1cow\xbaZ\xacܛ\xdfx\x1fr}\x91\xbcGn\x8f\xe1\x0f\xb4\xbc\xc0\xf5\xf19v\xb7K\xf8\xed78\x8c\xaarT](\x1c\xea&\x87I\x8b\xfe\xa8\x15\"\x9c\xebY\xb7\xac\xf0x\x0e\xb5\x8a\x9d5\xcb\xe1Q\xcam\xf1q\xbc\xbdڔ\x9e\xcd\xea\x9a\xe6@\xbb\xb5\x98u\xf09\xca~\x0e\xbb~\x1bV\xbc};\xb6\xaa\xb1cl\xb9f*\xe3w\xe6\xdfV\x8d\xa0\xaeB_j\x9d\xe4\xe16\xeaf\xbf\x9b\xf3QV\xdb\x16`Ϸ;$l\x19i\xabC\xee\xd4n\xf5\xaf'0\xf5lZ\x03\xce\xe0\xe9\xc4\xe6\xf4\xab\x05\xb0;\xcc\xe1\xe9D\xd1iu\xc5\x10\x18\xcd7\xa0\x1e\x9d\xc2\xd3I\x02\xff\xa3\x8a\xc5%\x96\xc0\xb1zQE\xe8\x12(~\x90P !\x92\xf6e\x82\xad\x8d^r\xb6\xbeaō~\xcf\xe5n$]]\xdf\xed=\xe3\xa0\xeb\xd0ڶ;oC5:)\x06\xe7g\xaa\xb0\x00\xf5\xeaed\xab\x03HU\x9a\x9c\rlU\x01\x92\x15\x03\xd0\x15\xcdl08\xff\x85\xa1\x8c\xd0e\x92$gcE\xba\xf3NTK\xa9\x9d:؏\xebl5\a`\xb7\x82\xe6\x00\n\x95\xdc\x06\xa0\v\xc4\xd9`4\x9d\x1c@R\xad\xe7\xc3ɪ\x8b\x8a\xa64\x1dT6\x9d\x97R2\n\x92\xd0\r\xa0\x1cs98\xbf\xac\xb1zo'\xba.\x19vݫoG\x0e*\xfe\x1d8\xff\x0e\x9c\xbe\xa2\xe6\xd1?\xfc\xbf\xc81\xa2e\x01\xefX)\t\xc5\xc1\xef8\xe2\xab\xe2\xcf;\xe2w\x1f\x1bՁ%\xcd\xcb\f\x8b(\xb4\xf1\x11\xbau\x9fbc\x9f\xf2\x8a\xa89B\x0f\xa1\x9bw\xb5\xeb\xc5^F\xdd\xd3j\xe8\xdb;\xc8\":d\x06\xba\x91\xd5\xc4v\x12\x1e\xd3\xe6\xd8^\xc3\a\x88\xf4\xa5mO$\xa8;(\x8f1\xd8\xfb\xb5\xe7Y\x069\x11\x12S\xcc\x05H\x06M\x88\x81\t-\xfdV\xdbf\vF\xa3\xc1\x9a\x95\x02\x97\xc5`\xe8\xf8\x1d\xdc\xd3vsWf7\xb0\x82\tY=\xa2t\xd0\xfc)\xb9'\xf4\xb8\xd5\xdf\xdc\x7f\xdff\x1fy<\xd7_\xa7Ж\xcf0%^\xff\xb0*1\x14\xdeU\xd6\xd3&\xa8ߔfD\xa8NW\x16\xc6G\x90\x1b/\\Z\xc9A\xa7'\xff\xa0\x1a\xc7(\xf2\\J\xbc.d\xf3U\x8dG\xdby\x8f\x83@=T\xac\xaa\r\xf3E\x85\xeeJ\xc4\xc0\xc6cP\x04\x84.\xab_a\xbe\x81˒\xeb\xbeHP\xe5\x80QfGڡ\x02NH\xe8\xaf\xc2Da\"\f\xc3\x11Y/\xbb_\xf3\x92E\xe4jiTq\xbf\xa9\xe2\x89\x1c)~\x96ٮ\x87ѽD&\x00\x05O\xc3aH\xd6\xcbqY$E\xf5\xf5\x94\xf6{\xe6\xbfV\xb2\xea\xdf6\xb2\x83\x00\x00q\x8e60\xabٴ\x93\xed\x12K\x9d=\xeeP\xfe|\x0fjoYmx8\u0096*'\xa0\\\xf9 \xaaԾ\x12\xbf`!nV\xaa/\xaa\xf1\x86\xb5LM\xbb-5\xb4\x9d$T\xe3\xf4\x04Z\xe3k\x1d\xa0N\x9c]\xbdm\"\x8c\x14_0\xb6Hq\x94oI\xb1˫{\xe3\xe9O\x95\xf6%b\xa8\xd9~v\xc6\x0e)\xfepԴ\x02B\xb5\x15\x9a\x90\xb0}\x89/\x15\x14J\xdcQ\x8ej\x13\x1c\x1d\x18\x7f\xba\xc4/\x11\x1c\xd6+;#c-\x96\x7f84~G>\xa9\xba)M\b9\xad\x99/\x15F\x95ȣ\x1c\xdbEtt8\xfde\x92\xbfDX9\x9e\xfa\x97\t\xad*H<\x05r+\xfb\xa5\x05\xba:\x94\xd8hQ\xbdi\xe8\x0f\x17\x17R\x7fQ\xd4s\xebv!g\xea<=3La\xe6\bL\xea\xc7\x1a\v\xc6\xedM\xe5\f&\xa7\xe6ˀpV\x11ف\x93\x93J\r\xb9.\xfe\x89r\x8f\x97{\x8b)\xd7\x05\xcc\x00\xb9\xc3UU\xde?5\xa3\x84\xaa\xe8\x8d\xf4\x11LO\xe1\x03\x9c\xc3h\n\x7f\xff;|\xd56`\xe4\xc8\xfep\x9b\x10J1\xbf\xc1\x0frh\xb5kF\xe2S\xf80\x1a5r\xc0U\xfb\xc3\xc9\xf4֟ȇ\xdb\x1a\x0f\xb9(ȇ>v\xd5\xf3;\xa7\xf0/:\x03\xe3\x9bm\x86F\x89`\x8b\x8b\\\x176\xa6̥\xbb\x81z\xefw\xbc\xd5\x16\xa1!\xcc\xebض\xaf;\x90~\xb4-$W\xa7\x11\xd5÷\xe3sw\xbc\x9a\xb0\x953\xb1b\xc9\"B\xed+\x80yד\x03\x8f.\x00@\xd7EN\xa42D\"\xd4o\xea]j\xac\xc6\xd5\x17\xb6`f\xe1\xea\x05\xa6\x05\x83\x01\x9bXO\x19\xbd\xc3*zM\x8b^\x13\xbd\x9f\xdc\x0e\r\xf9\xfb\xe9\xad\xce\"\xf3J\xc6ܗ1\xb72\xe6\xdd2\xe6\x9d2浌\xb9+C\x19@\xe1\x9fi\xb2\xd6l\xa7\xbes&\x81\xe7\x19R\xfc\x05\x8e\x19U2k\xbb\x9a~\xc3\xdc\xfd\xa8\xc0&\x01\xa1&\xef\xcc\xcdȼ\x19QsS\x83g\x1a\xd69\xb7*_\xd9\\\x05g\x9a\xf1)\x90\x93\x13\xdb\x19 \x8b\xe8u\xb9\x9ec\x1e\xcdߓ[\xd3zy\x8d^\x87\xed\x97G0u\x17qC\x85|\xaa\x16\xd1\xc4]7m\xa23p%\x1f'\xf0ܧ\xed\x11k\x1f\x98\xd5.\xdd>\x8b9\x8eE\xd78u\xe3\xca<\x00\x14\x11\xd2\xfe\xe9\x01\xce\xe3\xa0\xfdx\x0f\r5\xaba\xf8[8\x9c\x0f5\xa5\xadm\x8c\x84\x99\xcaqj\x1d֟\xfab\xa4\"9\x9b\x19.-\x0f\xeb\x17:v\xdbrske\x04\x05\x7fYm|\x9e\x1d\xb6\xa6!\xc9\x1a\xb7\xc3[\x8d\x1d\x12\xc9毓h>0\xd3T\xcez=5,-\xbc\xca<g\xf0\xb4\x9b[\x00\xf6\xaaH\xae0\xc7@\x04 \x98\xc0\x9a\xd0\xf1\x8a\x8f3U\x03\x10\tb\xc5\xca<\x03!\xf5m\x11\xc7Hbn\b\xe5\nQ\xc8\xd9=\xe6\x90a\xcaքjw'\xaaW\xa7.\x93\xa6\x90\xaa;(\xa1\xd8\xc3\x04R\xa4mc\x95{?\xb9=9\xf1\xd4U\xa9\xa7i\xa6\n\x9c\x86qK\xed\x86T=x\xf4\xfe@D'\x8f5\xa1\xbby|?\xd9\xcfd\xc5w\xf3x\xf6\xfd\xe4\x00.\x19\xda\xecf\xf3\x1f\xdf\x7f;\x99\xf4\x87\x8eI\xbbT\xaf\xc2!\x98\x18\xa9C\xc8|t\xa4\xfd|\xb1%̐\x9a\x87\xa2-}\xdbԯ\xf6P\xefe\xf0\x8f\xc3\x18\xb4gj\x9a\xe4+\xb4\x11\x12\xa5\x1f\x87@1\xce\xf2\xba\fS\x81O`\x06\x15\xdcF\xf7\xa9\x06ޯH\x8e!\"^-\xa2\xeeF+\xec\xf7\xe4\x16f\xb3Y\x8b'xyL\x15}\xa7\x01l\xdf(X\xb8.kO=\xad\x97X^\xbd\xd5/O\xf9&B\xf6\xe9\xd8\xe7\x00\xc6\xdf\xc0\x13U\xf7\xab\x1ep4XIY\xfc0\x1e\x93\x82\xd0\x05K\b\x1b\x0f\xe0\x04,6\x9c\xc0\xc0=\xbf\xa9\xeb(\x9b`\xdd4\xa7\x86\x93\xd4\br\xff\x9c\r\x84W\xd7o\xf5Ki\x8d\xc1\xf8R\xbf\x7f\x877\x9c,\tm\x00\x96T\x03C\xdd]\xfdf\\\xfd\xf1\x13\xf5\xdd4\x90\xf7\fr\xb6$B\x92\xb4\xd6F43\xf5ߜ|r\xdfߐE\xf5\x19\xceg\ue5c0*\x159\xa2\x1fGK\xfd'E\xbc\xc0q\xa8F\xdf\xf5P\xb1<\v{R\xae\xc1\xe0\xd8 x~q\x9f,\xccտCX\xdb'\n\x95\xce`\x00jO\xa8_\U0006a362\xc2\xf3\x00m\xdd&\x10M\xe0\xe7\xb91e\x000\x87Y\xbdEj\xaec\x98\xe2\x93gq\"\xd9K\xf5\xd7N\xa2i\\\t\x853\xd7D\x8ap\xae\xbc\x02?_xƁ\xc8\xe5\xf4}\xbcM\xb6-\xef\xfb\x96<\x97\xfd\xab\x8b-3\xf6\xb1\xf9\xcf\x1dl\xfeqQMy\r\xb3\xdaVvnk\xf3\x1d\xb0Z\xcbuþ\u0084\xb1\xc1hK\xd0܌\x1dB\xbfNԣ:\x90\xe76z\x1f\xffo\x00\xaf\x93\xa0\xec\xecK\x00\x00",
+		hash:  "4c10b9d7c968a39e442990955e31cdc7b7ca1cb006a3b8df4f08129b57d36d5f",
+		mime:  "text/javascript; charset=utf-8",
+		mtime: time.Unix(1792369086, 0),
+		size:  19436,
 	},
 	"js/factomd-ajax.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW]o\xdb6\x14}\u05ef\xb8劅Be)[\xf6\xd4T\r\xd0u[1t\xe9Vw\xc0^i\xe9:b,\x93\nI\xc56V\xff\xf7\x81\x1f\xb2$\xc7N\xe3\x15\xd8\xc3\x1e\x02$\xe2\xe1\xb9_\xe7\x1e)\xf3V\x14\x86K\x01w-\xaa\xcd\xd40\x83\x94\x1b\\&p\xcf\xea\x16\x13\xb0\x80\x18\xfe\x8e\x00\xee\x99\x02\x85w\x90\x83\xc0\x15\xfc\xf5\xdb\xfbw\xc64\x1f\xf1\xaeEmh\x1cE`OS)\x14\xb2r\xa3-SQ1q\x83\x90C\x17\x85z&\x00>\xa7\x16\xec\xa0.(\xe49\xfcН\x02dY!\x85\x965\xa6\xb5\xbcq\t\xc1\v 0\x01\x02/\xc0\xdfԍ\x14\x1a\xe3p\xc1F\xa0\x0f\x0f\xb6\x91\xffq\x995((\xf9\xe5\xa7O$\x01\x92fsV\x18\xb9,\xaf,yni\xbb(ߺ\xcaݣ\xd0\x03\xa3Z\xc7gY4\x8a\x92\xc6\xd16\x8av\xad\x9b1ST\u007f\xec\xf7\xef\xff\u07b87\xb6\xea+W\xfb\xae}\xc7Z\xf5\x9c\x92o\xfc\xb5\x89F\xa6\x8a\x8a\xc4iQ\xf3bA\xf7\n|NI:\x02NP)\xa9H\x9cꚗ\xf8gC\xe1\xe2\xfc\x1c\xe2h\x1b\x1f`\x9d\xe8v\xb6\xe4\xe6\x18\xb9\a\xbdaj\xea`Ա<\x8cXHa\x18\x17h\xa3.p\xd3(Ժ\xa7\xc2~\xa6\v\xdc@\x0e\x98\xae*^T\xf0\xf93\xa0\xc5\xff(K\xbc\x8c준>\xa3\x0e\x93\xc3w\x17q7#\x85\xa6U\"\xb4\xf7`J\xbd\xb2\x1e\x1c\xefb\xaf\x8f\xa9\t`\xfdt)\xad\x8f\vi(\xa3\xf5\x03\xd5\xc8\xd9-\xe4\xf0\xeb\xf4\xc3u\xda0\xa5\xf1\x00\xc4\xd6/g\xb7\xe9\xa7M\xe3\xb8I9\xabe\xb1x\x87\xfc\xa62\xa4\x0f\x04\xb0⢔\xab\xb4\x96\x05sU\xe7@|\xe1W\\4\xadq\xea\xb2L\xbb\x055\x9b\x06sOG\x02\xcb\x16\xb0\xd68\x0e\xfa,\ar-\x05\x9e\x1c\xec\x90\\\xefYM\xe3>z\x97\x93\r\xd4qg\x99\u0092+,\f\xfdj\xce\x04H#\xb5!\t\f:\vY\x06S\xb9DSqq\x03sيr\\~_\xe6\x17\x16\xe9\xad\\\tzq~\x1e\xef.<>\xef\xed\xc8\x14\xac\x00\xe7R-\xdf2Â\x0e\u007f\x0e\u007f\xd2\xd8j\xbf;LY\xd3X\x13 6gYZ\xff\xe8\x8a?\x84\ng\xc9\xf1f9\xb7\\\aG\xfa\xfd\xc34X\x92k\x95\u05fe3\x9d\x8e\xb93\x9f\x99,7$N\xa5\xa0gK\xd9jl\x9b\xb3\x84h\xf4K\xb6\xe7!5\x17\v\x92\xec\xef\xbbq*\x86[g\xf3\xd4T\\\xc7)3FQbO\\\xec\x8a\xe9j\x1fbp\xed\x97\xf2?\xd9\xd9S\xb7\xf2\xe0\x82\xf09\xed,\xcd\x1aWܟ<my\\\x1bF\x9a6\x83\x1d\xe9\x17u\x18\xe5\xfba\x02\xbb0~\xca\xd9\x13#8\xe1\r\xd5\xfaŕ<\xcc\xf3\xef6\x8f\xcf\xc7f\xa7\x1b,8\xab'\xcc\xcdo2głħ\xb9У\x8d\xfc\xfa\x85\x1f\u007f)\x9c\xb2\xf2\xef\xb9X<\xba\xf6\x16\xf0\xb4\xd5\x1f!w\xebo+?\x8aZ\b\xb9\x12$\xf13?\xcd\x0e,\x8f\u007f\xc3f\x19|\f\u0080\x157\x15\xd8+\xd6\x03\r\nӿ\u007fw\xe2iU\x9d\x80\xaf$\xe9`\xfd\xcb\xd8\xcd\fr;\x83W\xee\xf7\xd7d\xe4\x0e\t\x90\x8a\x97%\x8a`c\x1dA\xc0\b\xb6t\x98\xf0\x98\f\xfd\xe29={e\xd3\u007f}\x96\xec\x86\xed\xf3x\xd9\xe5\x13\x9ez\xa5\xbd\x84V\xd5vf\xbe\xfc\xd04\x97ThH\xf8\x92\xb8\x8c\xb6\x97\xd1\xe0SC\xe0\xda\\\xcb\x12\x83\xd5X5@>\xfc\xaf\x80t\b\x92\x90\x81?Z`\x10\xb6u\xed\xa2U\n\x85\x99\bY\xe2D\xb4˙\xfb\x8cr6\xe8\x90>\xb5m\xf4O\x00\x00\x00\xff\xff\xe0\xe4EHx\f\x00\x00",
-		hash:  "234eb2a1d1707c7445dd034c84421b37eb90e6d0f91eb6579a5fd8331a021c02",
-		mime:  "application/javascript",
-		mtime: time.Unix(1491149617, 0),
-		size:  3192,
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW]o\xdb6\x17\xbeׯ8\xe5\x1b\xbc\xa1P}dˮ\x9a\xaa\xc1\x9anˆ.ݒ\x16\xd8--\x1dG\x8ceR!\xa9\xd8\xc6\xea\xff>\xf0C\xb6\xe5\xd8i\xbc\x02ð\x8b\x00\x91\xce\xc3\xf3\xf9\x9cG\xf4\xb8\x13\xa5\xe1R\xc0}\x87jqc\x98A\xca\rN\x13x`M\x87\tX@\f\x7fF\x00\x0fL\x81\xc2{(@\xe0\f\xfe\xf8\xf5\xfd\xa51\xed5\xdew\xa8\r\x8d\xa3\b\xac5\x93B!\xab\x16\xdaz*k&n\x11\n\xe8\xa3P\xef\t\x80\x8f\xa9\x05;\xa8\v\nE\x01\xdf\xf5V\x80</\xa5в\xc1\xac\x91\xb7.!x\t\x04R \xf0\x12\xfcI\xddJ\xa11\x0e\al\x04\xfaذ\x8c\xfc\x9fˬEA\xc9O?|$\t\x90,\x1f\xb3\xd2\xc8iun\x9d\x17\xd6m\x1f\xe5\xff\xaer\xf7*\xf4\xc0\xa8\x0e\xe3\xe0E\xa3\xa8h\x1c-\xa3(\xcf\xe1{W\x95\x06S3\x03\xa1ZW90\x85\xd0Jm\xb0\x8aV-\xb6\xcf\xff\x9a\x0e?\xbba\xbf}\xb8\x19v\x8cl7Ą\x14/\x91U\xa8(\xb9\x90\u00a00\xe9\xc7E\x8b\xf6$kۆ\x97̦\x97\xcf\xd3\xd9l\x96\x8e\xa5\x9a\xa6\x9djP\x94\xb2\u008a\f\x9aKV\x13\xf1\xe6O\xd7?_\xc8i+\x05\n\xe3:\x17oMi\a\xcc\x19c7\xa6U\xfbG̔\xf5\xef\xdb4\xff\xaf\xf3\xfb\xad\xad\xfa\xdcվb\xf9>F\x1fQ\xf2?\x7f,\xd5\xc8TY\x938+\x1b^N\xe8V\x81G\x94d\x03`\x8aJIE\xe2L7\xbc\xc2O-\x85ӓ\x13\x88\xa3e\xbc\xc3k\xaa\xbbє\x9b}\xce=\xe8-S7\x0eF\x9d\x97\xc7\x11K)\f\xe3\x02m\xd4\t.Z\x85Z\xaf]\xe1z\xa6\x13\\@\x01\x98\xcdj^\xd6\xf0\xf93\xa0\xc5_\xc8\n\xcf\";)\xa0/\xa8\xc3\x14\xf0\xcdi\xdc\xcfH\xa1\xe9\x94\b\xedݙҚY\x8f̫\xd8\xf3}l\x02\x98?\x9fJ\xf3\xfdDڤ\xd1\xfc\x11k\xe4\xe8\x0e\n\xf8\xe5\xe6\xc3U\xd62\xa5q\a\xc4\xd6/Gw\x99]V\xeb\x9bT\xa3F\x96\x93K䷵!\xeb@\x003.*9\xcb\x1a\xe9W\x19\n \xbe\xf0s.\xda\xce8vYO+\x1d5\x8b\x16\v\xef\x8e\x04/K\xc0F\xe30\xe8\x8b\x02ȕ\x14xp\xb0]t}`\r\x8d\xd7\xd1\xfb\x9cl\xa0\xdew\x9e+\xac\xb8\xc2\xd2Я\xf6\x99\x00\xb1\xaaN\x12\xd8\xe8,\xe49\xdc\xc8)\x9a\x9a\x8b[\x18\xcbNT\xc3\xf2\xd7e~a\x91\xdeə\xa0\xa7''\xf1\xea\xc0\xd3\xf3^\x0eD\xc1\x12\xd0*\xed;fX\xe0\xe1\x8f\xe1\x91Ɩ\xfb\xbd1cm\xeb\x94\xd7\xe6,\xad\xc0\x93\xbe\xf8]\xa8`K\xf67˩\xe5\xfc\xd1\aĵ\xcas߉N\xef\xb9\x17\x9f\x91\xac\x16$Τ\xa0\xc7S\xd9i\xec\xda\xe3\x84h\xf4K\xb6\xa5!\r\x17\x13\x92l\xef\xbbq,\x86;'\xf3\xd4\xd4\\\xc7\x193FQb-.v\xcdt\xbd\r18\xf7K\xf9\x8f\xec\xec\xa1[\xb9sA\xf8\x98\xf6\x92f\x85+^[\x9e\xb7<\xae\r\x03N\x9b\x8d\x1dY/\xeaf\x94oc\xd8\x11\xc6O9\x7ff\x04G\xbcM\xb6~q%w\xfb\xf9{\x9b\xc7\xc7C\xb1\xd3-\x96\x9c5)s\xf3KǬ\x9c\x90\xf80\x15z\xb2\x91_\xbf\xf0Û\xc2!+\xff\x9e\x8bɓko\x01\xcf[\xfd\x01r\xb5\xfe\xb6\U000bda09\x903A\x12?\xf3\xc3\xe4\xc0\xfa\xf1_\xd8<\x87\xeb@\f\x98qS\xbb+4\x94\xfe~\xb9\xfe\xfe\xae\xc8ө&\x01_I\xd2\xc3\xd6\x1fc73(\xec\f^\xbb\xffߐ\x81:$@j^U(\x82\x8c\xf5\x0e\x02F\xb0\xa9Ä\xd7dS/\x8e\xe8\xf1k\x9b\xfe\x9b\xe3d5l\x9fǫ>\x9f\xf0\xd63\xed\x15t\xaa\xb13\xf3処\xb9\xa4BC\xc2M\xe2,Z\x9eE\x1bW\r\x81ss%+\fRc\xd9\x00\xc5\xe6\x8f7\xd2#HB6\xf4\xd1\x02\x03\xb1\xadj\x97\x9dR\xf6\x8a.d\x85\xa9\xe8\xa6#w\x8dr2\xe8\x90>\xb5e\xf4\xd7\x00\xd8Y\x03J\x1f\x0e\x00\x00",
+		hash:  "8ab9d521428546fe55ffdf6aaa2906bdc8bd7313f67ed0617cd8281131eb76a2",
+		mime:  "text/javascript; charset=utf-8",
+		mtime: time.Unix(1792369086, 0),
+		size:  3615,
 	},
 	"js/searches/tools.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xb4S\xcbn\xdb0\x10<[_\xb1\x90\x03\x84\x84b\xd9\xce!\x87:2\x10\xa4(\xdcSQ\xb4?\xa0\x88+k[\x9b\f\x96\xebDF\xe1\u007f/\xa8\x87\x1d'qR\xa0\b\x0f\x82D\xcd\xcc\xce,\xb9g*\x1e\xa2\x15ގ\xb0\x16d\x9b\xafFd`\x0eC\xb4\x853d\x97\xb1N\x8b\x15\x15\xbfU\xb9\xb1\x85\x90\xb3Jß\b\xa0\xff\x0f\x19\xfc\xfa\xbeA\xde*\xa9\xc8\xebT\xb0\x16\xa5#\x00*U\x8fI\xc9\x1a\xac\xbf\x95*^`\x1dk\x98\xc3h\xaa\x83\xc8\xe0\x88Z\x925*\xce\xe3N$\xbe\xf1\x05\xd1'\x88\x83\xda\xc0\vC\x06\x85\xb3\x0f\xc8\xf2\x85\xddz\x81\xb5:\xa2\xdf\xe7\x8cVT\xaf34\xb9쵴\x8e\x06\xff\f\xf6¡\xe4\x0ep\xe5\x11\xa8\x84W\x824\xde\x0eQ\xde\x0e\xb2\xc0\x1a\x9a\x1c\xc7)~\xba\x8f\xcf\x10\xedt\x14\xf5G\xf7\xbc}\x15\xd6\xeda\x02<\xe4\f\x15\u0590\x85g*\xee\x870٥ҳ\xf1\xb8t\\`G\xf5\xe4\xec\x1e߆9?\x9f5;\xa5cPa\x9b \x83\xc9\f\b\xae\x1b\xad\x15ڥT\xe1;\xc9\xe0R7ذ\x02;ɠ-\x94\x96\xecַUηΠ\xba\xcf\xd9\xe3W+\xc1`\xea7w^X\xd1\x05\\\xea\v\x98^i\xdd\xd6c\x94\r\xdb 3\x8bv/3\xb6\xcd\rmx\x91\xf0\x89\xe3\xd6p6\x99ѵ\x17\xee\xbdR\x92\xf4\xac\xb0\x02+\t\xb4$`\x8a\xce\xe5\x8d(҇NM\xaf:_\xbb\xa7\xee*\xac\x1bwg*\x96n\xac¬\x15\xce\nZ\x19\xf9\xcdz\x9d\xf3\x16搟\x9a31\xcf'l\u007f\xec\xfdK\x83\xea/\xc1\xab\x05b\x9dVd\xf0M\xe8\x9d3\x01\xe7+\xf7\xa8\xf4x\xecWd\xf0\xb3{\xb4j:\x99\xe8\xe6\x1a\x9d\b\x11\x88\xff\x9f\xe0]c\xef\x068d=\x99\xe1o\x00\x00\x00\xff\xff+jE\xfe\xee\x04\x00\x00",
-		hash:  "5ee175433a0e7150d2075be21daeb3c073cb13e0ac90ae3f7348274dc6445795",
-		mime:  "application/javascript",
-		mtime: time.Unix(1491149617, 0),
-		size:  1262,
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4\x90AK\xc3@\x10\x85\xef\xf9\x15\xc3\xd6\xc3.\x98\xa4\xbd\x15\xc1\x9e\xaaԓ\b\xfa\x03\xb6ى\xbb\x9a\xceJvbR\xa4\xff]6m\x14Ŵ\x05o\x03\xf3\xe6{\xf3^\x9e\xc3M\xc7X\x93\xae\xe0n\x19\xc0\xeaw\x84\xb5g\vH\x857\x8e\x9e\xc3%\x84\xd6qaa\x8d\xdc\"\x12\xb0\xc5Mr!\xc5\x04\x89\xebm\x8a\x87\xfb\xd4\x19X\xc0d\xb8\x13*+*W\xbcʲ\xa1\x82\x9d'\xa9\xe0#\x81/.\\\xc3\xcbC\x83\xf5V\xb2uAe\x8c\x1dK\x95\x00\x18\xcd\xfa\xf7\xf2M\xd7H,UV:2RL\xa2FD\xb1+\xe5\x00\xcc\x1c\x19\xec\xeeK)V\xd8\t\x05\vHg*:\xc2O\xd6\x1e\xa1\xc5\xc1R<=ަ\xf3+\xe8q{\xf7\xfd\xa2\x9f4s-E\x1cӆ˹PQ\xb5\x03\xac\x02\xc2\x19\xec\x15v\x00g\xb0m\xfc\xb8G';\x95\xc4r\xf9Pfl\xb8\xf0\xc4H\x9c\x86f\xb3\xd1\xf5\x16\x16\xa0\xc7\xdae3Z\xdd0\xf4\xaa\xa1\xc8?\r\x84ʬ3xT\xba\xf6&\xea\x82\xf5\xadTy\x1e*gp\xe9[\x92\xb3\xe9T\x1d\v\x11\x0f\xff\x9f\xe0\xe4c'\x03|g\x1d\xcd\xf09\x00\x1e\xa1>}\x1d\x03\x00\x00",
+		hash:  "3908a6bd1f2a2cc545f7d4634098ec67934aa72c2825d37d5dec1234288686e2",
+		mime:  "text/javascript; charset=utf-8",
+		mtime: time.Unix(1792361150, 0),
+		size:  797,
 	},
 	"js/vendor/foundation.min.js": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xfd}\x97۶\x958\x8e\xff\xffy\x15\x1a4+\x13\x16\xa4\x91\xec&m(\xd3ZǱ\xb7>M\xe2l\xecn7\xabQ\xfd\xe3\x88\xd0\b1\x05*\x044\xe3Ɉ\xfb\xda\u007f\a\x8f\x04HP\xd28i\xb7\xdf\x1e\x9f\x93\xe3\x8c@<^\\\\\xdc{q\x1fV;\xba䤠\xbdw\xcb<e\xecy\x9a\xe7\xcf\xd7x\xf9>\xe2\b\xc3;\xb2\x8a\xce\"\xde#\x94\xf1\x94.q\xb1\xeaa\b\xf9\xba,nz\x14\xdf\xf4\xde\xden\xf1\x8b\xb2,\xca\b<O)-xo\x99\xe6y/\xedɾz)\xeb\xa5=3\x00\x80է\xb1>\x8d\xf5i\xacOc}\x1a\xeb\xd3X\x9f\xc6\xfa\xe7\x19\xeb\xcc\xfc\x1dqx\av\f\xf7\x18/ɒ\x83\xa9\x9d\x05\x16\x9f\xc8*\xba.H\xd6\x1b'I\xf2R\u007f\x19m˂\x17\xfcv\x8bG4\xdd`xw\x9d\x96=\x9c\x9c\x9b\x96\x17,\x9a\xff-Z\xdcMP\x05/\xa2sD\x12<\xc2\x1f\xf02\xe2#^\xbc\xe1%\xa1W\x11\x84\xd3\x12\xf3]I{\xa4\xdf'\xa3\x1c\xd3+\xbe~:\x99\x91\xf9d1\xe2%\xd9D0\x06\xa0\xd2u\xec\x14x=\xf6\x8c\x8f\x96\x05e\xbc\xdc-yQʙ\xc4\xce\xe7\xd6\xc7\x1a\xbcD,L\xf5|\xce\xcb\x1d>\x1fq\xccx\xc4\xe1\xecl\x1c\x9f\xafҜ\xb9E\x93\x98\xb0\xef\xd2\xef\xa2\xc9C\x0eg<ަ%\xc3/\xf3\"\x15_\xeb>i\xddg\x8f\x8fJ\xbc\xcd\xd3%\x8eΣy:\xfce\x01\xa3\xf9\xb3\xe1\xff,\xe0\xf9\x15\x02\x9fM\x86\x9f=\x02pċo\x8a\x1b\\>O\x19\x8e`% \xc8\x12\xf0\xc5\xe8\xd1\xe8\x11@Erw\x8dKF\n\x1a3\xf4n\x9b\xef\xae\be\xf1]\x85\xde\xedv$c\xf1|\x81J\x9e\xc7v\v\xcdȠ\xe49\x10P\x8a\xc0\x9aor\x00G)\xe7e\x042R\x02X!\xd5Q\u074c#\xa2\xf6\x8e%d\xbf\x17ۍ\x8a\x84F\fN\xf9\x9a\xb0\x91\x19x^,\x12Q0g\x8b\x84W\xa8\xc4W\x84q\\~\u007f\xa0\xb7\x19\x8d\b\x8cq\xe4mQc\xcdS>\x12\xab\x91]\x8f\xfe\x03\xf3\x1f\x8b\xaf\xc9\x15\xe1,\xfa\x021\x88\xf8\xe83\x9c\xe3\r\xa6ܬ!\xe5\xe9\x10\f\x18\xdc\xef;\xbf!ե\xd7Z|\x8b\xc0/+5]\xe07o|D\xdck\xcaKru\x85\xcb\b\x10J\xf8\xe8\x97\xd5H\f\x8f\x14l\xe4F\x8c\xb6;\xb6\x8e\xf4\xa0\x15\xda\xd1N\xd8(Ȑ\x84F\x02(\x9d\x93\xf3\xc0e\xb6A\rŶ9Y\xe2\xc8-\"4\xc3\x1f^\xaf\xcc\x04\xd0ě}\x897\xc55~\xe6\x02\x88@]\xfaus\\\xbb\xd4\f3^\x16\xb78S\xeb%p\xba*\xcaHnk\x8f\xd0\x1e\x87\\\xa0\x01\xdd\xe5\xb9\xc0\x84W\x94\xf0z\x95ج\x12\xbbT\x8aOyy+\xe8\b\x81x\x84\xd3\xe5:r\xf0\x96\xcb\x05\xc16 \xde\t\x98G\xb0\x82S\x9c3\xac\xf1J\x9ckA\xf7P!\xb1\x06\xa5\xc9]q\xf9\x13^\xfas\xc0\xa3UQ\xbe\xf0\x06\x12\xa5\x02\xf6\x10\xf1\b\xcc54\xf0\x00,\x00\x1c\xad\x8a\x1d\xcdRY\r\xc8Q\x01\xac`\x85\x98\xa4S\xee\x19\xbbW\x0fhG3\xbc\"\x14gn\x17r\xf7Ԕ\xa3\xd7\xf2\u007f\xa3\xf7\xf8\x96E\x85=l\x10V\xd54\x9d\xb3E\x84aU-S\xbe\\G%\xbc\x13xQ\xe4x\x84%\x85/a\xb5\"4\xcd\xf3[CrpU!\xe7\f\xb9\xa7\x12\xd7t)\xe1\xfb\xfd\x17\xe8۔\xafG\xa5\x98s$\xff\xdc\x167\xd1\xe3/\x10\x1fL\xe0P}KiVl\"\xf8\xd0\xfb\faM\xbd\x1f\u007f\x01GL\xe2\xe3\x04\x0e\"<\x03\x02\x181\x10\xeb.\xf1*/n\x9c\rAT\\0\x06\x1a 1\xbbHg4qa\xe0\xd1\x1c\x18\x03\xb5\x01N\xfd~?\xa2ɜ.\xe0TcÚ\xb0)W(E\x91\x1d\x90\xa2B\xe1a\x9ax4\f\x95\t\x8f0\x1c\xad\b\xcd\xea-,\xd4\x16\xa6Y\xf6U\xba|\xdf,\x9f\x96-\x8cUw\x9d\xc6[D\x93\xbbjJV\x11na0t\xaf.\xb3}7iI#\xf0\xb6$8\xeb\xf1\xa2'p\x85\xa49\xf9\x05\xf7䈽\x82\xf6R\xda\xd3'\xb8\xc7\xd7)\xef\xa5y\x89\xd3춷\x967\xf8K\x8bj=\xb5\xb2\x11\x80j|\x87\x12\x16[Q\x81\x01(\xceB\xa8\\\x12\x13\x1e\x81\xa9D\xde\xc6Y\x91\x18\xa3!l*\xc6\x00\x8e6\xe96r\t\x9a\xbd\xec\xd4m]\xc1)\x9b\x8f\x17b\x93\xe6\xe2\x8fEB\"6\x9f,\xa08M\x82\x02\xb4@\x84\x04ےF\x16\x94\x10j|gM|gM|\xaf*yF\xaf0\u007fI\xbf\x13\x17?F\xbcL)#br\x98f-ʋ\x11I\xee\xea*1\xf0\xaa\x03\xf4W|\xf9\x9e\xf0\xb7N\x85\x9bF\xc9\vQ\xed\xdb◷ݝ\xbcv\xbf\x15\xfe\xc7\n\xd1$+\x96;I\x9a\x97%N9~\xa1\xb6Y\\\xcfנAg\tt\x8e̙=\x02#\xc6os<g\x02\xcc8!s\xb6\xb0l\x14\x9e\xe18\xc2\t\xc3\xfc-\xd9\xe0b\xc7=2k\b\xfc\x9fR\x9a\xe5\x82\xce7\xa6>\xe7\vX\x89\v\xa4\xf1AP\xa3b\xb4\xe3$O\xee\x04\xaf\xc9y\x8e\xe36\xaa\x10y#\x98\xa94N\vUĚ%iy%\xd7Ϧ\xa2v\x92$\xa4ߏH\xe7\x94\xd3\xed6\xbf\x8d\xa8\xe0\x06\x88\xbeq\xb0 \x8f\xd5T\x9dn[\x99\xd8q\x14\x98\bb\x82\x05\xda`\x9e:\xb4y\xb8\xf9\x19@\x94\x8a/#Z\f\u007fb\xea\xe40\xcd{\xee\xf7<z\xf0D\xb4Q\x1cs\x02\xfc\xa6O\x1f@1#L\xb3\xb7Edwr\x8d\xd3\f\xa2T\xf7\xd1\xef\xa7\xfa\x82}.\xba\x88\x80\x1e\ay\xf4/\xa1\xb0\x18}\x8b3\x92\xfe\xe7\x0e\x97\xb7\xe6\xaaC\xc5H\xd1Nu\x1eԽGV\x91!\x84g\xa2a\x90\xdf\xff+~P\xe2\x1e+\xca\xf2\x16\xf5\xc0\x80\x0e@\x8f\xb0\x9e\x10\x01\xd2\xdeu\x9a\x93\xac\xb7M\xcbt\x839.G\xbd\x1f\x8b]o\xb3c\xbc'x\xfe\xb4\xa7:\xef\x95x[b\x86)\x17?\xf8\x1a\xf76\x98\xaf\x8b\xacw[\xecz7\x84\xad\x15\xb5\xba.\xdecAp\x04\xb4\xcb\xe4YY\xa6\xb7\x0e\xc3-/\x84\x91\x90:\"\xbb\xd3\x02\xa5r\xc5\xe25\t\xe4\xd4\x15-\xf2\xfd\xbe\xfe{N\x16\xceB\u007f\xc0+\\b\xba\f\xae\xf6\x01\x18\x90\x01x`\xd7K{\xe9uJ\xf2\xf42\xb7KX\x15e\x0f\f\xa2|\x86\xa3\x1c\xc6@\xcc\xc5PX\x00\a@\xacg\"xf1G\xb5\x8d31\x03\x8d~9*a,\xbf\xf9\x17\x81\xbc֜z<\xa2m.\x06\x95\x82L\x19B\xb9&\xac\x9a\xde\x10\x9a\x157\xa3\x9a\x8e'\x05\xe2\xa3\x15u\x105I\x91s\x0e\xbeN9\x1e\xd1\xe2\xa6\xdf\xd7MM\xc1~\x1f5J\x12\xfbGK2\x88\x04$\xc5g8\xbaR\xc7MRlCsx2\xd7\x04\x0f \xb0)~\x01\v\x84\x93\xf1\x14?\xe1\x16\xb3\xcf\xf4`%\xfey\x87\x19\u007fF\xc9F\xce\xf6\xa5\xc0\xac\xe9``)\x01\x9f\xe3\xc5\xf4P\xe5D}\x9c\x93\x01\xf8!\xf4\x1d,\x90n\xbe\x14,d\xde\xd9\xfay\xe03X\xec\xf7\xcd\x1a\x1d\xa3Td\x15\x9d\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf\xe9}\xa1\x85?=\t\x9a^\x93\xabTH\x91;\x86\xcbgW\x98r\xb8\xdf\x1f\x04G\xfd9\xb4\x02C\xadƇ\xa1Ժ\xc2\xec\xf6F\x82 J\xdel\x93~\x88\xe8`\xf2\x05\xc2\xf6\x1e蠦\x11M\b\xac\x10\x19bX\x1d\x04\xef2\xc7i\xa9{\xa8t\xc5-.WE\xb9\x11\xd5-\x1a:e>6:\x1f\x92;\xc6Ӓ\xc7\xceĩ\xcb\x1bZ&\xa2\xae0\x94gM6\x13\x97|\x04Q@\xebpIh\xb6\xdfG\x1d_<\xc8\t\x02j\xd5\x1e\xf6\"\x95\x146HK;\xfa\xec\r{7\x82\x1b#\xac\xc7\xcb[I$\x8b\xde%\xee]\x8aSk\xa8\x8f }\x82\xf2h\n\x89O\xa6\x90Dݐ\xd4=\xb7\x15b\xedc\xdc#\x86\xdc\b*\xe6\bYt&Jb\x8e\xa4\xdec\x99\xf2蔱!\x84\xd5\xd4!Ouu\xc1\xc6տ\x12\xff#DΏD\x00\x90\"&6\xeb'y\xa1A\xe4)\x97|}\x92\x02̝\x19\xb6\xbe\xdc\xcc\xce\b6\x86'\x86\xab4R\x06\x1aN \x9c\xe1\x9a#\xed\x03!\xcbf\xbb%\x8eB\xec\b\xaeU0\x17\x83\xf3+\x04z5כ\x00\xc1\xb7\x93\xf9x\x81XB擅\x01\x01M2\xbc,2\xfc\x97\x1f^=/6[\xc1\x01\t\xb2\x8eXb\xef&6\x13\x8cH\x1c\xa8'u\x16딽\xbe\xa1ߗ\xc5\x16\x97\xfc6\xa2p\xa6\xb6\x810\xf9\xff\x88\v\xf1e&\xfeU\xaa\x03\x06c\xf1#\x99\x8b\u007f\x11[\xa8_\f\xf1\n\xddU0ƕZ\xcd\xdd\xcf;\\\x12,\x15?\xcb]Yb\xcac\x00\x90d\x1c\xe2\x06\xb7E\x90\xe5\xb7\x04\xa7\xd3\xe0\u007fFK\xc1\x96\xac\nʇ\xabtC\xf2[q\x0f'8b\xf5]P(\xfe\x934\x17S\xc0~\x9f\x8e\xf4L\xd4\xf4\xef\xa4ҭ@\xd7i\xbe\xc31(h~\xdbc\xcb\x12c!\xc6d\xbdhC\xe8\xf0\x86d|\x1d\xf7\xc0\x80̋\xc5\x00@Pi\xbd\x86^\x88\u00adwW\x98?W\x05o\xc8/82j\x96\x1b!\x10\xe02\x82\x15J\xf978e\xbc\xcdګ\x1e\xae0\x8f\xb8\xc3\rkj\xb4\x11\x1dHfK\xc8~\xf2\x17f\xf1\xd9D\n\x0f^_f\xfdX\xea9D\x97z\xad\x90\xac\"\xf7w\x130\x18ګϩ%nA\xd1P\xb0\xb9JMj\x8f\xb0\x04\x97a\v\x14c\xdbX\xbf\xbb\xa9\xf6\x8e6W\xb2;\x15u;O\xf1`\xd05\x876\x1c\xf4\x04,4\xfa\xfd\x88\x8b\xcbA\x9fH\xa5\x9e\xa8\xe5n>\xe3Z\xb9Z!\xb3\x1fq[ \x96¸\xbe\x03ਠ\x11(1#\xbf\xe0\xd1/\xab\xd1F\x8c+\xe6t\vP\x13]\x13\x1c\xd8|\x9a`\x83\x1fS\"\x18_!\xefX\x8c!\xa8\x1e\xc8*\xae\x96\xeb\x94^)\xb5\x95;ܜ \xaad\xd0jZ3]\x0e\xfb\x9d\x10\xd4\x02Q}\x99\xd5e.1\xf6\xf4\xe5\x8a\u007f\xd2\xf5\xa5|\xa6\xfb0]\x88_\x02\x15\xce,\xc2v\t\x82\xb25\x10\xf7\x81\xadq\x85\xb9\xfe̾\xba}\x9b^\ti7\x02lY\x92-\aP\xd00\xaad/<\x92\xd4\x18p\xfc\x81\x9f/\x19\x03\b\x8fH\x96\x00\xb9\x029\x87\x9f\xd8P\xf05\x00\x91\xd16\x15\x80\xfc\xae\xc8\xf0\x88P\x86K\xfe\x15^\x15%\x8e0\"\x02\xf8@lH\xb1\xd9\xee8\xce\xde\xc89\x11\xdaS˱\xf7\u007f\xb3\x8a\xe0\x88wy\x0e\xf7{\xbbQ\xb2\x1c\xf1\xe4\xceBq\xb7\t\xe8D\xc1\xbf\xcb\xe9\xf5\xc0\x80\x0f\xc0]\xefw\xad\x19\xf7\xeez\x9a\x8aL\xb6\x1f\xa6\xbd\xaaW\x01{\xce\x15\xc4߬1\xe63\xf7\x87 to\xf1\a\x9e\x90\x18\x8f\x04L\x9e\x17\x94+\xe4\x01\x93\xed\a)\x86\x8dd\xafUU5\xe5V\xab2\xbb3\x04\x83\x8f\x9c5Dx\xbf\ai\x9e\x03\x88\xe44c\U000fbaaa\bBԁg\xf7\xbb\x1fͱ'J\xed\x8a\xe7|N\x16\x8bD\xfck\x17o\xae\x87/c\xf0\xf6\xd9W\x00M\x1e\xc7\xe0\xc5wo_\xfc\x00У?\xc4\xe0ś\xe7Ͼ\u007f\x01\xd0\xe3G1x\xf3\xfd\xb3\xe7\xe2\xcf?\xc4\xe0\xd9\x0f?\xbc\xfe\xeb\xbbo^\xbc|\v\xd0\xe3?\x9a\xdf\u007f\xf9\x1e\xa0\xc7_\x9a_?\xbc\xfa\x8f?\xbd\x05\xe8\xf7cS\xf0\xf5\xeb\xbf~'\x95\x18\x921\xb9{\x8foY\x8c#\x02\x91|\x0f\xf93\xbe\r\xd0e2磛5Y\n\xd9z\xf4\x1e\xdf>/2\xbc\xd8\xef\x95\x0eq\xb4*\x8b\xcd\xf3uZ\x8a\xd2HW\x84#^\xfce\xbb\xb5O\x04V\xc7\xc4\xd6d\xc5\xff\x8co\xa5\xde\x03\xbc\xf9ӫ\x97o߁\x01\x16w\ue497\xb9\xfd\xf2\xfc\xed\x0fߘ\x0fi^\xb7x\xf6\x8d\xae\x8f+\xb4\x96:\x10o\xca\x18\x11\xc4Դ\v\x94\xa2\x12\xe5\t\x9d\x93\x05\xdai\xceG\xaf1\xc2Rj=\xcb\r-\xf7\xf5z\x96\x19\x90ܠ\x16\xf8ϔ\xa4[$!\x1dh>\xcay9\xcbc\acJ\x9eGp\xc6G\xf8\x03\xc74\x8b\xee*$+\xa1\\|\x81\xb1\xff\xa1\xe4\xb9\xfa\fQ\x9a\x14\xf3\xdd\x02\x95\t\x9b\xa7\vT\xf6\xfb5\xdbk\x87+\xd5\x12\xb3\xa4\xd4l$\x9cF\xe2:\x13\xf0\xc8\xf6\xfb@\v\xfb\x15\xf6\xfb\xf6\xef(\x83\x15\xce\x19\x8e\xd8hG\x0f\xb7\xb6\xdfe{\xfbK\xdc\xe7+B\xb3\x97\xc5r\xc7\x04\xcb\x1c\xb7\xcf^\x0fkUm:_\x97x\xb5@\xbd\xb4\xc4\xf6oB\xb7;\x1eӂG\xf3\x8c\xc8.\xb2\x05D=\x86s\xbc\f\x94\v\n \x9a\xb7\xbf\\\xee8/h\xbb\x9c\xac\x848\x84z\xea:D=\xbc\xb9\xc4\x19\xea=\x9c\xf3\xf4R>\xbc,ď\xa5\xa2*8#\\\xb4\x94\xaf\x01$縌ڼ\xbby\xeb ,\x02\xf15aD\x8a\n\xfd\xfe\x99Q\x81j]\xad\x19\x00\xc0'c\xa9\xe64\xafJ\r\xc5\x1b\x9d\xf3E\x82\xfd{\xed\xcf\xf8\xf6\xb2H\xcb,a'P\x1b$\xc8\xfd\x9d\xf3\x84Y\xc2;q\xf7\xa5IX\xc6\x13\xb4\xadH\xcaa\x8a\x8c\x18\"\xce\xd9\xd3bƒC\x92lD\x11\x86qt@\xe8\x14\xcc3\xae\xaf\xf2\x15\xa1\x84\xad\xd5]\x9eʊ\x18\xa09^\xc0\x96\x16\xb3\xb3\xa6~QE\x05J\xd5\xf5xl\x8a\xd0}\x1e\x16\xe4\xa0@\xa9\x03\x9b2\x82wx\xbf'\xa35\xc9\x04\x83\x92G\x10\xa5\xfd~\xaa\xe1@\x9c\xe6y\x04\xef\x84p\xa1.\xa3Q\xadO\xfdzW*\xfd\xce\x18\x11OM\xb8\x1b\x80\x1e\x18d\xf2\xdf\x02Vd\x15\x91\x84G\x04\x8e\xf0\xcf\xd1\x18\"\xf3:\xae\x0e\xef.\xc13:\x1f/b:\x9f,P\x96\xe0\x19\x13\xbf\x98\x90cĬ\xc8(\xcd2\xd5o\xa1\xd9\xfdz\n\x00\x01ZP\xc1b\x84\xc1\xe0 \xad\xd3\xcf\x0e\"\xdc\xef\x93\x11[\v\x1c\xa8Ni,\xd6_\xacV\f\xf3\xbf\x8a;\x16\x91\xc0LԳ\x8b\x1a\"\x13ݒQAq\xe4`\xb3\xa7\x8b\x16\x97M\t\xabJ)O\xe6`\xb3#Cq\xf4J\x80\xe4\xdf9N\xaf1\x102\x9d\xf3m\x98.9\xb9\xc6n\x15S\xb4@Er\xa7\x91\xe6\x95\xf7\x8e-u{$:\x1b#\xf5w\x85t\xb5\xd7;\x1e\xaa71\xf5|\x16\xb3\xb8\xc6\t\xf6\x98\x81B)\xfc:\x8e\xa6\xbe\xfa_\xe2\x94{,\xb6\x15j\xadܮ\x11\xe2I2qԦ\xf6\xeb|\xb2\x98\x81_V vK\xa6\xe6%\xa8,r\t\x0eLw\x97i\xa9U\x15\x82\xdfV\xc46'\xc6j\xe0NԌeE\xc2\xf1\x06TB\x10\x06\x84\r\xa5\xcau\xc8v\x97\xe2\x13@E\xc2\x06`(\xab\xa0\xb4]a\xa8xM0\xb5\xe4<^\x91\x92q\xd0\"wh\f\x11=\xf2\xde&ą\xe5\x9a\xe4Y\x89i\x04v\xb9\xb8\\\xad\x8a2\xc25B\xa5f\x15 -I:\\\xa7l[lw[\x10\x9f\x8d\x91*\xc2\x1f\xb6)\xcdp\x06ⳉ.\xca\xd3K\x9c\x83\xd8\x1d\xa2\x9e\xad\xb8B\"\x85\xa6v\x14\xa0\x17\xd9\x03\x03f\a\x94om\x06<BN\xd7S Y\x86\xa9\x9c@\rYPAA\xfa\x14\x8c\xcc\xeb\xa3n+.\x13\xb34ge\x02\xc2\x06\xb6\x02\xea\x8ah\xc0\n}\xb5+i\xf0m&\xe2\xee\xe6\xbav\x01\xf5U\x83\xd4\xc6agg\xa5\x96\xc4n\xad\xd9{\x1c\xd8Z\xd3\xffC۽\x9a*\x91$\x8d\xca\u007f\x99|\x95\xf0gn\xa0G\x989\x94\xb0m\xb6`\xa7\xa3HHF\xd86Oo%\xfd\xf0\x0f\xdcw\x98\xf1\x04\x9fv\xef\xd1\x06\xbb\x87v\t\x89\xb8zJ5L\x12\x11\xec^\x9a\xec4!\x1b\xf1b;؍֘\\\xad\xf9\x93$\xd3\u007f\r2\xe7;*\xbc\xeaO\x13\xefcY\u007f\xcc\xf1\x8a;_\xc5O\x94\xfb\x9f\a;%\x9f\x88\xa1\xe4\x1f\x03\xaf\xba\xe4\xc0z\x9d\xd3\x13\x8d\xc5}\xf75\xd903S\xaf\xec\xc0\xa4\xbb\xea\xb5\xe6\x1f\xaaxx)^\vY&\xe9\xcf:\x99\xa7\xa8\x10\x1bQ+\xe3fe\x92$y\x92\x9c\x8dc6+\x04\x81\x93\u007f\x0f'I\x92\xac\xad\xed\xcb\xd9Ļ\xb9\x8d\xa5\x1cO\xcc\xcbŌ\x8b\xeb\x91#\x9e$\x9a\x05\xd8\xef\xc5\xdfF\xc2v\xd4\xc0Z\x05\xfc\xea\xc1\xc6</}\x9d^\xe3QO\x14\xa4\xab2%Y\xefUo\x99\xd2\a\xbc\x97\x15\xf2u\xde<\x86\x91DJ\xea_\td$\xf4\xeayN0\xe5?\xe0%\x97\xba\f\xee\xca\xdb]\xd5X-\xf3_\x16\xd9mg\xbd\xc22g\xe9\x15\xfe\xf1\xb5\x042J\xdd\xc2\xffV\x85\x1a\x90wJt&\n\xdaH\xa1BL4N \xb5K\xf1\x1d/\xb61\x918T \xb1e1Q;\x97VHM^\xecX\xac;\xa3~g4\xd4\x19u;\xa3\xa63\xf3\xf6\xe0\xf6\xc6\xfc\xdeX\xa87\xddOZU\x95gs\xa7\x8e\xb2b\xd6ԫ\xa48\xc6(O\xf0L\x1c\xdfX\xf1~7\x84/\xd7\x11\x85w˔a\xc0\x8b-\x885pd\xaf-\xe9+w\x91wX\xea㗫\xff\xc7\xdeW$f\x97;\xa7d\x18\x95\xe6\xbc1XM刢\xa2?\xa4?Bd\x86(`\xab?\xddE)z<Ї\x99ݠ\xe8\xeaa)\x19\xa2^k\xf5\xc1n\xce\x1f\x99e\x9f?:i\x89\xba\xf7˂\xf3b\xe3\x0f\x90Ί\x8f\x18d\x90\xdb1\xfc!~\r0m\xa7rh\xf3\xa7\xdf\xff\xe9\x90\x1eL>f\x00\xbf벃\x84\x0e\xca\x16\xa5l\x81\xab\f\xd3i\xbfi\xf7tJ|\x8d\xd3ܟN\xd4\x1e\xd6\f\n\x8f\x8cʼn{\xab]\x9e\x9f\xb6\xd4C\xbd:\a(\x88[\x1f\xb1\xf5\xee\x81:\xdeg\xbd\xdb\x06\x0e\x87z\xce\xf0*\xdd\xe5\xfc\xefG]\xdcSQUN\xd7_\x15\x1f\x92\xbbW\x9b\xef\n\xfe\xb6\xd8-ׄ^\xfdX\xecb\x8c\xfe\x03\vʍ)#\x05e1\x11\xbf\xd5\xf5\xc0bZ\x1d\xe7\x95\xe0]!\xae0q?\t\xd92\xf2\xc5d\xfb\xe6`8\xd7[\xcc\xc9\xf0\xb2\xf8\xb0\x90\xcc\xe3\x1cde\xb1͊\x1b!\xed\xf1\xa2\xc89\xd9\x02d\xf0N>\x8f\xe0~?jY\xfa\xe1\x19U\xefK\x18ƭ\xe7\b\xdc\xef\xb7\x1b\xccǋ\x195/\x9f\x18ƾ\xf9\x18P\xf6\x18=\x9an0S\xc6/\x97X[\xbe0\x00\x9b\x126Kh\x87\xb1\x1bX\xe6\x05\xc3\x1b\xaclcy\x05G?\x15\x84F\xa0\a\xa0\xfb\xf6\xb1ZEL>\x810\xe4j\x18ͻ\xbf\xb2\x9ag\xdbt\x89͓\xe4H\xa9\xf2Y\xe2\x18\x97Re\x99H\v\x1e=𡛀\a\x032x\x00\x16\x0f\xe0\x94\x1d\x16\x98\xa6\xb8\xa53\x91k\x10+\xd0\x1f\x94\xc2D\x1aԹW\xab\xdd\\%`\n6\xc6\xccM=\xec,\x00\x9cR+\xa0\xf8\xcbw\xde~\xcc(\xcd7!;\xba\x9d:\x83w\xa4\xdfw\xed\x0f\x84\xc8\xdfe\x1f\x96\xee\xf7\xb4Ӝ\xb8\xb9f5\xecƟP%\xc4M\xc7<\x12_\vYY\"\xa8\xa8\r`\x85\xf0~?\x91:8\v\x18v\b0lY\x16y~\x100\xaaJ\x1b0\xed\xf2\u007f\b`\u0530\xa7\x02F\xd5\x0e\x03\xa6\x88\x94\x8bJ\xaau\xe3g\x93i\xe3\xb1K\xbeȽ\x91\nڢ|\x96\xe7\rtB=\x0f\x88\xe6\xe7f\xc7S.\x90\r\x91\xa4\xad\x12\xe1\x918\xfb#\x9e\x96W\x98C\xc3\xe9\x91\xc0\xec\xa1\xe6\xfe\xf4\xe6\nf\xf7\x04$As\xb2\x80\xd3\xcb\x12\xa7\xefյ\xa1A\x10h\x1e\x02%\x9a\xdb\xd7E\x87]\xb7=\xfa\xd7\xc5٤ҶĚ\x1a\x99' \x9a\x8c\xa7\xf4Ibʇ\x93)5\xef\xbd,QF\xb4DP\x82\xe2\x92\xe1\xf2\x1aGxN\x17\xe8N\x80\x80\\\xee8f\xf1\xd9\x18I\x8d\xc67\x84\xf1\xf8l\x82\x96\xeb\xb4L\x97\x1c\x97_\xa7<\x15\x05lw\xc9K\x8cş\xb6\xd9K\xa9ǎ\xe7\x1e\x14\x17\x95\xd6\xc0y\uf86e\x19\xd9_\xf1埥\x19ٷ\xc5/\x00\x81\xd7\xe2/\x81;-\x932\xf9h-D\xb59^\f\xc0\xb7b\x9bIA_\xab5\x94\xf5\x8b\xa3yk\xd1V]\x1dՍ\xdcx6\xa9\"\x88\xca\xc4'\xbc\xda\xf6\x98X\x1b\x90^\xc8\xf2\x99\x8as\x02~\a\x06\x14\xce\x15\x99\x04I\x92\x90\x190\xdb\x19\x03\u007f\xd3\xc1\"\"\x03\x10\xa2\xa5SnmD\xd5\xf9^\xe6d\xf9ޫ\xa9\xb1\xbf\xd8b\xba\xf0\x9e\xc5Kk\x12\r\xc4Gy\x1eO\xeeNN{\xd1zf\xb7\x17\x82\xb6QT\xab\x83S<sFӅq\x83X\x04\xae\x8c{M\x89\x17WW9\xee\\\xa3\xfa\xdc\xd1e\xf3\xaarV\xa9\xde[\x90\xef\xf9\xc1x\xb1\xfd\xbe,\xb6\xe9\x95\xf2ǰ\xe2yk\xf5\xdaP\v\x803\xb1\xc5--\xed\xa8V\xf9ک\x12\xd4MQ\xf5d\xb3\xd1/+\xb1\x14\v\xc3U\x9a\xc9>\xba*\xb6ּ*\x96;欹w\x99\xef\xcan\xb0\x0ee\xfd\xe3\x1b\xee֖܊\xc0sܾ\x10T=\x1f\xa5uO\v5]s\x9d\xe5E\x9a\xb9W\x8c\xb4(u\xd5ݯ\xfe\x84\xd3\xf2\xc7bw\xaaN\xce0HB\x9e7\xfe=x\x94\xe9W\x13T\xfa\xde\"j]P\xf0M\xfb=\xe0d#\xa6\x9a'É\xb2.\"\xec\xfbt\xc7p\x96\x9cM\x94)Q\x89\xa5Q\xa1K\xb6Dm\xe4]\xabƽKV\x8d`\xe5\xfcJ\x9an<\xee\b\xcdN\xf2d\xfc4\xc9gi\x9c#\xe3䵕\x95\x01:\x9b\b\xd6ر\x88d\x1d\xf78\x1e\x11\xba\"\x94p\xdc\xef\x17f\xfa\x9a\tG9D\x8e\u007f\x9a\\\xbd\xfc\xae8\xd3\xd2\xcc\\\x0ez`\xe6\xe3\xf6\xf2\x9b\xd3\x1d\x1bc\xc6z\xca\xd3|\x98\xe0!m\xcd@\xb5\xb1Sh\xbc\xa8y/\x8d\xf0\x8e\r\x87HZ\xd4\xf5\xfb\xc4:@\x9a+nZ\u007fA\x01\xa71i>Vl\xb69\xe6xF#\x18\x87\x9c%d-\x9a\xf2]\x99\xe6\xf2\x11\xaa\xdfo\x15=\x1d\xcb\xe6\xe6\xa8\x14\x14G@\xe0\xb5w\x9ch\xa4\xb8c\a\xb5\x05\xc0J\xffq\xa7\xa0\xaf6\xe9\x15f\xdf\x14i\x863\xd7ڣS\xb4\xd2x\xb9)\xae\xf1\vq\xbd\x8a\xeb\x19Su\nw˵(\a\x88@t\xa4\x9et\xe0\xc0\x10풳\x89\vs\xa24\xa1#\xb6-\xf8\x9b\x1b\xb2ţm)\xaf\xf1\xaf\x15\xdb\xd1\xef\x93FI\x04\xd1\xce\x1e\u0084\x8cT\xf7LpXR\xb1\x88\xd2$j\x95\xfe\x88\x8a!\x83\xd3<\t٘\x0fK\xe5n\x96^\xb2(\x85O\x13w6b=o\xd7%f\xeb\"\xcf\xfa\xfd\xfc\x89\xf7U`\x94\xf35\xa2I\xfat<Ӫ4\xad\x0e\x83\x88\xf6\xfbQ`\x15\xda\xd0U\x91\xed\x16\xa5fb\x00\x80h\xabh@\xa1/\x04qx7I\x12n\x96\\\xbf4\x15u\xa1\v\x1d\xde\x06\xceN\x9c\xb22\b\x1c\xb5\xb1i\x96\x1d\xd8}I/\x0e\xd5S\xbb/\xaayR\x8aƮf#}\x06:\xfa\x92$\x04 *z\xab\x9cͨݑ\xc1d4\x1e\x8d\x01\xc2T\x1aJĠ\xa0NKB{\x96\xdb7\u007fh\xeb5\xe4\xef\x91\xe04\xbd\xfd\x8f\xff\xf09\xf2\xb6<~4\x1e+O\x9f\xfa\x89\xe6l2\xe5#\xd9͈m\xf1\x92\xa4\xf9\x88\xa9\xf91\xccwۘUH\xfb\x03\xce\x15\xa2 \xb0\xdb\x02\x04\xb4\nB!\xcd»\xc5\xfd\xee\xe6\x06\x11\x04\x94\x16\xa6\xdb\xc0\xad/E6\x85F|D\x8bb\v\xab\xaa\xd3\x02Z\xfaw\xa4Y&53-j\xecS7\x82$\x17J\xa14;w\xf7\xa5gѢg6^\xfd\xa1,*<\x92\x85#\xb9,I\xb74\xf9\x0ey\xc4\U00051d9a|\xab\xb0\xb66\x8b\xbe\xabǍ\xc1\xa6\x10t]\xc2\xd0\xceA\x97*,5\xf3х\xbb-\xa8P\x91\xb09\x976\x89\x8b)\xf8V\x14K\x8cs-\t\x03\x16CZX\xaa\xebϔ\x81y\xab<*\xd0\xdd\xe5\xee\xf22\xd7⍄\x81\xb4$:\x1b#e\x83\xfc\xdf1\x1d\xe9\xbftɏ\xb6\xe4G\xb4\x94\x8f*\xa2\x8e\xfeK\x97\xfchK~\xac`\x1c\xb5\x8d5\xe5\xe8\xee\x8a\x04\xb9\x11\xf7\xb47\xb9\xb3\xb1\xf8OM\x1bMP=\x93z\x06\xf5\xc8vDt6\xa9\xff\x1b+\x83J!\x8c+\xf9v\x94\x11\xb6M\xf9r\xad\x06\xc1\x02\xe7\f\xc6\xc9]~\xa7\xa6(\x1f_=\xf9\xcc\xd0\x05\xae\x1eȬma2\x9e\x92'\xf6\xd2%F\xaa\xa4\t\x9e\x93Ŕ\x8e0\xddmp)\xc0\x9a\xb8?\xf6\xfb3\xb1\xa4eAW\xe4j\xa7\xbe\x9f\x8d\x11\x90v\xc5b\x87\xa5\xaf\xee\xe8\xa6TVQ\xc9\xd9\x18\"ͼ\xa9k\xda\xdaNsD\x05?'\x8d'ZV\x98H\x1e\x86:L\x04\x8fp\xedw -Ue\x19\xa2\x10\u1a8a\xe04`N\x11\x82\x02\xae=\x06\xefaRqW\xf9\x06\x15\xad \x1d\x82cŚX\x1b/\xfc\x84\xa8\xdf\xda\xf56q\xcd\xf7\xf0H\xcb\xff\xcco\xa39[\xb1,e\x04\xaf\xfd\x03]E\xb2\x17g@\r\r\x9e]\x92L\xc8Q\x1a`.2D\x18\xcd\xef\xde\xe3\xdbX\xfb\xa7k\x8b\xfd&)\xfaLZ\xd5)\xcf\xeaz:\xeam_~\xaa\r錩\x1d0\x93T\n\x82\bV\x15\xd2#\x19\xb5Qk,\xd7b\xdc\x1bIj\xc8F\xa9Z\x87\xd1\x17b\xc9ԪB\x8fƍ\xe4ǗE)\xbd\x8f\x15I\xde]nHG}kU(]\"S\x8eMK\x04V\x04\xe7\xd9sI\n\x81q\x05\xd4;fk\xbf\xa6\xfa\xee\xd4@R\x93U\xf4\xb3\x1eP˭\x8dR亪\xd6\x13x%:\x8a\xb8`\xa9\x94\xfeJ\nX\xee\xe09\xb9\xc6\xff\xa5k\x87\x86\x97\u007f7Go\x14\xdec\xf0z\xef\x94'j\x17\x9e\x98\xe0\f\xa6z\x89\u007fޑ\x12g\xf2 \xb4\x1a)/\xac3\x13\xac\xc3T\xb6.\xf2gc}E\x9d\x8d\x8d\x02\x8fK\xa5\xde\xed\x16k\xa5\xddRt|Y|\x001N\xe47Y\x803O3\xa7\xf01v~\f\v\x8a\xfd\x82\xcd.\xe7d\x9bc\x10\x9bg{\x85\xdd\n౪%\xe6\xe6\x98\x15I\u007f\x89\b\xee\xf7\x11N\xce&M\xdd\x1dW_\xfb}\xfd\x87u'V\xb5+'@\x83\x02\x96\xb2{-7\xd2\xda \x04,}<F\x8c\\\xe6\x84^\xe9\xc8\b\x06%V\xa6\xa9Ѥ\xd6>/\xee\xd0\xc6\xe6 \xd2\x01\x0f\x8e\xf4!)\xa83\xc1o\xa4%T\xf7\xe4\xc4\x1e\x90L;\xae5H\xc5\x03iF5_\x15e\x02\x1e\f\xb0~\xa8\xb0\xde/\xda,\x83\xc4|$5!\x8cG@\x19^Ao\n?\xa4\x19)\xe4<\xda4\x04\xbb\xde.\xd2[\xc4{\xb0\x91\x86y\xe6)\x87d\xa8H\xc8\xc192\u007f\x8eE\r\xc8\"\x91\xbcXs\xa2\x10\x15\xf3\xf1\xa2\xaa\r\xd4#ZO^0\xd7\x02\xb8\x92\xf2bv\x00\x8a\x02v\x16\xda\x117\x8e\x80#\x0fE\"\x0e\xa78d\b\xe6S\n\xd1E=l\xfd\xa6%\xf07\xdc\xc4b\x81n\xc1;\xeaIz\xe2Tt\x95\xeb\x84Jz\xa2\xad\xb1,9\x10l\xa1ܿ{\x00\xa2\xb1Aq)\xda\xcfi\xba\xc1b\x8b\xb8\xda\"\x17B\x0e\x82D\x18j\xb7\xb7\x06\xec\xb0\u007f\x90]\xe3\xb4\xc3\xe0\xab\x1fphw\xab&\x04qw\xd5\x16\x10[vn\x06\x94M8\x1e\x03!YE@\xc2Jpіn\xba^\x9d\x1d\xfb\x11\x19\x8a,`\f\xa0\x11\x14>\x0e+O\x87\xecI\xfb\xd1\xc6\xcd\xdf\x14\xb2\xde%x\x04/\xbd\xcbM\x81Cp\xbf\x82\xe1\x15\xfc\x9cs\x18t\xafE\t *\xc4m&\xd5/\x84\x99\x87.y\x1f\x0f\xc9\x15-J\xbcP\x91\xa8\b\x8b\x1e̕㖶\x0f]<p\xeeŎ\xebPmw\xac\xf7\xc5,FnpkS\x9d\x1b\xb2\xbeEI\x82\u007f\xc5\xd5Y\xb76\x97`c&o\xf1\a\x19\x1d\x8dI\xbd\x8d\xfc$ݧ4+\xa3\xccS\x19\np\x04\x10y\x10\xc5?\xefҜ\x17\x00*\x95K\x80/+J6\x92\xb5\xde\x16\x11\xd78\x9c&\xd2Hp.\x05\bT,\\cAT&Q:S\b\x00b`QC=\xe3(\x8e\xc9u\x89\x9e\xa7\xb3\xd09lS\xf9E\xc4]\x9dl)C\xaa\xa0\xb4\x85r\xe2\x04u\xf2\xc4\xf3\x85>lS\x8f\xd7k*@\xb12\x8f -fN*'\xa0\x11\xfa\xa9\x82\x03v\xd7\xef\xb9{7X|\x17K\xa5\xe5ĢetKgʁ \x06\x97y\xb1|\x0f\x1a\xe2\x8e]~Dg@\x9ca\x03g\xf1w\x10\xd6h\xeeu\xb0\x80\x88\xb6@&Щ}H\x91\f\xea\x85\xc5)R\x18\xb3M9ǥ\x0e\xf1\xa6m\xcbo\xb7\xd81є\f\x9a8\xba\x93\x16;B}\xec\xd2}\x05|}g\xc1zs\xbcPA#\b\x8c\xf1Y\x92\xf8\x13\x98\xa98&W/>l#\fmų\xb1\nX\xb8u\x8f\xc0~\x1fQ)0\xb7\xe1 \x0f\xf8a^\xe8\xc8-\xaa\x991\t\x02\xc4<84Û\x10x'}P\x9aG\xb4ߏ\x98\x98_\x05\x11\xab\xe7\xba\xdfw\xf7\xa0V\xa89v\xd9\x01\xd5\x1d\xd4Kl\x90\x87\xd0n\xd7\x064\xf2\x80\x90\xe4\xec\x8cLͳ\x81\xf3\xac\xebs\x82V\xee\xa3\x01\xca1ǋ\x88#\x82jf\x19Z\x9eN\x1e\x1d\xe6\xd9\x19\xd7\xf7\xb2\x96;\x0fʶv+\xcc\xfd\xa9ǟJ{\x9f\x01iތ\b+c\x1f\xc06ҷԷ\xa4oߣ\xa6\x9b\xc6\xfdw\xa4\x9b\xe6m\x89\x84\x04ؒ\a\x06\xaa\xeb\xc6-\xdc\xe8\xaa\xcd\xfd\x9cLF\x8c\x17\x12\x8f@L\xd4\x1dl\xa6\x1d+\x87<ԋ\x950\x8fz\xb1\x847\xea\xc5\xea\x86\x14\x05\x02\xa9Q/6\x17\x9a\xb1\x15i\\\xb0\xf2\xbc\x03p\x88#\xa8g\xa0:\xad\xe7\x11\xee\xd1\xc7et69\xb1s{\xf5\xfe\xa6\xfd\xbb>sE\xb9i*K\xa4-\x82AZ\x1d\xd8\xf1\xe3\xd51\xf7\xdfܖ\xba\xc2\xf6վ\xd0\xdaw\xac\xbd\xce\xfcW\xe5f\x90MU\xa9\xaa\x16B\x84\x8d\x04Sj\xb4j\xc9]\xad\xbb\x89=\x1d\x0fj\x1c\xa6\x18\x10f\xc0\xaa\x9d\x80P\xe3\xa0xU4ʶ\x8eM\f\xe4\xa1P\x80q\xbe\xd7=\x18\xb7O\xe4jv\xe2\xb3\t2\x17I|\x97\xe6\xdbu\x1a\x9f\xffm\x9e\x0e\u007fy6\xfc\x9f\xc5\xe0\xb3s$\xcb\xdeI\xfd+Y\xd6\xdf\xc6\xc3/\xe5gB9\xbe¥\xf80\x1c,f\x17\x99(\xa4\xbbͥ[\xf60\x9a\xc5\xf3\x8b\xd1\x05Z\\d\x038\xfb\xec\x1c-\xd32\x8b\xcf\xff\x16\xcd\xe2\xdf\xcfEWw\x93G\x95\xa8$\xff~\\\xc1\xd9\xfe\xf3\xf9d\xf8\xf9B\u007f\xfc}\xb5\xff\"\x9a\xc5\xe3\xc9d\xff\xb9,\x92\xff@\xdbt\xffx\xfe\xfb?\x98ʏ\xab\xfdcQy>\x1e~\xbe\xd8Ͽ\xf8\xa3_{R\xed\xa3Y\xfch\xf2x\xb2\x9f\xfcq<\xde?\xfe\xfc\"\x13C^d\xe2\x1b\x14\x93\xbb\xbe\x16sS\xad\xee\x1e\xa3\xdfW\x9f\x9d#\xbcII\xee\xad\u007ft\xf6\xbb\xcf\xfe\xad\xff\xe0\xe1\xe0\xe2<\x99\xfd\xed\xdd\xff\xefn_\xfd\xefp1\xf8w\aBbI\xf6\xd7pq7F_L*\xe7;\x9cE\xb3\xf8bt\xaf\x16P@xW\x8a\xb9Dkηl\xb6_\xf1\xed~Er\xbcgl\r\xe3\x8b\xf3\x8b\xf3(\x8a\"\xb3\x8b\xfb\x8bl?\xdc_\x8c\xf6\xef\xf6\xff\xbb\x9f_\xec\xc6\xe3g\xe3\xe1\xc5\xee\xeb?\xbc|y\xb1{\xf9\xe5X\xfcx\xf9\xf5s\xf1\xe3\xeb\x97\xf2\xc7\xcb\x17/\x17p\x1f\xfd\xdb\xfc\"K\x87\xab\xc5ݣ\n\xee\xe7g\x17\x9f\xf5\x1f\\D\x17\xf0\xe2\xe1\xc5\x00M\x93\xc5>\x86\x0f\xff\x1d\u03a2(\xba\xc8\xf6\xf3\xc9\xf0\xcb\xc5E\xb6\x9f\\d\x17\xd9\xfe\xd1|<\xfc\xbd\xf8\xf9\xe8s\xb9\v\xf0b\xf4\x0f\xad\x04\xf7\x8d\xf5\xdfg\xd9\x1f\xd9\xf0\xd7\xc2\xfb\xe1G\x8e\v\xe1\xc5\b\x0e\x9cY\u007f\xdcZ\xffO\x16z\xbfU\xce`\x14_d\x0f\xe1\fF\x12\xc3\xff\xfe\b\xbe\xffw8\x90c\xfdC\x86z\b\xc5\xdaf\xd1\xc5\xec\x1f\xb58\xd1ዱ\xea\xe3\x8f/_.\xf6\x17\xe7\xfb\x8b\x99\x98Ft\xf1\xbb\u007f\xd8$젟\x9d\xa3\xacؤ\x84J\xf2\xeb\x10\xc5\xfa\xef\x8b M\x14'\xc0\xcc\xf5\xee\x11\xfa\xa3 \xd8\xe2z\xe3d\x835)\u007f\xb4\xb0\x97\xcb\xc5P\x14L\xf4\xb5\xa0~=ֿ\xdeF\x92\x82\xe8Oq\xf7\xaf\xe8\u007f\xf6\xd1\xfcbx1Xx\x9d\xc5\xe31\x84z\xf4\xf8<\x9aœ/\xf7\x8f\xc6\xfa\xeeyT\r\xa3Y,\xef&A\xbf\xf6\x139/8\xf4K\xbe\\H\x82\xf6\xa5\x80\x9f\xa8}6~\x04\x83m\x1e\x8f\xa1\xae\">>\xfe\xfc\x0f\u007f\x94\x9f\xc5\xd7\xc7\x139\v\xb3\xfe\xb1\xea\xd6\xe9\xfd\xf1\x02F\xb1\xb3\x9c\xbbG\x06f\xaf\u07bc\x8e\xcf\xffv\x91\xdd\xfd\xbe\x9a_\x9c_\f\x17\xe2bD\x8f\xfc\x1f\x9f\x9d\xa3MA\xf9\xfa]\x96\u07be\xbb\xc5i\xa9F13\x9c<Z\xc0\xf9\xb0wq>Z\x98\xd2\xf9D\xef\xc0\xfe\xf1|<\xb1\x9f\xe58r\xe4\xdbw\xaa\xc7Fo\x1d\xed\u0083\x99ޖE^\x88N~7\x13\xa8\xb3z6|)\xe1\xffE\xb5w\u007f>\x16\xb7}\x85j\xa1,\xbe\xd3\xfa\x9c\xb8\xe3%X\xdb.\x06\xf5D\x8a\xe7\x87R\xeb!\xff\xaa\xaa\xca\xe5\x1eU\xd0\xe4\b\u05ef\xa5\x9f\x1e\xd0?\xea\x01]t\xfc\xcf\xf7\x02\xbe\\\x16e&\x13\\\xa0@P\x14\xdb(rj\xa2;\x191)6v\xc8HFJ\xaa\u007f\xd6\xe1\x8fb@\xa5\"\xca\xc4K\x8a\xc1\xb6\xc4פ\xd81P\xfd\xaaWw?\x99\x81\x0e\xd0 6\x9e0\xfb\xbe\xfe\x19O/\x9b\x0f\xf3u\x94\x82\x9cX\x11\xd8,Lz\xd8/\xbc\xe6!\r\x8d֨D\x04\"\x96P\xa7Kmd\x9c^\x0eu\x8c\x9b\x85\xd4j3\xf5\xf2\xb7\xdf;\xf0\xf5\x937\x80\xd4م4!\xb2v1\x00Z\xb0\x9a\xd2p\x1c\b\x1d\xa8A\fV\x169\x03q\xa1\xc3$\xf0\xf4\x12 \x92\xc5iw\xdc\x06\xfbl\x1b\x9fM*\x88\x98\x17\xc0\x82\xa7\x97۔\n\x91\xce\t\xf2\x90\xe3\xec\xf2\x16\xd8>\x9d\xd8\f$\x8b\v\xc7l*\xa4'\x1d\xb9\xf1\n\x0eC\xccy#QA\x90\x8b\x1b\x01xq\x9e\u007f\xbd\xd9D`Ok\xbf\x18\x13\x9f\x9e\x1c\x9b!u\xdec\x9c\xb8\x17@;,Y\xb3~\xbb\xad\xbd\xf7\xf8V\xac\xc3+\x04\r'\x80\xfa\x03\xf2M\xe1Z\xb6\x9a2\xd0d\x1d\xda\xc2\x00v\x16a\xab\xb1K\xf3\xbc\xb8y\x96\xe7ϥ\xe5\xfc~O\xea\xb7q\x18n\r\xfb}<\xdam#\nc\xac\x80N\x8d\x8dHp\xf6\xde$C\xa4\xc3\x06\r\x8b8\U000a81e2\x13^\x9e\x88\x91*\x13C\"A2\x9a\x9b\xc8\x132\xa22\x9a\x889\n\xd2\x1be\xb9cR\u007faV-\x1f\\^Hl\xdf\xef\xb9\xeb?Ђ1\xac\x90\xa1E\xa1\xc1ķ\xdft0\x1d\xc6\xcb#e\xed\x9d\xe5mw\f\x15\xb8\xbf\xc6vCe\x83Ϛ\x8e\rCx\x93\x95]\x89\xab\x8f\xf71\xa5\xdf?s\xfa8\x8a3\x8a\x84\xabõ\xdbF\\E9\xeb\xd5\a\x97;\xea4i\x0e\xd9T\xc57u\xd4\xcd\xe99P\xee\xf7\xcfl\xbe\x9c.\xb2~\x1fB\xc3|B\xb3\xdbF\fV\x86Ir\x89\x9cT*\xfa\x01g\xfc\xael2\fX\xc3\u038b>c\xa6\x84dT\xe1\f\u007f-a\xe3\xaeS\x16\xbf\xd9b\x9cy\x86\xf4\x81\xd4:\x81\xa3\xa8\\\xa8\x90\xcf\xe65I7\xf4\xaf\r\xe7R\x18\xb7/\x85\xb1\x83q\xbbm\xf7\v\n\x0e!\x8b\x13O\xb7c+g\x1d$,\xc6G\x10x\x1a\x1d@\xde\xfd\x9eA\x19\xd3H\x02\xf3/ۈ~\x04xw\xdb0pÈ1vv\xdc\xcbc\xe0n\xfa\xc7\xeeK\xf8\xb2>EA\xdd\xfdV\xd9@\\An\"\xb1\n\x03\xb2q h\x10\nug\xaf\xbb\x06\xa9\xfbX\xcds\xbdC\xf1\xa3\xcf\xc7\xc8A\x16\xe9c\xe9m\xb4\x8cF\x1c\x96Q\x1c\x9a\xfbIN\xf9\xa7\x96S\x1aq\xa8F:\x8a[\xe4\xbf\x04z\xbc\xf1ǉ6ߪ\xd0\\'\x8a7\xb2\xf6i\"\x8e\f\xf9\x1a+\x97SG\xc6\x11\xf4ҕ\x81\xd4\xc5Wǐ\x8d\xb5\xdb(R\xd1f\xf5\xcfgy\x0e\xd0\xdbg_\x99\xfa*b\xab,\xd8m\u007f#q\xc9%\x04N\xc84\xf9\xba\xe6ݜ55@\x01\x81\xab\x16\x13\xa4\xbc\xa5\xa9\x94<\xb1\x8aTIOѸM\xfc_o15\x06\xbb\x9f\x89ѿ!\xf4=; 1\x18ɬ\x11>\xad\xd5EWH\a\xe9\xbbwL\xf4\x1aʮsB\xdfK\x97\xf9C\x82@\r4!\xfb\x9d$\xd8\rud\xb8\"\xa1\x1dW\x1a\xe9\x10\xe7XK|k\nx\xb8\x8e;p\xd7\x16\xd5pSTs\x9ak\xf9\x8ed1\xbb\x87\xe8\xe6\xf9\xda;6\x14Ӷ\xc7!\xd1\xfc\x9f}\x91\xac~;\xa3w'4\xdfAY\xee\xc0\xf696\x8e\xed\xca\a$9EK:\x047E:\x8e\boV\xd6!\x92\xaf\xef\x14\xb06~g\xbe\xab\xafF\xd14),_\xba\xcb=\x8eW@\a\x95Iq\b\x06iS\xbd\x11\n\xbc[\xc0YD\x93t\x84\u007f\x8el\x02\x951\xc2\xc3\t\xf4\xc4#R2\xb1\xba\xff\xc7ܪ\xe2N\x1eLl\xb2\xa7\x8e6\xc7v\xab\x8e\xfc[{\x13\xb2\xa4\xb0h\xa0\xb4\"C\xd9\x1c\x04\x06\xa8G\x90q\x84\xbd\xda3jA\xc84J\xe9V\xed~bz\xaf)҄\xd6S\xcc\xd3\xc33\xbc.H\x16y\xd3t[\xe8\x05\x87\xe7)\xe5bS\xd4\xec\x18\xc2X\xf9\xe6T\xe1\v\xb0\x16\xd2\tj݁\xe2b\xf3RϨ\x99iz\xa2Xmy\xcaK\x88J\xf7Tv\x82ЈѰB\xf2\xd2\xf3{\xb7\x89\x93\x1a\x03ͤb\xa2\x84qq<\xecg$\xebvW\x84\xe8\xe4\xfdv&\xeb\xbb4Z\xfb\xa8f8\xd6\xe1\xc46Bg\xe3\n\t\xe0\x04\x1a\xb2\xa3\r\xdb*\x92C\a\xd91*ִ\xe5Pm\x03\xfbgy\xee\xeb`\xd6$\x13\x85QH[!hY\xd0\xfb\x99H\x11\xe2\xd5F\x86\xf5\xe78\xa0\xba0\x84^w\xff\xb1L\x8aaI:\xa4\xe6S\x14$ʲ8\xd6q*\x94\xe5 o\xa0\x9a\xab\xba\x88k\xbd\xc6\x115\x06o\xdfV-\xc6g\xbf\xb7\xdd\x1dӐ\n\x86̨a\xd8_('\xb9\xdfFj\x17Ĭ<\x17\x04\x97\xa9\xf0C\xf9\x1a\x06`R\xd5z\x8c\xc3\fV\xa7\xaa\xa0\x92J*\xab\xc3\xc0G$l|\x8a\x02C\x91\x1b\x99P\xf1\xa0֡\x01e\x8b\x12\x1f3\x89\x86\x98\xefNa\xea;:ub\xe2\xd8\xd5\xfb\x84\x95\x03Sr/x7\xc0\x8d\\\x13ʏ\x11\xf6\x9b\x93\x96[v\u007f\t\xbf\x8b\rj\x89p_\xedJzH~\xfb\rU\x03\xe2D\tt<\xa2\x06P\x13\xfd\xa4\n\xf8\x97P\x05d%\xc9sI\u007f\xef\xa9\n\xf8\xdaixX\rP״*\x00%\xdek\x05\x80+\xebk\xf1\xbf\xf9\xc4y\\\xfc\xb7\x8f\xa0\xbe\x06\xe0\xef&\xfek2\xf0\x8c.\xd7E\x19\x16\xb7s\"\xe8\x93\x05p\x9b>y\xd2\x11\xf2\xfa5=\xfa\xc3X\xc2'\x99\xac\x03\x82t-ʿ\xe2x\xd35=\xab\xa6\xf8ɝ\xe6e\xba|o\x89\xa7\x13\xa7_\x06`wX:\x8d.\xdb\x12\x8bY\t\xa2`\xf3\xa5\xbd\xd7H\xf0\xa2%\x98:\xb5O\x91N\x1b\xcb?\xfe\xcah\x14\xc7Λ\x92*\xfa\x86\xd0\xf7\xf2m1/(\x8e\xa0`\xbat\x16]z\x88\xaf\x1bݔ\xe96z\xf0$'&\x1f\xaf\x13=^\xf5\xac\x82\xc87\x83\xca\aw^\x82\xb0'`\x9a\x80\xba\xe0\xe9\x93\xf3\x9c<} \xf8>\x15\xf5\x88\xa5\xd78\xfbS\x89W\x00\x99\x18\x82k\xf1\v\xfafت\f\x1dҨ\x84Y\x9612\\r\xdc\xc8\x02\x80\xb0\xd5&\x10\xeb\x06nq\xf2\x04\xf8k\xc6+\x80P\xf6\x85w\xbf'\x06\xfa\x0e\x97!*}%m\xee\xe5$\xc4Ow\n\x06u\xc3/\x19\x0e\x11\x13\xec\xa7l!6n\x8bK=;;\x8e.n\xbc%\xb5\xa8`\xdd\xdc\x1b^b\x83W\xc1y\xabX\x1a\x9f\xc0wW\x98\u007f\x9b~\xf8\x9al\x84\xa4s\\-\x83\x1bz\x1f\x9fAp\xe6\xe6\xebH\xea\x0f\xc8\r\x1eHV\x11\x8fL\xb0\x0e\xe8s\xbc`\x97\x03\xa4N~\x18\x80-\"%\xaf\xd3nq\x04і\xf8\"0\xf2\x9dL҂=z%3\xab\xe8]\x90\xb4\xf95}.\x96b\x9f\x1f#pYd\xb7\xf2\x11\xd1>\x82\xdck\xf12\xf6\x9eZ\xb7\xccwh\xb6M\x06g\xe3\x82i\xe0)\xa1,\xf2\xbe \xd3D\xbaև\x84\xb1wV\x88C\xe1\x99I\x16\xdb\xd9f\x9f\xfc\x9dB\xe7,\xa5VBHH\x96i\x1d\xa9\xde\xd3^*HBC\xd9\x15\x02\xccQE\x97Ĉ\x03Z/\xff\xaa\xfa\xd5J.\xa5\x9a9\xa6ֺ\x97\x9e\xc5e2\x9a\xd6\x0e֡\x9e\xb0\b7/\x15i\xe2\xa1\xf0\xb5h\xe0k\x03>\a\xd3\x02\x15\x10y\x9a\x05\xaf\xa5\xda\xc2]\xde\xcbI/\xad\x93sag\xe3[\x1a\x92\n\na\xc7@ hXa#.H\f\x8d\x0elgk-\xde^\xdfca\xe1 \x81\x87:\xef`Y\x02:\xa1\nMԪ;uC'\xe9\x81\xdcJ-\x85\x98\xb9Z`\x85Z\x9a8\x1fKꝙu \x8eT\x8c\xfdSb\x0e\x8c\xa3\u007f-\xa4P\xbb\xf9\xf7Р\xbd\xebV\xa1)\xbb\xa5.\xc5R\xeb\xca\xf4\xb4M\x1ew!\xb0\x90\xd0+\x00\xa7\xfc0X9\xf4.3\xdea\xfe\xd0s\xfb\xac\xba\x9c\x9f\xeb\b\xaf\xee]U/\\\xb2e\xc7\xd4A\x9d\xac\b\xf2,\x84B\x02\xc4\xd1\xeb\x9a\xc0\xbbC\xfb\x84\f\nso\xbf\xcc\xfb\xe4\xc1˕\x87/W\xfb({@(\xfb\x15\xcc\x17\xee:\n\xdc\xe1!*4\xf6\xd6#\xe8GX\xb1z\x88\xad\x0f\x1aBu\xe0\x81 t\x8d)K\xa5\x9cw\x04\xc2S\b\xa3\xf1\xe9H|\"\x0e#>\xba\xccw\xa5\xbc\xf4\x9c\x89\x8by\x1d\x9cx\xcdgw2YcD\xea<ｆ<\xd3b\xb6N\u007f\xfc\x94\x94˄\x10O\xc8S<#\xf2\xe9\x98\xcc\xc1\x86СJ\x8f\x01\x16\t~\xd8@\xc2\xf9xѕU\xc9$\xd5\x00\xdb\x0f@v\x94~P\x89\xcb\xc1\xc2'C\x87\xfaPy<T\x17\xa7*:\x1d\x0e\xf7\x14\xf5c[Z28\xb7\xa3RB\x82\x9dL+\xea\x8d\xc2\x02\xb4\xf5\x8f\x8e\xe0H`\x11l\xe9\xf0US\x8d3\xeaG\xeb\x18\x03x\x1c\xddz\x1d\r\xddQ[\aΕ\xbb\x1da\xbag\x04i)\xd47\x958]j\v\x1b\xbe3 KT\xddz\xe3c)6\x9a\xca\x03i}\xec\xa8\x0fP\xa0\x8aY\xd9\xd7\xcd\x0f\x1f\xef\xee\\K\xf2\xb1\xab7i_\x11O\x9f\xa4\x16~\t\x18\x83\xa7_\xa5\xcb\xf7O\xceS\xad\x0fAZ\u008e\xc1\x93\x8c\\?}r.\xfe\x05\xa8V\xe7\xc8\x1c\x02\x8e \xd9m\xc1\xe6\xe8*?\xa9\xad\xff\x85<m\xbe6\x89}\x8e\xab\xa0M\x06\xa0\x03\x1a\xe8\x90☧\x97\xefVEy\x93\x96\x99\xa7?\x16\xe5\x02\x89减\xd5$\x87\f\x84\xd4y%\x99`\x16\xe5\x87T\x12\x91Ħ\xdfQO\xb1N\x18\x95\xfd\xde~\x13\xebp\x03\xac\xb8=t\x18Da\xa4\xc390\x1d\x95_\x9a\xc8z\x89~\x1c\xab\xa7\xc3\xf9K\x9bq#\xb7\x85\xe2\n\xd4\U000527ee0\xff\xde-5*\xe3e\xb1\xa3\x1c\x97\xc9\xef\xd5\xcf\x1dÙ\xa9ǒ\xf9\"h1\xe7k5\x01/w\x18\x84\xa6\xee\xa4w\xa9\x97\xe2\x1au\xb9`:n\u007f\x96eCU\xb5&\xd6m\v\xac\xe6:O\x13/dLIQ\xfd\xbbt\x83U\xb0\xaa\xe8<\xe2\xc5v\x9f\xe3\x15\xdf\xcb\xc8\xd1{\x95,\f\x9e_\xc1)O\xb8J,\t\x80\xb66;_\xe5Eʇ\xd1ś\x01\xbc`\xe7#\xfc\x01/\xa3\xe6\xfa\xec\x10p\x8a\x13<\xc3\xf3\x89큈\xdf2S*\x8f\xb9\r\xcb\xe3F\xe44\x9b\x1ad\x18[{\xa7BD\xf1\x19\x8f\x81Nr\x06\xd1\x197\xde\x01^M\x13\xe3FfF\x84OƳƞ[VT~\x8f\xe5\xff\x92$9ҙ\x19\xb5՟\x17\xc9\r\xc6*R\xf7\xf1\xfet\xc8\xf7#\xdd9\xb3\xd5\rL\xb0\xf8\xe3#ȉ\xdcc\x00U?>\t\xaaO\x87\x93\x8f\x1a\xbc5\xda=\xa1\xff\xb1\xe3\x1e[\xf4=w\xed\xe84NƖ\xfbm\xe6o6\ue84f\x9a\x90\xca+Z\xc5S\x17,\x86K]\x87CG\xec\xac\tT\x806\x91U\x04Vi\xce\xea@\xc4\xde-\xd2 \xfd\xd0O\xb6\xc5;I=N\xfc\\\x81#//`C\x14C$\x89N\xa8\xae\xe6\x05Q\x8d\n\x8d\xf4\f^\x91<\x05\x88&\x06\u007f\xc9\fhQ-\x06JԚ\x9a\x82$I\xa8\x1ff\xedZ%\xce\xf2\x8d\xb0\xd7:ϭ4\xe3\x95]<Mp+}\xe5~\u007f\xe6\xeeD\xbf\u007f\xd6XZ3ib\x03\x180\x180Oed\f@I'Wl\bn.\xc0P#W*\n\xad\x14\x85V\x8a\xce\xc6P=v\xe9̹\xed\xd5\x0e\x1f=\f\xb6ԹuA\xba\xe3\x05\xa8:0\xf6l2\x15\xec\xf6o\xb7R~\xfa\xda \x9c\xdeocd\x90\xfc1ԧ[\xef\xee\x14*\xae\xa0\xbe,\xed\xf1t\xcfݯ\x8cRN\xa3;\xabڱ\xa9\xc1\xf4\xa20U\x89\x1bܔZ\xedz\xaaح\xd8λ\xa4j\xear\xb7j(O]\xdcZ\xa3Ӥ\xc9\x1d\xae\x8bkq\x10\xbcc\xacDb\x99\xbeAb\xa7\x12\x8e\x15\xefޓ\xc59N\xaf\xb1[\xac\x15s\xe16^\xe8u/ɐJ\xedR츴\x1eW\u007fv\xa6@\x12\x00\x95\nI3M%G\xcb\x15H\x9b4!\xdax\xeb\xfa\x1a\xe7\xe9\xad1E\xef\x98\xf8o37\xb9\x89\a&7\xe9\x9e\\`?\xbeO\xa9\r\xf1\xee\x05`\xfb\xc7\xee\xc9?9\xe0\x9a\x92U[\x8d\xd8z\x94mM݉z\xa0N\x14KB2\xac\nw\xbc\xdc\xc9LqQmu\t\xa7'<\x89Z\xa1\xd7\x11c\xe3\xb0\x11\xa7R3\xc5:3ۈ\xb0\x88\x8d\xf0\xcf\xd1p\x02\xd5;\x97\x81\x03/ӭ\x9c\xcfLU\x18\xd7F\xd6\xedw\x18\x18[0\xc3\n\xb92\xb3;\x8b\xe8\xf84\xc6\x10\xee\xf7N5\xe5t\xa0*\x1d\x9e\x9fk\x05~l\x82\xcd\x17A\xaa\xdf\xfd4\x8b\xa1\xc71\xa4\xc0\x97\xd8\xc3v\xe7\x81\x11\x83\x8f\x92m\\4\xcf_\xfecU\x9ae_\x15٭I\xfc\xd8y]D^\x16\u007fm\xfc\xdc\xe4\xae:\xacM\xbc#\xdc.\xf7|q\x88\x9d/a\x11\xaf\r)\xear\x15\xf6\xdfZ\xa3\xd4&@\xfe\x96\xb7kDāJ\xd7\x1c]\xe0(%N\x88\x95=\xf4>\xb6i\x90\x96.mL\xfb\xc8kID\x91\x8b\xc3\xc6\xdd\xed\x9b\x1fu\xc9W\x84\rU\xba\xcdNks\x9fj\vVJ\xe2\xbbQ(\x1c'\">\"Ly\xed\xb5\xe5\x1f\xe8\xca\x1b\xc8մ\xea\x1b\xa2\x81\x8eQ\xe7\v\x14[\x177>\x98\x9b\xf1\x96\xed.j\xe5[h\x1b\xcf\xfc\xde=\x03&\x05\xb3Z\x0ei\xedz\xf3Y\xe0\x10\x90힙\xdd\xf6\x1a{\x1b\x1e\xb0.os\xb5\x9e\xae\xa7-\x16My\xf3\xc6\r\tvm\\\xe9ֵi\xf6ܰ\xdb\x00)F\x1dt\xf0ܓ\xe3:8\x8d#ɸ\xea\xd8\xe2\xfa\xad\xee\xe8\x16w8\x93\x04\x8ejh\x8b\xebZ\x81[ۏ\x9e\xa1\xe9\x87\x13?CQ\xef{\xdb\xffۇ\x9a:[\xb6|\x99n\xa0\x89\xf3\x9e\x13\xd2K\xdf\xe7\x11\xa5f7\xa4y\xbe\xfc\x19\x9fM\x90e\xd2\xc4\x0f#\x8fN\xd0\xda\xfe\xe5\xe1\x81\xd8z{!J\x97\u007fC-\xee\xf3vb\xd6\xf2\xe9\xe9\xe4_\xc4\xe2\xdf\"\xe7G=\xb6\x9c\xe4\xfa\xefU\xfe?0\xfb\xff5O2\xfc\xa05\xbfZW\xcbndz(zNg\xab#\xf5<K\x0f\xe5c\xcbv\x97\xa7\xd9\xf7?\x98\xd7v\xe6\xf2\x81\xbd~\x10:\x14\xc4\xedH3k\x90vl\xba\xbe\xca\x05\x97\x9c,\xd3\xdc\x04{\x0f\x13y\xaf\x89\xd4ݩ\xfa\xfb\xbd\xa3\xc9\xf3#Ր+*\xfa\xf0\x9ekJ.\xd3d\x85\xec\xc6Y\x04F\xbc\xd8\x0e/\xd3r\xa8U\xc2R\x94x\b\xe0,\nw\x9d\xe8\xb1=\xa7@\x81\xbal\xa8t\xbb0n\u007f1ya\xd5-Ըf?\xce]\x1f\x91\xa4\x95\xe5T\xa9\xdd\xf6\xfbP\xdea\x9d\xaa\xd2m\x82h\x02\x0e #rȸ\xe6&\x8b\x84G\xec\x90%\xf9\b\f\xa8\xb2$\xb6\xbbH!*\x13\xf5\x18\x97$I\xe1\xa5ibC\xc9\xc3\x038-\x8eb\xbe`\xe1RHVQ\xa9\x98?\x1c\xe4D\xf7{\xef\x03Y\xbe\u007f\xbdŴ\xdf?#B^t\xf2\xf8,\xf1\xcb\"ϋ\x9b~\x9f\x18\x1e\x81\x1d2>c\xa1(\x03ڤRs\x14\x81*\xec\x88=\x9b6\x16=\xb6v\x88\n\xa9T(|\x98c\x87\x8aK\xc8\xc3 t\xa5B\xaa\x11\xd4ɂf\xbf'F[\xe9\x18\xc6\x05E=\xf1\xb9W\xa3O\xf3\x13@\xac!\x8bdD\n\x18\u007f\x12<\x8a9\x80\xfe\x18a\x8dP8,\x03\xb3\x9a\x91\"a.~M\x8b~?j\xe8}2\xa9\x99A\xfa\x8fN\x9d\x8f\xda\x00v|\x03:T?\x87\x15S\xf7]\aYEE\xbf\x8f=YN\"\xb7z\x801g\x88\x85ϐ\xdb\xd2no-\x01}$\x80$\x863o\xfd\xdanJԇUպ|\xbat]\x87\x83m\x90\x03d%x\a\xa5\t\xd6W\x90ԳD\x05|:\x9c\xa02Ig\xba<.\xeapi\xca<O\x1e!8-O\xf7V(\x85\x04\x8c\x87\x13론\n\x06\x13\xd7\x19A\xb2\xbfy\xe2\x99:\xb7\xc2K\xec\xf7\x1e\x9a\xd5aF\x0f\xaa\x86v\x89\xa7~\xba_\xfb\neI\x8b\x95q\x89M\u05ed\xed\xa8\x00\xacE;\x174\xc8p>\xbd\xa7\xbd\x13\x17\xb0\x0e͠\xd3\xf4{\xca\x0f.\xb16\x00\x0e\xaev\x99\xa8h\x1aY\xd0\xcc_\xb6t\xf4\xa5ȵ\x99o\x05\u007f=\x04\xd5@\x98\xc9\xc3\xf6\xe5S\x89\x93-\xfe\x06w\xf0C3\xfbt\x89\xdb\xfc\xc7\xcc\n\x01Kt'}\x1er\xb4\xdb\xc6;\x15\xd23\xab\xfdB֕\xe0D\x0e\xd4]\xd7u\xb3F]\xf9=\xaf\xbf\xef\x94wE&Z\x8b~O\x9e`kRșFhPwR~]t\xb2~[\xc9\x18\xcb\xdfBQ\xdaЊ\xea<\xe2\xe1K\x11ӬE\xee\x9c\xdb\xe1>\xcdPS\x96\xa4I\xa7\x82\xd4\U00063334oZ\xad\"\xbd\xff\x84\x8fۢ72{9$\xd8\x13\v\xa4_\x8a\x9f@\xdeIK\xaa\x16\x81\x8dz\xf7鸂PfL\xad\xc9\xc1aA\xc9%\xed'\bb\n.T\b\xeeX\x85f\x90фHN\xf8-@&ˡ+^\xfd\xe4\xf4yzďc\xd3>\x1eH\xc4\xd7\x15\xebTb\xc7ޠ\xb1Lʮ\xa2a\xac\xa23ˮ\xd7F\x10Aaf\x06\xb4h\x12\x03%\xbd\xc8[Ս\xa6\xd1\r\xd6\xd4ׂJA\a\f\n\xd8\x12~\xc0 <x\xe3\x85\xebȺ\x10\xdb\xefCCvwߚ\b\xa1\x14\x97-)l\\\x85\x10\x024X\xd9\xdfRۭ\x8e\xb8\x97\x06\xab\xc3\xe5\x02\x99\xb36%ҰȤ\x05\xe4\x9a\xf78K\x12<sN\x1d-\x1c\xf6\x8d#R\x1f9A\xab\xab\x86\xbd\xd0\xd4P\x96`ȼ\xfd\x9e\x84\xc2\xe6\x98\xf3*\xf0L9\x17\x13O!\xe2z<\xd9D}\xc1\xb0\xab>\xfb\xaa\x8eQgxWR+\x1aN?\x98\xe3f\x8f\xa1\xa6\x1e:ԋ\xf6P\xc6\v\xc7ǎ\x9f*s\xa8d\xbd)9Mcd \x16\xc0q9\x8d\xde}\xf1\x1d\fXK\xe5p\xaa\xba^\xe3(Y\xc8T\x15\xa7i\xc7\x1d\t\xa0\xa9\xf8\xf6|1\x9e\x85\xa4\x97\xd6\xd6K\x18\x0eӲ,nzD)W\xea_j\a\xe5/G\xc3ҫ\xf50\xbdƑo>\x80v\xcc\xf0Dw\x97_\xab\xcdw\x05c\xa3\x86W<\xeb\x99\xd6\xed+U\xff\xe7cd%9\xa3\xa1גW\xfc\xf9x\x8c\xec\xe6kTk\xa8\xf0\xc7\xc8c,c`~\x02T\xab\xd3b {\xd1\x17\x01rT#ݡ\u007f|\xd5\xef\xa7w\x80\u007f!\x17\x8a\x17?\xefҜ\xfc\"N\xcdo\xed\xc4P\xe7\xc4Q\xfd\xef\xf7\x00t\xe4\xea\x9f\xfbu\x877)_\xaeݼ\xfd:\xe6\x86(\xc6Y\xe2\xa6\xef\xef\f\xd4\xd5\xe8m\x01\x82qw=\xef\x00\x84\x0f\x19\xfe㟭\x19\xc0:e\x82\\\xe0,9a\xfc\x85s\x8d\xaa\xe6$\xdc\xdaӄx\x04\f\x9d\xd2\xe7kZ\xeb\x98/\t\xcd4\xa3\x92\xdc\x15\xf4\a\xb9\xbeo\xb1\xf4\x18\xd4Vzu\xa9k\xd7W\xd0\xef\v\xc6\rVd~\x03\xef\x93k\xda7ժ\x9d 8\xc8\xe6ʰ\xe5\xe6\x163\xebxMg&5\xf7;\x99n\xf4\xdb\xff\x94\xc1K\x95\xf6Z\x9b\xa0\xa8\xcbL\x10o)n\xff,h\x8f6\xd40\x8d\x9c\xb9@\xcd\xf5X5;\x8a,\xf3D\xfb}\x9a$\xc9\xd9d\xbfWeI\x92P\x19\t\xd4 \x94\xb3\xfb\x05}\xb5I\xaf0\xfb\xa6H3\x9cE\xfa\xbc\xbd+\xf1*/n\xdc\x01c\xf7C\xe4\xc6{٦;\x86;\x1d\x95\xdb\xdb\xe6=8\xdf\xc9\xfb\xaa>?qkgG\xa1\xcd:h\x99\xe97\xf6\xb0\u0095\x03\xebO\x9d^\x1ev\xb9n+o6a\x8fb%Ş%-\xc7\x17\xc3e\xb7\xfb\xc5\a\x01\xe8\x029j\x1e\xcfY\xcbf\x16l\vƱ\xc5a\x0f\xc2\xe84\b7\xcd\xf0E\xaf!\x98\xa3\xa30\x87\xee\xd1\x1d\xd7+\xd6X\xdd\xf9>\xe9\xda(\u007f+΄\xbc\x8fG)\xff\x06\xa7\xac\x11\xf0\xa8>ju\xd6\xf5\x99\x1d\xd6\xd8߆\xa0hȭ\x12\x97\xb4U<R\xc6\xdb\x06\xebE\x1fZ\xd5_\x1f8\xee\x84\xc2!y\xcen\b_\xae\x03\x8bq݊Ħ\a\xaahuvǊ\xde\xf0\xb4\x16\xcf\b\x93?q\x16\x99Ǽ\x83\v@g\x13\xa5\xd2\r\x83\xeb\xab\xdb\x1f\x8a\x9b\x99\xb1\xd8\xf9\x93l\xcbd\xa1\xaa\x9fn\xb7\xf9\xad*\x96\xa5m\x82P7k\xb5\xf0\xc8U\r\x05\xbb\x82N@\xf4\xbcu\x1dr\x03\xe7\xc5\xd6\x1e2S}r\xb0\xba뻦'\x1e:\u0086\x8f\xc3\xc9|\x81H2։Ij`kƎ>%\x92\xb9\xf3\xa7@\x16#\xc6os\xac]\xdd\x13\xb5\x19\bk?\xb1f]e\xf6\xaff\x03\xa7<\xc200K\t\xffcj+\u007fv3\xbf\xd0\xc4\x1c\xd1^\x06\x12\x1c\xb1X\xd9|\x81X2\x9e\xd29[$\xf3\xc5Ԭ\xbdH\xc6(\r/;}ZL\v\xc1\xd3\xfak)\x82\xeb\x96צ\rQ\xe6T\xf6\xa72-\xcf\x12\"n\xa9\xc1\x00\xe9\xa9 \x92\x94P\xfeP\x90\x9b7{@\xad\xf1]X.`e֒'c\xb4K\x8cRq\xba{\x9aOsÓg\t\x8f\xe8<_4\xd2\xd9{\xd88\x9f,*(\xf0*\x82h\x9d\x98HO\n\xdb#\xa9\xcd\xc9\xe0Tt\xa2&\xba\x86\x95LIe\xb6\xd19\x15\xdd\xc1HB\xbd\xf2\xa6Ʌ\x95m\xb7%\xee \xf0\a\t\x1b\xeeT\xe8\x1c\xb82\x82\v\xe9D\xc8\xfbOx\xea\x88MR_\xda8]ZC!D'\xfd\t\x15\xf2ל\r'\x8b)YE\x8f\x9e&\f\xf2H\x96\x8d\xc5\u007f\r\xe3@\xed\x8b3\xc59çL\xb0Tʭ\xd0\x1c\xd3d\x8cʄ\r'\xd3\xf2i:M\xc5\xe1W\xe3\xa6\xedq\x8b\xaa{\xff\\p\xb7\x87\xab\xaa_\xb3M\xc7\"a\xdc\xf7\x1e\xfcX\x95@\xe3\x12\x13»w\xf5\b\xf1\xbf\xae\x13\x03\xd0!\x9a\xbb\xf2\xdb'\xb9\xfc\x9fB.7\"x\xb9\xcbq\xed\xbc\xbeܕB\xba\xfb>\xe5\xeb\x04\x00_Ho\xf0O\xc7d\xf6W\x94\xe3R\x89E\xe0\xd7\x04\xb1\x95\x1a\xed\x12\xa7\xef\xb7\x05q\xf0\xfd\xdd\x15\xa6\xb8L9\xfeA,\xc0\x96އ1\xf7\x048\xc5\x1b\x8b\x03I\x9c\x89{\a\x87\x93|\xc4\xd7e\xc1\xb9\xb1Oo\xcbY\xe8\xf3\xb1\xcb,u\xb2\x8c\x92E\xb64\t\xf7\x88\xe6\x9a\xe4v@c=,\u007f\tA\xe1\xf5\r\xb5\x18\x88\xa1\xc74\xc8:s\xbc\x98jc*\xe9\x90/\xf9툌\xa4\x18\nU\x19f\x82\x8fN\b\xac\x8c)w\x89\xb7y\xba\xc42\xe2<_7\x1e&k\x90\a\xa6o&\xce\xc5\xc4\xc3|\xbe\x18\x9a\xa8\xb5\x1c\xac\xd0\\\x1e\xb7\xcb;\xd8l\xce\x17S<z\xf3\xfd\x8b篞}\xf3\xee?\xff\xf2\xe2\x87W/\xde\xccɈ\xa6\x1b\xbcH\xc8HN\xb9r\xa309\xe8\xd2u\x89\x13\xc9MM\x89\xafC\x97\x10\x9e\xb5\x8b\xe2\x80n\v\xb8\xd8\x03Mt\x84\x8b\xf9\xe8\xe1\xecbq~U_CL@\x8e@\xf9Tр\x00\x83楌\b։\xe5d\x89\xa3\x89t\xd9aۜ\xf0\b\xa0\x1eP\x06m\xea\xdbX~\xfb\xa9 4\x02*\xf9˼\xb0!*C`*\x17\xfd~T&\xa1\x0f2X\xaa\xe0\x81\xee\x04N\xc4)\x92(\x14\x97&\x8f\x8f\xa2\x17\xd4\x02V\xe3P\x88\x910X\xec\x10\x95\xb3$\xf1X^D\x13\xd3C\xd6<{S\xf0\xea\xdb\xff\xa8]\xbb\x9d\xe8\x13\xb4\xc8\xf0w\xe9\x06\xcfB\xaa2V.\x05\x9b4ʋ4\xf3\xf3\x12\xb9\xc4\rW\xd0\xde\xc92\xf1\xa9٩QtEV\xfb\x9f\xb6W\xfb\x9f\xb6\xf8j\xbf\xa5W{v}\xb5\xe7d\xb5\x82\xd1|\xf6\xbb\xc5\xe8!\x9c\x9d\x13\xd8\x18[r\r\xe02]\xbe\xbf*\x05\xda\x0e\xc9&\xbd\xc2 \x06\xbb2\x8f\xc0\x00\x0f\x00\x04\xfe\x90\\\xb2\xa3\x18\xb9\xe6\x8c\xce{\xfa\x9ao\xf2\x88\xb9-\x10\x17\xbfW\xf6PHC\x90ƚNy\x17\t_\xf5\n\xa3\x05\xd3*\xae\x89\x06^$wyJ3\xb6L\xb78\x06lYbL{)\xcdzQQ\n\xf1LN'\xee\xd9:\x10\xa0mQ\xf22%\xfc@uS\x05\x02TbNh\x1a\x83\x82\xe6\xb7=\xb7\xc1\xf0\x06_\xbe'|\xb8!t\x98\xe1k\xb2\xc4\xc3-\xf9\x80\xf3a):\x89{\x8f \xea\xb5\x1a\x89\xca\xc3M\xf1\xcb\xe9-\x86E\xf7\b瓮1\xee5\xa1\x12\xb3\"ߩ\x95O\xbe|\x94m\xc9)5\x1fe\xdb\xed\a\xd8\xc5V\xf9W\xec'\xc6\xea_\xe7\xc1\xe3\xdb\xf4\n\xe7yJ\u007f\xc3\xf7\x8e\xe31\x836vP\x9b4\xbb\xbc\xc2\\\x06\xbdV\x9a}Sc\xa8\xbeԯ\x15yg:\xbf4\xfc\xa2q\xd7\nx\xa4\x82\xd9-\xcb\"\xcf\xc5o\x9d\xe4N\xbb.\xc9g\xf1\xc4r\xa1\xaa\xda\xf7\x05K\xb6i\xc9\xf0+jx\xb9\xd16\xbd\xc2?\xea\xd8\t\x93\xee\xcc\xe1\xcb4_~\xdf\xc5\xdcx\xf6\xec\xfe\v\a\xad\u007f\x9b?^h\xbb\x059\x92b\x98,;}C\xa8\x12\xb6\x95r@\xde\fu\x9ci=e\xf9\x1c\xac\xaa\x89\x93$\x15^Z\x99\xa4\xe7\x9f\x15\xcb\x03\xdd\x10\r\x0e\xdd\x05\xf1t(\x8d\x1e\x11\xf5+S_yU;Qȍ?\x1aJߙ\x0e\xf1\x94AC\xc7\xd7y]b\xb6.\xf2\fN\x8d9\xad\x84|B\x11\xd6\xf0R\f\a\xf5\xac\xbcN\x8a5\x11\x01qM\xa2\x9e\nɎ\xee\xb2]\xa9.\x17\xc7\x04P\xe6|\"\x05\xfdZ\u007fC8e\x84^\x85\xaa\xbc\x90_\xaa\xa9+\x19\xe0\b\bN\x02\xa0F\xac\x05mL\x8e\xf1\xf6\x1bB\xdf\x13z\xd5\xef\xe7\xc5R\x9d\xabu\xcadN.\x05\xea\xb7\xc57\xc52\xf2>J{/\x8b\x82ʈt\xb7\xcdR\x8e\x9fIL\x8f\xda\x11\x16e$\x8d\xeeG\x93\xb6 \x02\xd4\xe8\xc1\x17\x16w,/\xf8\x85oco\t\x02z\x90\xce\xd7%^\xfd-\x01\xbf\x03\x8b\aG2\x1dN\x1d\xe9\xe4\n\xf3g\x9c\x97\xe4rǱI\x061\xf5!C\xdc}w>t+K\x1d\xb4\xe3\x11\xf6ՐC\x8fO\xb7\xc8w\xfe\xc8\xffp\x99\x96:\x98J\x13\x8b\xea\xcc\xc8:]Xtg&\xb5\x8dI\xd5plnaW\xf8\xb3\xc2,\xe8p\xcd\x1dr\xa1b\x98]\xd4h\xef\x98+ݺ\xe5]b&\xc2\xc7Ȥ\x8c\v4h\x90,\xcdx[\xe2\x03\xb5˙>\xb3F\xbe\x90:\xb9\x9e\xe8\xe0\x89\xf3y>^@\x9e\x8c\x95\xc2\xce\xc1\aK\xb9\x9f\xe0F\xb2p\xddm\xd3F\x93#V_\xfc3>\xa4\xed-|\x92\xe08\xf8\xc1)\xb3\x88\xf0D\xb0\xc9S\x9e\xd8\xc7\xd4z%\xf1\xb8\xaa\xddv\xd5\xd9\xf0|\x9c\xbd\x9d\x95\xdf=W1sI\xd5\x17\xe2\b\xff\xec\x05\x04;\xd2C\x80\xa8X\x0f'g\x04\xfd\xa0\xd2>Vzoׄ\U00062f15D\xf5\rO9\x9eu}P:j\xf9O\x01c]\xcb#TIQ5.\\ܥ\u007fV\x98蓍\xb9;\xef\xc5)\xfa͖wuӫ\xb9\xe7\x8d`\x03\xf06\r\xd0\x1c\xe0\xdeg\x13;\xb7\x80\xdfk\v<\x18\xd6j\x16\x04\x00\xac>R\x15ۢ4\xca\xd6\xca'01\xc8\tũ|\xd5\xd5\xf8\x1e\u007f>F\xce:c\xa0M\f\x91\xb3\xc4\xf8l\x82졉\xbb\xec\xab\x1c\x9e\xf4\x93\xa8\xf1\xcf/j|\x96\xa7\x8c\xbfU\x87\xa6\xe6\x9c?\xd3ǈ\xd5E\x1f\xa9\xdf}\xbdZ=O\xe9u\xca\xc0\xdf'\xb0l\x8b\x12\x04\x12-*\xef\xb3\xc0\xd2\fs\x0e=\xd3-/\xf2,\xea\xa9Bi\x9d\xd8*m\x05\xb0\rG\xd2\xd0\xf1\a\xbd\xaf6l\xad\xdd\xd6`B!\x99\x01I\x06\x1f/V\xab\xe1R\x82r\x88?\x10^[\xf6\xea\xf5\u007f \\na\xb8\xb2{\xc5Z\xe1Dm\x84\x16M\"\x90\x91k\x99\xf9\x9ay4k\xa9b\xbe\x82P\xaf\xc8\xca|\xce'\xb12L\xb9\x8c\xed\xbf\x95\xa9\xb1\x88E\\3I҈\xc8B\xd8\x0f\xf8\x1a\xa7\xb9\xb1#k\x97\xef\xf7\x14\xdf\xf4~\xc0W/>4\x92\xbc\x96\xb2\x86D'\x04\xae\x00\x1cq\xcc\x1a!\x82\xfcH\xb1\xa8c\bc@\xe2w\xfc\x9a&\xc1҆[v8ޭ\xaa<\\\x15\xe5p\x833\xb2\xdb읒\\\x889\xf0\xfc\n\x8a\xb6Zg;\x04p\xfehQ\x87\xd9\xf9\xf6?%\x01\xc0e\x04\x1b\xf3\xae3\x15\xbc%\x1blr\x85\x85\xbf&\x13\xfc\xf8\xa1\xe4\xee^\xe6Ej\xf9\xbb+̟\x17\x9b\xed\x8e\xe3\xec\r\xbf\xcdq\x14\xdaN\x1d\xba|\x01\xa0|\xf8\xac;6W\f<پ\xe9\xf0u]\xacVKM*\xfe\xcf#\x02:>\xa0\xf5\xb4\xb4Xd\x1d\xb7\x94\x1fWwT\xc0\x80\xe3\x85>\x01u\x98\"\xb7P,\xfa\xff\xd5rUs\xe0\xe6\x82<o'\aS\x8eI\xc3'\x18$:M\x0f\x9bg\xe1ֱ\x803\xac\xff\x16\xd2Q\\\xff\x98(\xc1QK\xca\xfe\x02\u007fՀB\x86\xaeG\xac\\\xf1I\x14\x1e\xce\v\x13\xb6w\x95\xa0^\x008\xe5\xda\xfcʄ\xef҆b\x96X\x99\xa8\xb4>f7\x10\xb7\xd7F<!\xcb\xd7Y\xb9\x95\xe3\x1b\x8c\xa3V\xf7\x13\xf4\xf1\x912OF\xf7ʟ\x8d\xf4\x99\x85G\x82\x91\t\x1e\xe7\xb4@V\xfd\xfeYcY6Z\xa0\xc2G\u07fd\xc17\xae\x95&\xfdo\x8b\xad`\xa6\x80\x91\xbc\x8d\x9c\x1d\x8d=\xfe\xe3\xdb\xe2\x1a\x1f\"\x82>(\xbd\xc4.\a\xc9^#\xa2Y]E\xac\xaf\xa7\xd79\x04\x03\xda\n`\x05\x11=\x18\x17\xadj\xf2$\x1d\xec\x83ǿ\x1cbt\f\x9f\xe1\xe5\xcb\xc1\x8d\xc3v:\x95\xf2\xa6,}\xccdb\x10b#\x9d\xba\xcc#\xe9\x8a\xe6֊\xc1y0\xe3\x8e\x1f\xd9\xcfs!o\xea\x8cQ\xefR\xe6\xe6\x00\xb0\x11\xec\xad}Sn\xf5L\xa2c\fK#\x00\"\x18Nll!ۍ<\x1c\x96\xf4\xda\xe2N\x13\xd7\xfb\x86\xb1C8\xd1\xe1\xeb\x10Q\u007f\r'p\xeaܚ\x8d\x9b2|[y\xea\xb7/ϒ\x84\x8fn\xd6d\xb9\xee\xf7Տ\xf7\xf8vYd\x92q\xa8-\x99\x89\xce/\xc9\xd6d\xc5\xff\x8co\xe5\xd7@\xf4\x10\ri\x99\xe2\xc8$\xa9\xc4\xca\xf2ٶ\x15\xd0\x0e\xa533m]R\x1d\x0e\x95\x87O\x8b\xa3\xd6Ea\x88\xd5\xff\x1e:ݭhz\x9d\a\x9c\x04\x0e89\x16\x96\x0f\x9d*\x9e\x84\x92\x9d}̡mN\xa3>\xb7'\xd1\x1aMB:OБ\x03\xe4\xba\xc9\xd9c\xe4\x1c\x97\x8e\x80yR\xf4?\xba\xd13\xe7.\x16-\xea\x8bO\xfet\\R=\x16-\xc4\x01<\xfaC✈\x88K}\xae\x1f\xe3&\x80\xbcm^\xc0%\x81\x16\xb3O\xb5\xd0\xf3{:\x959F>\xd3\x18 \n\x1fk\xc8\xd7t\xc1\xf3\xef\xcfxl#\xf2\x19\x9f=s=\x8b\xca\xf5\xe9\x8b\xcf&\xc8pg\xd2V\xc0\x8d\xd57F\x8e\xbcfx4)\x0e\xf9\xf1\xfd:\xd4K\xae>\xe1\x93~\xe9_\xe7)\xfbuy)\x15\n\x87C\xf1\xa9Z\xe8.\xe7e|\xd7\x19n\xaf\x11N\xafB%\xcfMu\xf5ͭ\xad;\xa8\xab\xff\xbad\xfa\xe1d\xdba\xfd\xb3\xce\xe2\x8cK\uf140\xe5$\xc3\xec\xa4\x0edU\xd5x\xda\x1d\xeeO:\xad!#\xef\xa8\xee\xcd\v\x8a\xe7!?\xc56\x1e\x87WWqW\a\x125\x9a\xf9\xec\x18\xfe\xf6/\xaf\x1a\xad\xebv\xb4\x18n\nQsGd\xa2ģ\x8ej\xdcO\xcc\xff\xb2(%\x06\x04<\xd6\x1a5\xa2\xc6\xc4.wy\x8e\xb9aE\xdf\t\t\xf4+U\xd4Vi\xb6\xb8\xd8\xef\xf3\xf4\xd6\\\xb2zM\xda_ѤB\xb9\xc2ś[\xbal5^.1\x93\xb7\xafi\xae\xb1\xa3\xc5g\x8e\x9d\xcb˙\\'\x96\xe9\xf5\x9c\x84$\x97Ň\xd7+ݡy\t1\x8c\xb3\xe3\x82\"WpB\x0eRN6\xb8L(\xbeq-:\xc5\xfdЌ\xceY\xbf\xb3\xfb\xbc\x84\xa8*}\xc4\x11\xa1+q\x96\xb0$\xf7^\xa2M\xa5\x97x#\x80\xad\x84zT\x8f=\x92!\xdc\xdc\xe7\xcd\xc6柰\x88w\f\U000ffabdPϖ\x91\x9f\xacV|\x97\xa3미\xa9m\xf1\x1aw\xbeA#\x9a\x8c\xa5\u05cd\u007f\x9c\x1a\xf6\x12\xf5#\xf8\x81L\x9a\xc8\x04\xf8r\\}e\u007f2v\x9dd\xcct^OƢ\xbb\xfa\xa2.q\x9e\xea\xa7\x1c¶yz\x1b\x03ZP\f*\x88hB\x9e\xd2\x19\x89)b\x83A\x05\x11\xb3V\x94\x1e\x96[i\xcf \xaf\xeb\xfb@+\x88p\xe4\xe9\x00}\xd0u\xfb\x8et@\xc3]\x87L\x1bZ;\xb5\xdc\xd7\xf2\xc3\n\x0f\x8dS[\x8b\x15\x96\x90ސ-n\x9cq\xc9Y\xc9\x0f\x82ّ,\x96\xc0\xae\x9e,\x92!\al\x99\x96\xc3ڕ\xc1\x11#\b\x84ۨ^w\xd5\x18\xe4\x9e}M`\xd5I\xcc|\xb8x\xe6\x1cͱ\xbc\xd4\n\xcazY\xd6\xc5\xd9k\n\xd0ف\x8fФ\xae(\xe7\x87j̀tT\x011P\xd1@\x17m\t^VxM\xff\xa4Ҭ\xb4]V\xfdX\x8c\xa1\x15(\xca!\xfb\x89\u0091\x0f\xef\xb5n\x99\xc2\xc1\xa7FM\xfd<M\xafU\xeaP\xe6{\xef\x1d&ւ3\x91\xb7\xe5\x00\xa0^\xf3\xa3\xd8q}ۓ\xc0\x15\x12\xd8G/ZVs\x852i\xf5\x11,2\xa71\x10\xf9\xcdNUJ\xf2\a\xae[s[\xddw~\xf2\x98\x9e[f\xe3\xfc\xcay٩_s\xfc\x84]u~c\x9d\x1fV\x92HE\xeb\xf0A\xe6\xa7р%\xd8\xe5\u007f\x88`\x8e\\\xc0P\xc4\x10\xa95x\xf6b\xb7\xf9S.͍\xdb\xd4дw\xe1hx8\xc3\xf7\xca8s~\xb6\x8d\x06\xf5\xa8c\xd0uW\x9b\x84\x03\x01\xba\x81,U\xb2\x10\xb3\x84~\xbf\xfe\x11\x86\x9d\x9f\xe2\xc3ju\xeaQC\x12?\"\xae&\xf8\xf0\xe6H\x16T\x10\xf4\xf3͎\x18L\xa0\xfe\xbb\x9e\x8f\t26\xa7߯\xf4\x8c\xb5ޮ\xe6RHei\x99\xf0\x19\xf8A\a?\xfaFJ\xb7\xb9(\xfaF%[S_\xd0N],,\xc13\x1cs\xdf\xdb\xc3p3\x825\x98Qy<\x0er\xec\x86\xfd=\xa1j\\ħ\xd4:4\x1d\x81\x17'N\xe7h\xd58\x8dO\xa8\x85\x9a\fD\x8b:D$!\raAE\xe3c\xbeA\x9ba\xd5\t\f\xca\x1b3\xcfuH\n\x18\xc6*\xef\x15\x8dXG\xe0\xba\x06\x9f\x94^J{z\f\x90t\x98n\xdc@s :|E_\x96\xc5\x06\fʅK\xa4\xd8\t,\xd7e^,߃ʳ\x00\xc8e\x15\xb0-r\xc2q3K\xb5\xbf\x8c\xd7;\x1e\xd1\xee\bc홾\xde\xf1\xb7\x05\x18\xe4\v_\x83\xdeJ\xfd\x9d\xab\x0ev\x01\x16\xe1l\xa7/8¾\x17\xd7f\xd6\uf6d2\x12ח^\xdc9\xb1\x1eaCBC\t\xc7ը&\xefDp\x83l\xe3n\x80釪.\x16\xe7̑\x18\xea%8\x85v\x15\xdd\xd1\xef\x04N*\"\xe6P\xee9[\xc0\x96me\x97\xc0v\xf0\xb1\xf1\x14Iͣ\x82]\x180\xba\xccwe\x04\x11I\xb0nƶ)\x95A|\xc5ņ\xb9్\xbd\xbe!\xe4\r\xb3C?\xaa\xa31\xdc\xf8\xf8\xfc\"\x861V\xf3y\b`\xfb\x93JS\xaf\xd1\xe0cS\xb4\xab\xd5\xc4gcTs\\\xe2W}`\u007fP\xde\xe1r7\x87Ć\v\xb3\xe7\xc4\xfb^\xec\xb8[A\xf5 .\x01\xa7\x03\x1doҴw\xbf\x8a\xe6\xfa\xb3\xc6D\xa9H\xb52o\xfc9~\x8c\\\xd2,>Kf_\xfc\xe1\xf2\xb9r\rV\x81 ~\xf9\xea\xa2\x18H0\x0em)@5\xf15\x1f\xb5\x84\xe8\"\x96\xf9ti0\xd6\xf2q\xe6\x8bҎYf\xd3\x14\xd7\xf9)\x14\xd9펱\xa6\x95y\x9f\x14\xb4\u007fo\x05-\xb7\xa6U\xca\xe12\xe4jZb\xb6-(#\xd7x\xa8\x03\x05\xba\xee\x96\xdf\xfe\x9c\xa8\x10\x14\xae\v\xa6\xdcH\xa7\xfc#m\xff~\xb0#\xeb`{\x1f\xa5V%B\n\xe7%\xa1W 1\x19!\x1c'h'\x88\xcb]e\x02\xb8(\x8fhmRՓ\xdc\xfcxʞ\xd8\xc8 \xcc S\x91ȸ#\xb5\xf1\x95\xf4\x975z\x82Y!\x13\x92\xb3M\x9a\xe7\x00\x95\xfe\x97\xc9\"\x16\x9f\xa7\x02L\xf2\xe5T\xba\xcb\xe2y\xba\x90\u007f{\x8e\xb0\xb8\xe2#\xc2^l\xb6\xfcV\xa1\x9a\xe3\xb8m\xf2{\xe8HI\xc6\xe4\x85`v浪\xf74\xed\xc1\xe11a3x\x93\xf3\xb9k\x02\xc8Xs(uN\xbd:Ogq$ԓ\xccJ\x98pi\x8fbxF\x0f%{\x842\x9eҥ\x8f\x02s\xbcдG\xbeV\xcb\t\x10\xe4?-\xd2\xf03\xad\f\x9f\xab\xb8\xd5\xeapn2;\x92m\x1082&u\xae[6\xd2\x17g\x04\x83g\f\xdf\x04W\xd2T\xa5V\xf0\x1e\xaf\x8a]\xc3\xd7\xe8a.\xe2\xe6\t\xfd\xd8K\xb8Ҳ\xf7\x9d\x89/\x1a\xdf\x198\xc5u\xccQ\xa4\xd6\x16;\x83\xbcSElnk)\"\xb5\xd8\uf553qV\x92<o\xf7\xa8\v\x0fu9\xb2\xb5L_\xe9rY\x94\x99`ѝ\xbel\xa1\x1a\xf8\xe0\x1c\x1bu\xcd$\xabi\xf0\x16l\x91\xbfO\xd7\xe1?\xec:\xfc-\x9e,?\xfa\xb6{\xabl\x1c~C\x83\xf7\xd6M\xae\xcd(\xe0\x14\xef\xf7˂\xb2\"\xc7#\\\x96E\x19\x81\x1f\x8b]\xd9\xe3\xe9e\xefR`\a\xc6\x19르\xf7\xea\xeb^\xb1\xea\xa5=\x81\x8a\xbd\x94\xf5\xf8\x1a\xf7\xe4ТXٲ\xa7\x97\xc3˴\x1c\x01\u07fdS4Hx\x04~\a\x06\x16\xdej\xf4\xf2\x80\x01\xa5\xaaa=\u007f\xb5\x90t\x8fH\x88\xaa\xc1\xb7?\x9b\xc0\x9fn\xa9k\xe5x\x8f\xf8\x9a\x8d.\x1b\x8b\xf1\x95\x93es3\x91c:)@\xe2>A6e\xc1\xc0\xa2\xee\x11\xe4P\x88C/\x8b\xd2\x06\xfe\xb3\xf60n\x96\xc6zo\x8c\xb9fܨ\xee\n\xc7numk\xda0\xc8Qy'~\x8bi\x1bCtwL5F4\xee\x14\xb4U\x85,\by\xf8+d\xd0vg\xcdM?Tӿ/\xef\x87[\x1f\x9b+SA1\x06\xca[\xa0+\xa4C\x80\xd6|\xba\\\xba.\x17\xe7.1\x9d\x9c\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf9W*e\xed\x19A\xd3kr\x95\xf2\xa2\x1c\xed\x18.\x9f]a\xcaae;\"uG\xcfhV\x16$\xbbOk'\xe8 \x8e\xe0~O\"X\xa9\xd0w\xff_2\xd8Q\xc6^G-vt\xb5Ci3\xfdl\x97\xe8\xed\xb3\xafb\xe0$y\a\xe8͟^\xbd|\xfbΖ\x9b\xbc\xeb\xe0W\x19ꐬө\xccX\xf9+\u007f\xe9:\xa7p*\x03v\xdfm~\x8e\xc3tQ3ޕi\xffmqIāi\xe4\xd6Mxě\xbefzJډLk\xe2\x0fԉ\x1f4\x1dм\x1e\x1a\t\xbe\x9d\x04\x13\xd6\xedLǼ͐6FMٶ\xd8\xee\xb6@\xaa\xab\xf4\xa3\xa2T\x83\xfbd~\xb5\xcb\xf372\x14M3-em\xaf)\xea\xa8\\\xf6\x1dM\xadۄ\xf9T\\\xe32Oo\x13\x9br\xba\xf1\xc1hs?\xb3\xbf#\xef\xb7f\x0f6\xe9{\xfcZ\x95hW\x8a\f\x86㊔E\x8ec\x90\x914/\xae\x00j\xe6\xde\xd0aFn1'\xc3\xcb\xe2\x83\x03+/\x1c\x89.\xb5\x92\xa3\x9eL#\xe6\x93Ѿj\x9d\xea\xdb\u009fz\xeb\xe2\x0e\xd47\xae\x0fݩ\xd6o\b_\x17;>ԝڪ\x1dVMƫ\xb7\xdf\x0f\xbas'\x89`\xf7\xf4\xf2\x04im\x05\xbaPW\xa6:\xda!\xef\x13\x87'r6\xe5@`\xdb\b<\xc9\xc8\xf5\xd3'\xe7\xe2_\xcf\xe7B[\x88ڕ\xd5p\xd1P1\xa1\xa0I\x93\x0f3\x19\xbf;\x95\x16\xc4\x06\xfc\xb5\x9cÎ\xe3\xf2\xaf$\xe3\xeb\b\"\x96\xd4+\xbf\xd1eE\xa8\x816\x12\x92O\x8b\xb6\xc5Z\x17N\xb1\x8e\x90\xdbL\xba\xa2\x13]\xcflԅ\x88\r)<\u007f\x84&c\x18\xdb\xc2P\x13\x19\xb3\x86tt\xab3iϊ\xa7iݵ\n\x03Ch4\x19\x8fQz.\xda{\xa3D鰀\xe7\xbf?0\xf4u3\\\x8e\x1fԌ\x17ۘ\f\xc0\xf6\x03h\x1e\x87~\xff\xe0\xf2-\xbf\xe8u\x97\xe3\x15\x8f\xb1ߟWa\x93\x96BL\acY\xe3\xde\xf9gkK\xb0\x8fr\xadj\xbb\x1cځ\xa8\x13\x89\x82\xd6\xce\x11\xc4u\x14\xdd\xefyD\x9bI#]\xdf3\xc1,)\x87\xcb$I\xd8\fk\xdf?\x152\x18\xdb\xf4\x8d\xf7ri\fF\x85\xf1\"\xdf5\x8eM\xe4d\xc8\xd47\x89\xef\xb6hR\xc5\xfb\xf6\r\x86,\xb8,\xd8\xe4\xb1\xeb\u007f\xf2\xf8Q\xfdK\xaa\xecN\xb2\xbd':\xe3\xfd)n\x96\xad\xeb\xc3\xc3H\x97\xebWLLS\xf8k\xac\x00K\v\xa2\xae\xad4f\xbc,\xf2\xbe \xd3D\xa6\xa32\xf9\xfb;\t\xb1/\xc8n\x8b-\xe3:P\x86\x9aNl\t2r\xddPe\x84\x8e0\xe1u*\x84\x9e%C\x94\xff̣\xfc\xfa\x867\x8c\x90\xeb}\xe1\xba^\x1c\xf1\x14\fZ\xe45\xd7o\xee\x01g\xf4_\x15\xa8\x84t\x04*!6vK\xcdލC\xf4\xa5Ψ\x16\x9b\f{\x95yq\xf6=\x10OA\xb8\xa3]zbd}\x00QH\x05\xd0\xee-@s\xa3#\x13\x10C{\x1a\x85\x00\x1b'_\x8fg~GN\x0ew\xf99\xeej\xcd\xf2\xe2\x06\xc0& \xea\xe6\xea{\x03|\x9b]\xce\xc96ǯ\xa5\xdbb\x93\xc3\xf4\x1d\xa46\xb8ń\x90\fvDVzE\xb5@눆\xc6*8\xecc\x18N\x97X\xb3\xc6\xc3IU\xa7Z5*\xb8\xbc\xb8\x8a\x80,\x04\xb0\x9a\xd2\x04;\xa9\x87\xed\xd6\x1c2V\xc1\x16T\b\xacR\xf9\xda\f\x0e\u0605\xa8\x16\xe6\x95\x00\x87\xd6\xed?\xf8\xac\x8cˡ\x0e\xfa\xc0NpNt\x13\xcfJ\x9b\x8f*\xd2Y\xc3OA}\x89\xdf-\x9eA\xe5\xe5\xf5\f\x87\xd6ōJL=\r\x06\x02<m#:\x10\xc6\\\xeb\x86\xde\xfb\x12\x9aɗ_\x92+BS!\xa3\xe8(F\x810X\xcd0`\x9e\xf9\x84aU\xa5\xb3\x1a\x8ck\x97\xe5\xeeZ\xa83{5\xfe\xc0\xcbT\xeb\x93X\x04+\xcfG\xc0\xfbx\xe4UP\xac\xedc\xf6\xbd\xe1\x94\x1a\xda\xec\xfd\xfe\xac\xf3*֧7(8Z\xc0\x1c\xbew\xa5\x81j\xed_\xday\xefz_\x10\xa9\xef]\xdcu\xefꉾ`\xcb\xe6\xcd{\x84\x939l\xaa\xc9Q\xad\xf0\b$r\x0e\x8d\x1e\xd99\"lY\xaaڑ0\x18\xe2\xef\xe0$k\xa3J\xc5\x11L\x8fZ\x97\xda);Z\x97@\xbe\x16\xdcT\xf5ǚ\xd2)k\xd1\x16\x8ai\xc7e8\xeb\xfaX{l\xa3\xb31\x8c\xc7r\x8f\xdb5\xb56\xe4ll\xd9^W\v\xf4\x1bMt\f%\xba؆2\t\xban6;\xb0\xba\x8f\\\x81L\xf6\x1d65\x0fM\xf9`\x90\n8\xeb\xa2!\rt\xaa\xd0\x04ƴ\x03\b:\a\xbf\xe4\xe7\xd0o\x82\xbbm\x1bc\x0e\xefx\xbf\x1f\xc8K^\x1d\xf7\x05o\xe8q\x89K\xbd\x1b4\xb9\x95\x8e\xd3#\xb8\xa4M\xe8U\\\x80Vx\x89@\xcd`si)\xe3\xd1\xfbc38\xe4\x0e~6\xf6j\x04\xbc\xc2\xcd-V\xd9\xf8\x1b\x86\x995\xf4\xd8Gc\xeb\x01\xde2\x95\xb7\xf7C\x8bqx\xbd3\xa1O\x1a$\u007fv\xd0Hջ\xfb5\vS\xec\xa4\xf3N\xdc0\xf2\xebjl\xb8\x99\xceyٷ\xae\xe6\xcc|\xc6Cr\xb7c3p\x80\x91n=_)\xf6㴛BH\x90m*\f\xd1\xd9\x01\x9e\xa8C`\xad/C\xf9\xccԸ\r\x83\xdeᡑ\x1bA\xaa\x18\xe6b\x88\x82\xe1\xa6Ì\x8c\x19\x1f(\x82\x01\xc57\tH\xaaQC\xfeҁ\v\x83\xb2\x99\xfb-\x02\x00\xd9X/\x9c\xf0\x1c\xa3\xa6\x8c\xb6M\xf9\x9a\xa6\x1b\xdc!\xbd\x01\x00\x8f\x05\x12\xb0\xa9\bO\x95Y\x8f\xbc)\xb6v\xb2\x19n\xaeSI\xeb\xe1\xa1\xda\\C\x18\xda&\xc7n%_\xe5bt\x15 h\xc0\xd3\xd4\r\xdc\xebᑅ\x02G\xbe\xa21\x00\xc8=o\xe2\xb7\xe5\xce\xe31\xb2G%\x1e\xb7\x92\xf6֧E\xfc\xf2\xc59\xc1\xb5k-\xa2NՄ\xd6\xfeϚG\x94\xb1&\xf9F}\xfe~\xc9\xe3\xc9\x18i\x80\xaa\x10\x025z\x8b\xaa\x069;\xe2\x068\xcfU\x9f\xdeIOy'\x95\x8b6\x89\xd1α\xfb\xf2)\xfdv\x9ca\xa4f9\xbdd\x11\xb7QQ\"8ǋ\x01\x9f\xd3E\x04\xcf\x1f\r\x89zޤA\x00\xebHR\xc1\xe7M\xda|\xde\xc4\xddϛ\xf4\xc8\xf3&\xb9\xe7\xf3\xa6tZ*\x8f>o\xeaj\xa1\x88\x04\x84\n\xe4\x12\x9c\x8c*\xfe\xcb\xf7\xed\xb2\xaf_\xff\xf5;A\x82\xfcR\x15\xa3\xa0.U\xef\x9f\xc1\xce\xdfI-\x8cW\xc3\x1d'\xf0\xd9\x1f2P\xc1\x1f]U\b\x84Ph.EO,<k\xbfI`\xd0Fk=j\xc7\x03/=큗nw\x1d\x1e\xf2\xf2\x13\xb0\tV\x05\x97\xca\x0e\x183I#\xfar\xa8*\xd6\xd9\f\xd4\xef\xc4\xedDGmR\xdf\xe5(\x893\x17/a\xa3.\x92\rbe_\xe5v\x1b\f\x15j\xaf\x8d\x15\xc9\xf3\xe3\x13\x16\xb5\x16\xda\xd3\xc9\u007f\xcbѹ\xd4g&\x03\\\f\xe4\xfb\x16@cE\x17\xd5ݯ\xf8Æ\xc2V\xa5\u007f\xcf:_\u007f\x83\xb5\xb5\x9bh\xf3E\xd8|n\xeb`\xc3\xc1\xb0\x1bݡ6l\xcdk\x92\xd9\xfc\b:\x1e\x99\xb2\xb0\x19\x18\x82H\x87{Iqmd\x80W\x94\xc8\b\xa8Q35\x84\xbbC>\xfa\xcc'\x8b\xd6\xea\x8a\xdde\x8eߐ\xcc]\xa0\xaa\xfe\xa8\x855\x13\x0fk\x1e\x05\xd0\xe6餉8\x93\x10\xe2<:\x889\xaa\xf1|\xb2h\x02\xca\xe9\xb9\x01\xb0G\x10\xa2ZK.\xc0\xa3\xf4N\xdf\x17><\x1aQU)\xe1$\xcd\xdf\xf0\xb4\xe4\xe8l\x8c\xfc\x87&\xaf\x13b\xa7\uec1b\xba\xfd\v\x9a!'\xfe\x83\xb79\x93\x03\x9b#'m\f\xeb?n\xca~D\x03\xdbA\xc0\x91\x95 \xf9\xe8w \x1a\xe2!,\xbe#\x89\x13\x99\x95@D\x9e\xf8\x8aQ1\x9fY#٘,\x8c\xc9S?\xdf/ͤOe\xb3P\x9d\xe9\"\xe9\xc2\xce)YE\x05$\xabhl\xdeh\rb*oL\x93k-u'ډs\x12<T*\xf7\xa7$!O\x93t\x96\x0e\x1b\x93\xc7ۘT6\"q\xd9\xd5ow\xb7\xe5ӄ\xcc\xcaA\xa8\xdb \xa5\xeb\xf7\xcfh\x106C\xa2\xa0\xa3ȩ\xf6\xefm5GY\xb2k\x13˵(\xe4\xc5\x16\x98pX˄\x1f\xc8c<\xcf\x16h\xd5ζs\xa0\xf66\xc1\x11\x19\xb67\x1e\xb5VѮ\x03G\xbcxI>\xe0,z\x04\xd1&\x89V\xc3%|\xb8E\xd7I4\x19\x8f\x1f\xe2h\x83V\xb0\xae\xd3x\x94[\x92M\x9a\v8\xbb\x98y\xb8\xb2\x02\xe3UrW\xd9G>qn\xfeKl\x1b\x13\x87\x04\xa2B\xa1\xd1\r\xbaM:1\r]&\xff\xfb\xbfz\x8aK1E\xd1\xdb-\xbc\x9a\xaf\x17\xc9\xf5\x00\xfc\x1b@7\x9dX(\xc3(\xf3\xdb\x1c\xcf\xd7\v8\xbc\x1e\\\"\xd6\xef\x03sPk\xef!\xd6\xef\xb3ȉ\x88\xfdYW\x8f^\x87ӛ\xe4z\x18\x11\xf6]\xfa]\xf4\x19\x9cu\x92\x8e\xf3(:e{\xce'\xe31\x8c?\x83\x83\xcb\xeaj\x0e6\x84\x0e\xc1 [$7b\x8dU;`&X\x11J\xd8Z)\x80\xb4\xce\xc4s\xe6\xc9\x03*#!k\xca\x06L\xb3\xa9s\xbe\x80\x95ک\xe7A\x13\xf3\xacL\xaf\xae\b\xbd\x02p6\xc1\x8fϿ\x18\xfb>\xe5\xa2÷d\x83\xa7Ͱ\xab\xcf\x11o\x04\xf0a,Z#\xb9c\x10\xe5!\xaa3\xcb\x15\x1f#\xab^\xc1\xd8\xfd\x99!\x81\x01[ٺ\x82h\x99\xe3\xb44\x1a\xce\\\xba\xef\x16\xe2&\xb7\u007f&a\rh\b&\x8e\x19\xaf\a\x15g\x92\xba\x8aR\aU\xde5`.\x9f#~\xbe\xf5\x1d͡cXx(\xad\x96\x9e\x8c~\xfdjwpG\xb2\x18\xa3M\xfa!nb\x17ڐF\x88%E$$=lQ\xc8\xca\xe7[\xbc!\x94U\x9c\x01K\xd3`\x10#\x87\x12o\xd2\x0f\xa0=\x13\xb7\x02\xa1 4\xab\x065\x97:s\xde}\x9a\xe2З\x17v('U`c\xb4\x9a\xc75\u007f\x81\x18\xac\x8b\x92\xfcRP._\x8c\x1d#Go\x8f\x15\xc9\n\x87\xa8t\x02\xb9\x84P:H\xd2\xe2qsO\t\x1c]\xa7y$X\xc8\xe0\x15\x17\x88e)\xd38\xb7\xe6D\x113T5E\xa5\xba\xc7ux\x8bwi\xf6ӎ\xa9\xc5D:y\v*\x933\x9dl\x87\x86\xf31\xfdF\xd7\xe0nFիi\xac\xfe\xff\xdfh\x95\xb4(k\xf7\xc5w\xfe\b\xdd㚄h\x9b\xecf\xb5r\xacV\xe0\v\xfe\xd8/\xfd\x06\xaf\x84\xf4\xbfIZJU\x99\x1aj\xbe^LM*\xb4\x1f\x93$ѫ\xe8\xf7\xa3\xe5 \xd9*\x10]\xa3\xabd9\xdc\b`_'\xe3\xa7W\xb3q|\xf5t5[\xc5W\xa80\xea\xaa\x04G\xd7h\x05Q\x9a\x9ct\x17<\xb4\r\a\x81\x13\xe3\xaa*x\x1e\xd9p\xb7m\x1e'J\xdb\xfcM*f\x91\a\xd0!\x95\xe80Ag:>\xd1MB|\xe6x\x8d\xaeQ\x06\xd1m\xa3\xfc\x91\xfe0e\xc9\xed\xd3\xe4f\xe6~\x8b\xbd\x8a:\xbb\xb9\xcf\u007f3\x94\xa2\xd2K\"l\xe7u\xe0\xc8!\x89\xe9(MZ\xf4\fy\xdccz\xfe\xa8\xb6\aM\xb4m\xa5\xbe\xd8;\xb8\xc9\x18#\x9a\x90\u007fK\x11KȐ\xa2\"a\x83\x14\t\xaaDg$\x96\f,\x1b\x94\xb3\"fGm\f\x9d\xa8o\x9e\xb1\x93>\xf8\x8e\x17\x89s\xfb\xb8\x8eK\xee\xa5Զ\x91\xa5\xa6#MX\xb4\xc1ה\x8e\\\x1a\x11aD]\xe2N\xa0\x8d\x1c'hN\xc8|\x8e,\u07ff\xc19^\xf2Vh-\xefA\u009fp\xa3ԛ\xaf5\u007f\xec\xe6.\xce&\xf2-4\xe2\x11v\x83\x0euh\\\xf6\xfb\x88\x06\tns\xed0\x0e@\xc3\b\xec\xed\xb5\xcb\t\taL\xeb\x8c\f\xdc\xd2,{[\xecd\xcc\f\xe5\x99a\xb5\xfbS\xac\xa0\"c\x87\x997\x18\x0f2\xa1/5t\nx\x87}s\x90\x1a(b\xaa\x92\x05:\xf0\xbd\x03\xa0\xea\xd50\xe1Qa\xbc\x10\xde*\xa0\"VOJpo\xc1I\x85\xc3\xc95 \xc9e\xb8\xab\xba\xb7ݶ\xab\xaf@C\x84[o\xa2\x81u\x1f\xaeҹ\xf4\x89\\\xa5\xdd\x15\u007f\x99\xbd\xf6dae\xa3\xecI\xb4\x97d\xd6i!c\x92\xdd\x0fX\x15\xacp\xebi\xceC\x8bvy\xfb\x88K\x8bse\xae[$]\b\xef\xb3\x17\f\xc6c\xe4\t\xe6\xd4a4\ns\xe8\x8f\x18\x81`Tk\xb2\x8d\x0eֳ\rNR'K\x9ed#\x91\xd1\xe66\xea\r\x9a\xf5<\x9dn\xb3\xd3\xc9\xf8aW\xbf\xa1\xfa\x83@\xfd@$3\x1cD\xe6\xc65D\x94\x8eǵ<8\xf2\x16h o_\xdf\xcc\x06\xa3\x16\x99o\u007fm;'\xb2\xc0\x13Á';ZE\x82\xd6\xd7OvJ\a4F\x98f\xf1d<V\xfc\xfe\x04y\xdc\xf3\x18\xd5,\xb3\xac\xa4\xf5\x9d\xf1\xd9\x049\x84?>\x1b#\xc3F\xc8\xf74C\x17\xc5\a\xa3\xaf\x92\x1fjTT\xefnR\xf8\x8f\x1f!#\x19Ə\xc6u\v㮯\u007f\x02D\xa8\x18応\x91\\Y+\xfe|\x1c\x8c(C\xeb\xe7\x98O\xcfw'=\xdf\xd9^\x9a\x19=[\x19\x9f\x1a\x89\x8bwy\x0eG\xab\x82\xf27\xe4\x17\x8c&c\xf8\x90W\nr!\xf0\x129\x99\xf0\xe3\x1d\xb9\xc7\xe3\x1d\xf9\x8d}\x13\xdfp\xb2|\u007f\xdb\xe1\x80O>\xc6\x01_\xf9hX\xf6D\xf6_G^Z\x00\x88\xc8}\xf3h3=I\xc3-\xe2\xc6\x13\xc6M\xcaT\x90\xe1\xac~\x9c\xf8\xcc\x0e\x99\x98\xea3\x1c7<ul\x1d8\xba)\xd3\xed+J\x9b\xa1\x99[\xbdu\xbc\xb7\x84\xe3\x93\x1f~\xa3Q\xcb\xd2!\x05CI\xbcI\xe5\xa5\xe7~^\xec(\xf7\xd9z\x19u\xe6\xc55.o\x8dA\xca\x1b\xbe[\xbe\x97\x8e\x98aW4\rKW\x05\x05\xc0Y\xe2ܡʚbF\x1d'L\xf0;7g\x8f*\x96\xfc\xa3<46\xb3\xae\xba9\xc4y`\x91g*\xfen\x99\xe6K\x1df\x97\xdaW\b\xe2\x04\x13\x92\xe6\x19%\xc3\x11\x1c\xfdT\x10*˼\xf8:\xceH!\x837\x1b\xd9\b\x80\x86\xf3\x14/\xb6\xcfԊ&q\xf8\x03\"\xedV\x97|\xa3[u\xe5'\xf7\xf2~\xc7\xe1ƈ&s\x8c\xc8\x02\xb1\xe4\xaeBE\"\xf8\x0f\x1bY)}Z\xf4\xfbt^,\xa6\x85\xa1\xaeR/\x01\xe8ns\x89\xcbZ\t+\xea\xc02\x91U\xad&6\x97\xbf\r\x04c\x00\x91\x89=\x91\xcf\xc7\v8-\x93\x9d\x97\xc6\x19\xe5\xf2\xdd\r\\\x16\x9c\x17\x1b\x90$\x89(\x18\xf1\xe2\x9b\xe2\x06\x97\xcfS\x01\xfa~?*\a\xc9\xee\x80\x0eA\xbb\xea\xc1\x8a͋ERVn\x8e\xf6ӥ>\xa59\xa1n\x02\xe3o\x04i\x12\x87U'\xac\x16\xa8\n\x06X\xab\x83\xd8kj\xce\xfa2\xa5\x92f\x99'D\xf1M>\xb9y\x16?Tr\x92\xd4cE\xc7\xd2!\xc99J\xb3\xc8\xfb\x99\x90б\"a\x9c&\x16\xa7Q\xdb\xf4]\x05{\xf4:\x1f\x0e\xd1\xe1&uTd\x9f\xfd\t\xf9\xa2)69\xf4\x05\xf9i\x9e\x8fN\x1e\"b!:#\x1a\xd0ĞP\fcU\xd8\xef\x13q\xd8w\f\x9b\x8db*\xbd<\xf4\x8e\xa8\xfb\xbd3\x9a\xb9ڱIc\xc7p\xa7G\x82\xec\xd6!]nЭ4_\x86U!\xc6\"Ǥ1\xb0`0\xb1\xa5̢#\xbc\xdfG8\xe0\xbf\x00\x91\x94\xba\xf1\xd3D\xbb\tn%\xf5\x99\xe1'\xaa@\x1d$U\xe6\xd2^\xe7)U]\xaeƚN\u007f73R\x12\x9c\xaer69\xa5\xd2\x18:\x99\xef\x0e\xd6\x13\x92\x9e\x1ff^\x93\xfd\x03\xe1\xfeM\xda\v\xefzz[\b\xeaȋ\xad\xa0\x18x\x06\x943\xe9[\xa9\xc2T\u007f\u007f\xa5\b\n\xa2n5MebY$\xa9ߔ\xcd\xc9\xc2\x1b`N\x16\x03\x807\x00\xb19^$c\xc4\xe6t\xa1\x1due<ܕI\xd1Q\u07fd\x96\xa0\x89\xaf\x83\xa3\x1c[\xa3\xb9\xa0\x8bs\xb0M3A\xd5T\xe8\xc9E\xed\xa4k\xafΦ\x81E+\x92\xa8$\xed8뉿\xf9\x10\fh\xc3k\x85\x89~\xecW\xac\xacJ\x98\x93\xb5J!\xb2B\xe9\xdd\xf2=/b'b\x90\xeb\xbc\xe0%|\xeb\xdd\xe0\xcb\xf7\x84\xbf\xb5e/h\xd6+\x1a?\xfd\x16\u07fe\xf1>\x03\xff\xe1\xc99\x14\xeeM\xeb\xe2ґǛ\x03\xa8\x82\xa8\xb8\xf2\x98\xd6\xea\xaa+b\xe6泟,\x86~z{u\x00\x14tu\x82|UC@D\x15\xa0\"!\x87p0M\xb8ѯk\f\x9c\x8a[2\x19#\xaaϫA0>\xa3\xe2H'\xe3X\xfd\x9f!\xaaP\x0e\x806'u\x10\x1dZ\xbb퇉m\"K\xaaЁ\x06\xd1aGeo\xab\xb2\xd8Ģ\xaa\u007f\x84\xc5V\x856\xc4#h\xc9=\x02\x11\xa9\x81_\xd3\x06M\xdc\xef\xcd\x1b\aN\xdaG\xa8\x935\x90o\x1c\x88$\xf78\x8d\x88&\xf6\x10\x93\xfah\xaa\xa0\xb2\xf2lN]\v߆7\xb5k#\xe6\xa2M\xe2\xd6:\xce̘\xb48\x1e+\x1b\xf0s\x95\x196\xd4CN\x8c\x87T{\xdc\x1b\xad牏0zL}IX`\xa8\x89OU\xbe\x91$i\x8f\x0et|l \x984\x96\x18\xa2\xd5\xe8 a-q\xc5\xcd@\u008c<Q\x9f(\xd3¿O\x02\x11\x06N$\xc5$D`]\x9b\xa4\xafJ\x9c\xbe\xd7`f\x1e=\x12\x12{3w\x8cS;dM\xe4X\x12\x19\xe4UE\xa4\xf6\xdf01\x83h\xd2\xf0c\xb0dDf0\b}Sd\xc5F\xb2\b\x101C\xb5\x1c\x13\xf4\x9a\xe3N\x93n\xda\x17\x17\x83\x16ޢҜ\x1dB\x1d\x9c\xd0\x145DpgQ1L(J\x87\t\x1fP\x18;\xcc}\xa8v\xbf/\xaa\x97È\x0f\x18\x14\x8dʡ\x91T\rw\x93\x14\xa8\xc9\xdc$)\"\xfd>9\xdd\x0f\xa0͌t\x13\xd0.yx\x00zm\xe2)\x00\x01=\x84\x06*\x04=\x00HMX\xfc\xe5\x1eS\xe9\xbb\xdd\xcdF\xa3\xa3ĥ\x19\xbf\xa1\xf1.e\xf4\x12>+\x1b\x10k\xf4P\xb5\xa2\xa2\x11\xf9fGo\xcatk8E\xe7\x98u\x02\xab\xa1ph\x80\xa5:UUJ\xaa\bN\x89\x9b\xd8\xd0\xf4\x1b\xcb03\xbd\xa0\x16\xc7\x04\x9fA\x1a\xb34\x9f\xa7\xa0\xa4w\xe5\x99\xfda\xc5a\xf1Þ\xbax\x82\xdcS\x16O\x90\xb9\x8elH7\xe4`Dl\xb4@\xad\xd8\xde\xcd\xc9\x01T\x8bo\xf10\xe8\xe5@\x1c\xc5\u05ff\x8c\x9at\xfa\xff>%G\x04o\xd3Kv\xd4\x15AV:\x14g\xad3_\xe2_\xbew\xd2\x1fz\x9e\t\x87\xb2*\xfe\xea\xf0\xa6uz.\x9e^\xbe%<?1\xe9aN\xe8{O!\xc9\xd3\xcb\xe7*\x03mb\x03\xab\xf1\xf4\xd2極\xe1\xd1|\xe5\xac\x17*\xcdN\xa1\x95\x8f\xcc\xcb*\x84hBL\xfai\x19V\x9c\xf8yjmJ\x8e\"\x91\xc9i\xd6)[\x8fXN\x968\x9a@\x94\xaaB\x92\xcd\xf4\xff\xe3b\x00\x86yz\x89exq\xa5\xe9*lr'm\x8f\xb5-1\xb3FNR\xd7\xe8~\xe5\xe9e\xdbT\xab\xd0%\xea}\x13g f\x88dqZAT6[oS*\x86o\x04X`\xba@N.\xc7\xd9\xe5-\x90͙r\x13n\xa5\xf8\xa6]ɷ7)_\xae\xb5\xe4\xe3\xe5\xc1\xaaw\xcdMS9%ǳB\x12\xc7\x14]v\x1c\xc8\ai\xbfE:\x10\xbb\x8d\x84vr\b\xda4\xcb\xfe\x8cou\x90\x05\x1b\xa2E\nBd\xf9\xbeYn\a\xacc\xd6\xd6\x01\xf4\x03\xc00\xaa\xbeP\xc3\xee\xe5\xdd'\xdem\xbbc\xe8\xd9\xddx\xeb8!\x86D\x87Y\b\x97\xc4\xc97\n\x91e\xa8\xfb\xe4\x1e\xcbAFB!\xa1\x9a\x99\xc8\xdc\xf3\xb6\xdfcct\xf06\xbd|.\xc1b-d*\u007f\xd9\xf5\xa6\x1e^t\xcb\xed>'\xb1\xcc!5,VCq\xd3\x00\xe9\xf6ު\x93\xa7n\x95i\x93\xbe4\xad\x04\x1c\xf85K}0\xad\xa2/ϒ\x84\xa8\xb8Y\xfaFF2˕ƍ4)\xecC\xd5.\x17\\\xe5\x9a\xe4Y\x89\xa9\x98\x16\x80ӴA\xdbH\xadQ\u0530%,*\xe0L\xa9\b\xed\xae\t&\xee5\xfd3\xbee\xb3\x88J\xcbm2Kuڬ8\x956FÉ\xa4\x85I\x92\xa4\xfa\xf8\x0e'\xb3\xd4d\xdcҕ\x06\x13\x99-(\x91\xbfT@\xba\xf4C4F\xa2\xb5h\xee\x94\vnf0Aug\x10B\x1b\xf5,|\x11z\x11-\xf4]،\xb2P\xa8Mz0\x17\xc4/\x91\xa4s\xf1\xa0\x0e\xe1\x10@\xa1\xa2#\xa3\x1a\xbdwO\x14V\xa8\x99\xbe\x8dݻ\x17\x16N\xdd\x16:/\xe10\vU˲\xd4v\xde\xfd\xb2\x81\x83\xf3\x14w\xa1\xb9\xe3\x10\xeb\xa0\xea\xb4\x1d2\xf1ȭ>81\xe5Q\x10tn\xb0\xa0\xfa\xf2\xd3I\xed+8\xd5Wl\x87\x8fT\xf7`\xa1 D*o\u007f%\xf6)\x9c\x1a\x99tM\xc74\xecJM\x16\x1cͬ\xa1\xebE\xa1\x96\xe0\x14\xed\x98\xe3\xc5\xff\x9f\xbd\xeb\xebm\xdb\x06\xe2_%҃!mrk\x17ۋTW\xc8P\f\xeb\xcb2\fy\x19\xd2bpb:\x16`[\x86E7\x01b\u007f\xf7\x81w<\xfe\xa7e\xbb\x19Ї\xbe%\x94\xcc?\xc7\x13\x8fw<\xfe~z\xae\xb1\xf1[\xb1W\x88\xc4>+6I[،\x1b4+\xb5\xdc*\x95\xbc`\x98pt3\x17\x12\xccߏ\x80\xab\x03Q\xe1+Ǭ˅NN\xd0b\xcb\xe6b\a\xc6$\xb8-\xadP\xf1m]e\x02\xdci\xddo\x9c\x1bYazYZ\xc2GUd\x9b\xe1\x91j\x8a\xfd\x8f\xe1oR~s\x91\xa6\xf9\t\x9b\xc1\xa09\xaa\xe0\xc0)\x86\xfa\xe6\xd3\u0099\xf1\xb6\xe31\xb6H\xcdF\x9d\xa0\x91\x93\xee\x03\xab\xbb\x92\x1d\x9c1!\xa4\xe6\xf9\x88\xea};q\x9d\xd5$͙\x044@\x86\xaf~\xc1\x9bPt\xc1\xcd\x12\xb2\xec\xc466\x1e4ǹ\x1b\xa3K\xf1\xda\xd5\x0e\xb8Lƅ6\x94\x00~\xa0\xbb/\x1e*Y\xc1\xa6\xbb\x1b\x02\x00FZh!\xc8r\u070eGp\xdf\xd1\x11\xfc\x91\x04\xf5}\xf8\xf6\x8d\xeb\xcf+*Ru\xe0r!\x97\b\xa2\xfao\xbf\x89B$\x04\xec\xc3\xea\xcc9\xe4\x92\xe5&W\x96\f\xe1*<\x90\t\xbb\x1b}qJov|\xc2\xe0\x1a1\xa4\x8c\x95Y\x98\xb1\x84\xd38<\xf9\xbc\x81\xf3\xb4\xbbї\x9a)\xff\xb8tL\x89\xe5\xadW\x1efz#\fJqe\x80ay\xa5\x1a\x1e\xbd\x91\xd6'd\xf8\xbd\\5\xf3-\xf6\xbc\x99\xaegl\x96\x16!h%\xb9\xa2\xe7'{\x95\xb6\x13\xe5\xc3\xf7\xa2#\xe0\x97\x17aX\xdf\x13\xf1p\ue0ba\x90\xfe\x8b?\xba\x96\xd7\tK*\x005\x03\x1en5*\xf3A\xdfЌw]\xb2\xe6J\x9f\xccǮI\x1b/\xf3:\x86\xe7\x88>\x12iW\x19{m>\xb7\u07b3PV\xaf\xff\xfet\x9dqo\x88$\x8ccI\x05\xd5\x11M\xa8\x8f\x81u\x06\x10\xae\"P\x9d\xdc\xea\xa6:o\x88I\xe0\x90\x97\xe7Bk\x99\xdf\xf2\x91\x96\xc7^ˎP\x0f\x1e\xff\x8d\xf8a\xf4H\xb7\xe7\x1bK\xf87н艾Ԝ\xa3\xc4\"0B\xcc\\\x98\u007f\xd8\xe0\xef?\xbe\xeeQx4\x1dļ\xd4\xff'\a\xe0\xdbvɛ\xcdkRz\x19\xea?c\xddö\xb9\x87 \xeb\xd1;\u009c\xbaa\xdbv\xc2]B\xf5\x8b?\xa2$\xaeG\xc6\xff2ˏ\"\xbd\xf2fs˞\x9d\f]Y\xe8\x82\xc0\xe0\x98pgK\xcb\x06[m\x96S\xee\xec:\xa8\xb4vr7\xa8\x9c\xa2\xb8\xf7\xbbf9\xbb\x95\x85*\x9b\x8e\xde\xd2\xe0kV\x16{\xfe\x86\xb3g\xb7b\xecp\x04\xf3\x1a\xfdm\xe87\x1c\xb6z\xb3R2\x8f\x12\x84J\xa4\xddU\xffS\x9a\xb3p\xbd\xec\fi\xb9\x82\x9aG\x17\xbb\x8e\xcdh*\xba\xc9\xdd\x17\xca\u007fح9\xdbN~1vM\xe8\x03ϴ\xe6\xfa\xe1lwZCK0d\x16\xc84\x824\xa5t\x18\x8bF\x1f\xfd\xae\xec\xed\xe7\xfb\x8c\xb7\x9b\xfd\x92\xcd\xf9\x1erW\xf2\xcf\xf7o\x1f\xd5\xedJ6a5\x03z\xd4Tw\xc1\x9a\xafx<ə\x1b\xd4j:\"w\xbdDsH\xfec\xd2\x05x\xcc0\x1fm\x95AR}\x94R\xa4\xb1\xef\xbb\xd3W\x15\xa3\x81\xd1~\xbd\xf0\xe3T\x19\x02\xb4\x8a\xa2f&f\x9b\x04\xb36\x93\xce61\n\x122\x87\x96\x06\x00\x94}\xc6k\xae\x92\x1e\xf2\"\xa1lO\xfbM\x15\x8c\x81\xfc\x81\xf7\xa3\xda\xf92T\\\t\x9e\x97*\xdf\xe2xeԪW\x9fub\x9fˋ\xde\xfd\xf5a\xceS_u&\xd9\v\xfe\xa0\x94\u007f\xf4\xb7\x00\x1d9\xa3\x01|\xbf<I\xaa\x1f\x86\xe3\x8b\x1a\xf7Z;S\xfa\x97\xb6\xdb7\xe83g\xad\xb7\x1b'k\xcby\x93\xf9j\xed\x1e{\x18ZZG\xd6\xf2;\x1cZ\xe1\xc6\x1e2!i #\xb6Uٵ\x82\x99\xa9\x8c\xbf\xb5\xcf\xc2\xc4\u007flVl݉1\xbao7'\xbc\xad\xed\xf6z\xa2g\xb8\xc6?M٫\"\x99\xbeLZ\xb9\xf6 \x1d**\x00\xf6\x99\x10\rP\x19\xa2\xf2\xa9\x9ay\xc60c\xf2\xc3D\xfc\xb1\x9e\xb5O\x1f\x9bU\x87e\x04N,\xe5;\x18$\xce\xe0>\xad\xfel9ܻn֏\xff\xb4;G\x1a9\x1dVY\xd3*\x93\xd3|9a\x9f\x1c\x91چ\xbfH\x1f\x98\xe8ʕԨ\"\xc8x\x14d`JF\xb9LO\x82\xb1\x95\x8d7\xdcở\x82\xbf\xa4t&H\xd9=\xe4E2\xae\x84[\xf0?\x8c*\xfd9\xe3\xfb\xbd6%'\x8f.ϫ3熘\xafqj\xab\x9cr\xe6\x14$(7\xce\xc9M\x82!:\x9fX\xb4O\x11\xee\xf7\xe9r\x99&n\xeeߢ}\xbaY\xdb\x1aԛ\x17\f\xbfq0\xb0M\u007f^\t\x1f\"\xe6:\xba\x9e\x16\x14\xb8w\xd8Z\xac\xa1\x14\xfd\\%j\xa3\xe1\x98\v\x85\x0f\x94\x87\x9e\xbc\xf8\xbb\x90\x91\xbbU\x19\x1f\xf2\x82\xfb\x946\xaa\xa2\x8e\xb7\x9b,7\xf9c\xec\xf1\xa5\xf9\x9b\xf9t\xa6\"\x14\x8a\xb3\x01\xca>\xee\xb6 a3H\x10?\x86\x12\x122\a\xab\xe7X\xb4~BX\xc5\xedt\x90\xff#\xb87;\xe0(T\xbc\xc3\x1c\xc6͎\x87\xc6\xc1m\x1f\xd1r\x10-\xeb0\x18d<bKB\x8b\xbe\xb1j\x19^@xS+\xa6\xcew\x04L/\xc0u\x01\xf2\xb8\xf8\x85\x90\xc3\xe2?Ό\xa6n\xcb8+K2v\x9cMy\x99\xfb\x8f\xf6+ۺ\xfe\x9f\u0086\x80\xf5\xc7Ry\xeb\u0094\x81W/Du\x14Ë\x98\x8c\x0f\x85\x16\xdfB4.\xd1\xdaMH\x8a%\x9b~e\xe1f\x9b\xfc\xc5B\x11\xe3\x1aE,K\xd8~\xaf\xa6~0H\xb8\x8d\x8dr\xb3ak\xb1\xc0\xc9\xcf'\x88\x9f\"ީ#\xc2P)\x1d!Y\xa0\x9a\u007fZ\xc1)\x15g.\v\x9a\xec\x14\xc8I\xe9\xe6\xa80\xbah·\xe8\xa4\xeb\x8cK\x94\xab4\xc71\x92\xdcI\xac\xf9\xc1\x8d\x9e\xbef\xbf'\xc9ȃ\\\xc1\xfe\xfe\xden\xc1\x9a\x84T\x88O7F\xbb\b\xc7\xc1ֳ\x13\xf4\xa9\xa6I*\x95\xda\x04n \xbd\x04\xf8\xfb\x10\xe0A|<:\xb8\x1e\xa4^\x01\xb7/\xaac\xca9\x16K0I\xa1\xce\x02\x1a\x05\x17\xf4\x921\\j\xc3\xcc\x16\xdde\xddP\xbb\xe3ѶBK\x16\xa9\xe8\tw)M\xb9\t\xc5q,\xf3eH\xfb$}H\x16\xc7ќ\x1b\xcd5\x83H\x8e\x19\x83\xb8Nn\x1e6㨮\xa4\x8c`\xc1\x93\x8b\xf3u$\xbef=\xb6c:\x81gĳ\xef?\x91q\x1e\xd7d\x13\xc0\xff\xa5\x11h\xe7\xf3(\x93q\xa1W;\x00װ\x8dr9\xfe\x15\x8b\f\x03\ae\xe6\xba\x00|Yv\xa0\x04\xf3\xd9u\xe0\xc5\b\x83\x98q\xaa2]L\xbb!\x14\xe3\xfe\xa9L\xbb\x95ؑ\xa9\xfa\xa0\"\x8c\xaea\x9d\xbb\x87\x05\xe0\xf2c\xc9\xedT|\xc0W\xf8\xc1\xa5\x85R\u007fa\xc2-+(~L\xce\xc5x\xa4\x98\x01\xc6\uf891x2p*\x12\xff_\x00\x00\x00\xff\xff\x89\xc0T+\x86\xa5\x01\x00",
@@ -37,6 +37,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1491149617, 0),
 		size:  1734,
 	},
+	"general/login.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfflRA\x8e\xdb0\f<;\xaf`uw\x8d\x16AO\xb6\x0f-zn\x80m\x1f\xc0Ht,T\x12\r\x89\xca\xeeB\xf0\xdf\v\xc7N\u05cb\xcdI\xe2\f\xc1!\x86S\x8a\xa1\xc1\x06\x02\xe5\xf8b\xc3\t/\xa4\xe6\xf9P\x95\"\xe4'\x87B\xa0FBC\xf1\x06\xb7\x9f\xea\x1a\xbe\xb3y\x85\xba\xee\x0fUk\xec\x15\xb4Ô:\x15\xf9Y\xf5\x87\xea\x1d\xe60^\xa8>\x82'c\xb3\xaf\xbfA\xf2\xe8\\\xfd\xe5+hvه\xb4\x01\x9a\x82P$s\x1bP\xb5\x03G\x0f\x9eddөӯ\xa7\xdf\nP\x8b\xe5Щ\xe6\xb6\xe6\xdaW\xb5\xe3\xb1\x7f\xb2\x97\x006\x800\xc8H\xf0\x83\x83Dvp\xc2@\xaem\xc6\xe3\xdaY\x8a\x1d\xe0\xf3<\xef\x97\xd3\xe8\x1cg\x01t\x14E\xf5\xa5,|c\xec\xb5/\x85\x82\x99\xe7U\xc3\xe1\x99\\\xff'Q\xbc\xd5UkÔ\x05\xe4u\xa2N\t\xbd\x88\x82\x80\x9e:\x95\x13E\x05\x98\x855\xfbɑl\xd8®\xf8\xc0:\xa7m\xf5f\x9d\xbb\xd78aJ\xcf\x1c\xcd\x03\x9di\xa3\xeeZo\xf5{=\x9dc\xa4 \xf5\x7f\xfe\x91\xda~p\xcagoE\xdd=9g\x11\x0e`0\xfe\x05z\x990\x182\n\xae\xe82uj\xb3z;R\xb3\\i\xf9\xae\x9e\x1d\xde\xde%#?\x83\xd9\xe5d\x9f\xa6\xa4\xa3\x9d$}H\xd9\xc0,k\xca\xee\xf6\xff\x1b\x00\x83jP*\x9c\x02\x00\x00",
+		hash:  "3e7e36e61febf0ed547b451a0863348ad2a01610b3caf07d842fc1e9c7e13ac4",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792361521, 0),
+		size:  668,
+	},
 	"general/scripts.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x8c\xcb;\x0e\xc3 \f\x80ὧ\xb0\xd8\x13.\x90\xe6.\x16\x0f\xd5H1\x14\x9b>\x84\xb8{\x87l\x1d\"\xc6_\xfa\xfe\xde}\x88\xc4\x01\x8c\xb8JEŌq\x03\x00\xd8\xce\x06\xa9\xeen\x92\xd8W`\x9f\xabM\xcf\x16\xeawMb\xf6͞d\xbf\xf2\xef\a\xeaB\\\x9a\xce?17\xf6\xa8\x94y=\x88'>,eBEt\x9a\x0f\xbf`\xc2\xcf\x1f\xef=\xb0\x1f\xe3\x17\x00\x00\xff\xff\xb1\x1b \xa8\r\x01\x00\x00",
 		hash:  "e084eeb01388db75a5076e1e487fc761e3b7ece8ee41f6a104896b630431b97c",
@@ -44,12 +51,19 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  269,
 	},
+	"index/charts.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffԔ\xcfj\xe30\x10\xc6\xef~\x8aA\xe7\xcd:\t\x9b=9\x82]r]ضy\x01E\x9aX\xa2\xb2\xe4jd\x97`\xfc\xee\xc5\xff\x9a\xe0^Җ@{3\xf3}\x1e\xeb\xe7o4M\xa3\xf0h\x1c\x02\x93Z\x84H\xacm\x93\x8cPF\xe3\x1d\x18\xb5\x9d\xca \xad \xda2m\x142\x9e\x00\x00d\xca\xd4S9\xf8\xe7\xb1:W\xa4\xb7U\xe1\xe8B\xed\x1dz\xc5\xefQ\xa2\x8b\xf0GFS\x9bx\xcaR\xbd\x9a\x99\xa8\x10\xd6\xf2\aQ\x94\x16\x15\x1cN\x105\x82\xf3\n\x01k\f' \x94\xde)8\xfa\xd0+VP\x84\xd5\x06\n㪈\xf4\x03\x84Sga\xfdk\t\a\xeb\xe5#\xf5\xf5\xd1\xf43K\x87\xaf\x9c\x0f\x9f*S\xf3d\xfex\x15\xac\x15!\xc7\xc5o\x18\xa1\xa1\xffyo\xd07|\xaf\x83\xafr]Vq\x82,1\x8c8Ӂ\xb2TofoJ\xe1jA\xe7X\x16\xf1\xb5\x0f\x03\x8d&\xd7q\xcb\xd6\xeb%\xe3Y:xg\r.s\xe9\x1bX\xcc\xd1)\xc6/Hg\xe0\x1fE\xbc\xab\xb0B\xd8a\x195]\x83\xf2\xd4\xf9\xe9f\x187\x8b\xf2o7R\xb07\x05Ҕ\xe5\x90#\xbd'\xc8a0\xbf\\\x88\xff\xfaK\x02\xbb*\x88n!|\x86p\xbco\xdf/\xe0\xff\x88\xe1\xaa\t.;\xe3\xad\xf9\xb2t\\\xce<i\x1at\xaam\x93\x97\x01\x00Uѣ\xd6\xc3\x05\x00\x00",
+		hash:  "bd416d651cbbc158278f037ac01faf9d7dabf653a7d5a169aa3c5b80e9b8a56a",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792361743, 0),
+		size:  1475,
+	},
 	"index/controlPanelScripts.html": {
-		data:  "{{define \"controlPanelScripts\"}}\n\t<script src=\"js/controlPanel.js\"></script>\n{{end}}",
-		hash:  "5fc312858cfbfba3160a20303376141a6fcfb759d1291c25dcb27fe9aecffae0",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xaa\xaeNIM\xcb\xccKUPJ\xce\xcf+)\xca\xcf\tH\xccK\xcd\tN.\xca,()V\xaa\xad\xe5\xe2\xb4)\x06s\x14\x8a\x8b\x92m\x95\xb2\x8a\xf5\x91\xd5\xe9e\x15+\xd9\xd9\xe8CT\xd8aQ\x9b\x91XTR\x8c\xa6\xaa\xba:5/\xa5\xb6\x160\x00\x81\x11\xf9Xz\x00\x00\x00",
+		hash:  "f7be47ed484dec36bb1f064ae6aa953b958ce2b991e22752ee5bd102e838f97c",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  0,
+		mtime: time.Unix(1792361744, 0),
+		size:  122,
 	},
 	"index/datadump.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecW]o\xda0\x14}\x0e\xbf\xc2\U000decc8~\xbc9\x96\xa6\xeee\xd2ئ\xb2?`\xe2\v\xb1\xe6ؑ\xed@\x11\xe2\xbfOv\x12\b\x19\xedhJ5\xb4\x95\x97\\\xf9枋\xcf9\xfe\xc8f\xc3a.\x14 ̙c\xbc*J\xbcݎ\x88\x85\xcc\t\xad\x90\xe0iH|\xf2\t\x94Ifm\x8as\xc1\x01\xd3\x11B\b\x11.\x96\xed\xb0\xd1+LGQ\u007f8Ӳ*\x94mS!\x9d\x8f\xe9\x0f0\x85PL\"\x0f\x8fDQj\xe3H\x92\x8f\xe9(\x8a\"Rɶܱ\x99\xc5\xe1\xa5؇\xe1\x1f\xc1\x03+J\ta\x00\x87\x82\x88Hѭ\x88\x9dp\x12\x90\xb01˜X\x02\xa6\x84\xa1\xdc\xc0<\xc5\xef\xfd$\xc7\x181#XlAB性ؙ\n0\x9dVE\xc1̚$\x8c\x92D\x8a'\xb0\xfb\x88W\x98~7:\x03k\xd1\x17a\xdd\x00\x84k\x8f \x94\x9b\xb0r@\xf5\r\xa6S0K0v@\xf1-\xa6wZ\xa9Z\xf4C\x00\x92T\xb2\x0e:\x9a\x06\xa4L+\a\xcau\xc4i\x87\x8e+ԯ/\x99\x02ّ\xa86[\x10\xa7\xae\xe8\xdb\x00\xf9d|\xa2!\xa2\x88\xbc\x8b\xe3\xce\xec\xdbbt\x8a?\xa6\xb96\xee1\x8f\xe4\xc1\xaa\rGq\xbc\xeb7\xb0\xd7=[az\xcfV\x87\xba\xed\x89o\xa8k\xd9\t$3\xa1\xc0\xecg*\x8aE\xc8\xcf+)mf\x00T\xacK\xaf\xe5nͲ\x99ղr\x10\x1fyŚ,ŢX$\xfb\xdc\a\xbb\\`J\x12Q,|\x13\xd4\xf9ռ:xp\xcc\x00C\\X6\x93\xc0\x91-A\xca,\x87\xecg\x8a\xe7LZ\xc0\xa7\xa9]SMI\xd2Bv)=_\x9b\xc0r\xa7IK2\x17\xcbƟ\x9d\xf0\xa8U\xf7XW\xddͬ\xe6\xe4eN}\x81s\x8e{\xf4\xb2\xedt\xc1\xd2^\xbfI\xfb\xafJ{s\xa6ce\xa0\xa2\x1f+\x97?\"\xa9Oi#\x9c\x80\xde\xe1\xfd\xa7n\xfd\x1e\x9f\xb9?\x90ix\x9c\x01n\xb2\xfe\xaa\xfdUo\xb2F>\xb8\\\xdb\x1d,\xd8s\xfa/\x88\xf6\xbb\x01\x87\xb6\xd8\x037J\xbd\x02r+ڙV\xcd\xed\xdf]5wZ=g+|\xa6\xc1\xa7\xe1\xea\xf1\xcd\xe5`\xfeCs7ܾ\x86\t\xa7\xfd;\xdd\x13\x16\xdcEm\xd0<I\xd2|\x85\xd2\xd1f\x03\x8ao\xb7\xbf\x02\x00\x00\xff\xff\xfd{\xba0\xad\x0e\x00\x00",
@@ -59,18 +73,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  3757,
 	},
 	"index/index.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfft\x8fA\xaa\x021\f\x86\xd7\uf762v_O .\x04\xf7\x03z\x81\xd0d\xb4\xd0&\xa5͈C\xe9݅q!:\xcc6\xf9\xbe\xe4\xff[C\x1a\x03\x93\xb1\x81\x91\x9e\x03\xdc\xc8\xf6\xfe\xffךR\xca\x11\x94\x8c\xbd\x13 \x95e|\xd89gN\x82\xb3q\xee\xf8M->\xc3c\xa5G\xf1\x10\xaf\x92\xad\xd9\xff\xae\xb4\x00W\xf0\x1a\x84\xeb\x94\x12\x94ye#(\xe0\x94\xf2\xe7\xfd\x99q#B\xf5%d\xad\xab\x1b^X\x8b\xc4\x01\x98\xe2e\x83\x19E\xf4]\xb25b\xec\xfd\x15\x00\x00\xff\xff)\xb2x\xeb\x1a\x01\x00\x00",
-		hash:  "cc3a3da9da68933167db530679efab736e844d7d525da9b7f55fe484cfc74a3f",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfft\x8f\xc1j\xc30\f\x86\xcf\xdbSx\xbe{O0v\x18\xec\x1e\xd8^@XJc\xb0%c+\xa5\xc1\xf8\xdd\vi\xa1\xa4i\xae\xfa?\xfd\xfa\xd4\x1a\xd2\x18\x98\x8c\r\x8ct\x19\xe0D\xb6\xf7\xf7\xb7֔R\x8e\xa0d\xecD\x80T\xd6\xf1ׇs\xe6Gp1\xce}o\xa9u\x9f\xe1l\xcd\xe7sA\x14\x0f\xf1_\xf2\x8bH\vp\x05\xafA\xb8\xce)AYv\xe7\x11\x14pNy\x17\xf8\t\x8aև\xd7/\xe3\x81[\xf5%\xe4;\xbai\x10\xd6\"q\x00\xa6\xf8w\xc0\x8c\"z\xfb\xbe5b\xec\xfd:\x00\x85P\x95\xd63\x01\x00\x00",
+		hash:  "2b0e5d7f50effffddee396017cfe87646165347e3aa0f489fb8f3cbed006cf6c",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  282,
+		mtime: time.Unix(1792361744, 0),
+		size:  307,
 	},
 	"index/indexnav.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x84\x8e\xb1j\xc40\f\x86\xf7<\x85\xd0n2ui\x1dO]:\\)\xf4\t\x94Xw\b\x14;\xd8J\x9a\x12\xf2\xee\xe5B\x0fz]nѠ\x9f\xff\xfb\xfem\x83\xc8gI\f()\xf2\x9ah\xc1}o|\x94\x05\x06\xa5Z;,\xf9\v\xa1ڷr\x87#\x95\x8b$\xd7g\xb3<>\xc3Ӵ\xbe`h\x00\x00\xfc\xac\xb7\x82Q_\xe1zܐ\x93\x95\xacn\xa2Ċ\x10\xc9\xc8\x1d\xa9\xc4\x0ey\xa5qR>\x1e\xbf\x90\x03\xa4\xf2\x17\xe4LL\x19\xa4:\x1aL\x16ƣ{\xdb\xeaF\x92\x84\xc1\x13P\x11r\x95\x95\a\xe3ء\x95\x991\x9cH\x12|\x1a\xd9\\\xe1\x83.\xec[\n\xbeUyd3\xea\xffo\xbf\xb7\xe6\xc2Wk8\xe5\xc2\xf0\xcaF\xa2\x1c\xe1=G\x86\xb7t\xcee$\x93\x9c\xeeu\xbe\x9d54\xbe\x8d\xb2\x84f\xdb8\xc5}\xff\t\x00\x00\xff\xff\xa7\xffC\x19\u007f\x01\x00\x00",
-		hash:  "dbe5cd4fb16f7f214469c016e8613fc72fd7f0bba689d5a13a5a99fac9615dc8",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xac\x91\xcfj\xe40\f\x87\xefy\n\xe1\xbb7\xa7\xbd\xcc&\xbe\xec^\x16:\xa5P\xfa\x00\x9aX\xc9\b\x1ck\xb0\x95t\x8aɻ\x97I'\xa5\xff\xa0\x97\xfa`\x8c\x85\xbeO\xe8W\nx\xea9\x12\x18\x8e\x9e\xce\x11g\xb3,U\xe3y\x86.`έI\xf2h \xebS\xa0\u058c\x98\x06\x8e\xf6 \xaa2\xee\xe0\xf7\xe9\xfcǸ\n\x00\xa0\x99\xc2֠x\xc8p\xb9l'Q\x93\x04{\xc2H\xc1\x80GE\xbbVٷ\x86\xce8\x9e\x02\xad\x1fW\xc8\xe54\x81߂\xac\xb2\x06\x02\xce\x16;\xe5\x99\xccڻ\xcdjG\xe4h\\\x83\x80\x89\xd1f\n\xd4)\xf9\xd6h\x9aȸ=r\x84{E\x9d2\xdc\xe1@M\x8d\xae\xa9\x03\x7fgS<|\x9c\xfd\xbdU\x12]\xacn/\x89\xe0\x1f)r \x0f\xb7\xe2\t\xfe\xc7^҈\xca\x12\x7fJ\xd7\x1d1i^\x85\x7f\xd7\xe7gp)\xdcï\x87LiY\xbe\x94\xbcF\xd8\aA\xdd%\x1e\x8e\xba.\ue628oM\x1dd\x90I\x8d\xbb\x91\x01dR(e\xa3m\xaeR(\xfaeyI\xbb\x9e\x82\xab\x9a\xda\xf3\xec\xaak\xe1y\x00t\x8e\t\x14K\x02\x00\x00",
+		hash:  "0e972c62b9c0ec778e157105d5a4542d97c6a16dee6dd30f5c1debb81a53e276",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  383,
+		mtime: time.Unix(1792361744, 0),
+		size:  587,
 	},
 	"index/localTop.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcX_\x8f\x1a7\x10\u007fϧp,U:\xa4:\v\xe9\xa9=\x91]Kw\x17\xe5Z\xa9\x89\xa2\x12U\xea\xa3Y\x0f`e\xd7\xdeڳ\x1c\b\xf1\xdd+{w\xe1\x8e\x00\xbbp!\x0f\xbd\x97\x03{\xe673\xbf\xf9g\xb1ZI\x98(\r\x84f&\x15\xd9\x17S\xd0\xf5\xfa\x15\xa9\xffb\a)*\xa3\x89\x92I%@\xf9\xe62\bH5'i&\x9cK\xa85\x8f;\xb7\xbb\x12\xa9\xc9\xca\\\xbb=R\x95\xb1\\d\x19\x8f]!*\x83\x0e\xec\x1c,s(\xb0t\x94Ǒ\xbf\xf1\xff\x82\xdc~\x8cـ8\\f\x90\xd0G%q6\x1c\xf4\xfb?\xbd\xa3\xfc\x1fSZ\xf2\xc9Hh\xac\xccW\xab7\u007f\x83u\xca\xe8\xf5\xba\x81\xac\xee\x1a\x80If\x04\x0e\xad\x9a\xce\xf0\x1d\xe5\x0f\n\xc9]\xa929$\xab՛\a\x85\xe1\xcb\x13\xddh6\xe0d\xbfS\xaf\x19\xab\xed\x12\xa3\xd3L\xa5_\x13\xaaa\x81ޡ\xab\x1e\xe5\xb1\xe0\x9f`\x81\xc1\xc18\x12\x9b\x10\u007fn\xbc\xbd/\xad\x05\x8d\xc3-7iu´\x91\xc0t\x99\x8f\xc1R\xdeߡ\x880\xb6'!\x91T\xf3\x9d,\xee9:)\xb1\x99\xb0S`\xbf\x90\x1c\xa4*svM\x82}6xKZR\xfe\x04#\a\xb4*= \x18\x8431\x86\x8cL\x8cM\xa8\x0f\xfbw\xf0\xa9\xa9s{\x97\x99\xf4+\xa9\x8e\x86q\x14D\x8f@)]\x94HpY@B\x11\x16H\x03\xa9OP\x89\x169<?\x91ʉq\x062\xa1hK\xa0d.\xb2\x12\x12\xca\x0e\xc5\xf6-\xa9/\x0e\xdb-u\xfaAY\x87\x94\x87b\x1e-uJF\xa1?\xc8\xd5\xc0!)\x84s\xbd\x0e\xf1{\aB\x8bm\x00\x1b\u007f\nk\xa6\x16\x9c\xa3\xc4\x1a\xdf\x05\xcd\xf7\xb1\xb0\x94\xa0\x18+-a\x91\xd0>%\xc2*\xc1\x02\t\xda<&\xf4\xed\xb3\xa3\\\xe9\x1d!OsB\a\xfd>)\xc0\xa6\xa0\xf1\x99\xb8X\x84\xbb#<T#\xc2\xd7\xff\x8e\xa7,\a\x04K\x9f\xf7=\xf1\x8d߂\x16\x10\x8b\xc0\x03\x82C\xa5\xa7t?6\v%\xc2=d\xa0\x1c$\xb9\xea\x133!\xfd^\x1c\x15-.W-y8\x15G\xca\xe4B\x154\x82\xd4h\xb9\xaf\x84\xdejyV\tՈ?\xa8\x86n\xae\u007fL\t\xdd\\w\xac\xa0\xffeѴ.\x80C\xd2\xd5\xec\xff\xb5e\xf4\x1f,а\xf4' SSj\xa4\xfc\x03H\xb0\x02A\xb6Vd\xcbp\xdf\x01\xae\a\xfc\xee\xe9\x89C\xbe\x03뗢H\x94\rE\xb7\xa5T\xf8}\xe8ـ\xd6\xf4\\\x8a\x90\xd3\v\xf8\xd0\xf17\xaf\x90\x9b\xe6\x15\xf2\xdb\xc5_!\x05\x80\xfdS\xf9m\xbc}\x98\xa1A\x91}\x06\xb0\xf7Ur\xeaV&\xf7F\xeb\xea1\xed:\fW\xf4\x9c\a\xbc\xad\x8d\xe3|\xe3\f\x84\xec\x90}\xb4\xedB5\xe0\xc6>S\x05\xe5\u007f|&\xb1ʧ$\fG?i7\xe3\xde\x19\xeb\x97'\xf3\xb73%\x81>Ud\xfe\xd6_Q\x12\xf18\xc2Yg\xf3\xbc\xdaJ\xa7\xe9\x9c$\xbd\xf5S\x96V\xf8\xdcP\xfe\xbe\xfetF\xb0\rȹ!\xbfḟ\xd0x\x104\xf7\xbd\xe0[\xa3q~5\xf2\x11h<#\n\xaf|~Ҷ8\x16RPs\x90\x94\xffU\u007f:Ù\x06\xe4\x05Ut[5]7\xa58j\xeb\x0f\x8f\xd3\xdai1\x8e\x8d\\\xb6\x02u\x10\u00891\xf8]\xdbZ\U0008f8c7\xd1\xd5\xfb\xdb/\xb7\xbd8B\xd9]\xef$\xe9M\x0e\xff-E\xa6pI\xf9\xa5\x8d\x95\x05\xe5}\xff\xc6\xfax\xd7;][\x9aG}\xa6~G_;\xd5\xd6\xf1t\xc7QX\f/]\x9c;GqT\xff\xcc\xc3_\xadV\xa0\xe5z\xfd_\x00\x00\x00\xff\xff\xa3\xd5h\x9a\x16\x12\x00\x00",
@@ -86,6 +100,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  2945,
 	},
+	"searchresults/addresshistory.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4\x93\xc1\x8e\xda0\x10\x86\xcf\xe1)F\x11\xe2\xb4K\x0e{\xab\x8c\xab\xadV\x15\xbdЪ\xda\x170x VC\x1c\xd9C\x17\xe4λW\xce@\x02Un=9\xf3O2\xfe\xe6\x9fIJ\x16\xf7\xaeE(\x8d\xb5\x01c\xac]$\x1f.%\xf3\xacP\xf5\x8b^K\f*\x1eM\xd3\xe8/\x8d\xdf\xfd\x8a\x90\xd2\xf2k\xf0Gf \x9f\x83w\xcf\xfc\x04-~`$ػ\x10IU\xf2\x81\xaa\xea\x17=+T\aήJ<w\x8d\x0f\x18\x9e;s\xc0X\xeaY\x91\x92\xdb\xc3rm⏀\xbf\x9d?Efe\xa0\x0e\xb8_\x95\x11M\xd8՟\xe9\xd2\xe1*_r\xe9\x90y\xe1\xda\xeeD9~\x15b\xe6E\xae\x96\x95\xb1F\xa97\xf8\x81\x01\x84WUF\xa7\x84MD\xe6{=%lm\xee\xf4\xcf\x1d\xc8\x06\xcf\xf4?\x10\xf2}\xa9\xbf7v\x12\xe0^\x1f\x00TՍf\x88\xe5\xbdLf\xdb`o\x9dp<\xf7B6\xaePT\xa3\xb1\xf9\xa9P\x14\xfa3k2\"X\xa3;Ԥ*\xaa\xc7Lf\x7fT^\x8f\xfe\xd4\xfe\xfbV0m4;r\xbe\x1d\x12\xaa\x92\x1bT5\\\xaah\xeb\xed%?\xa5\x14L{@\x98\xbb'\x98\x13|Z=\xb4\xf0@g\xf5\xa4\xafv\x9b\x99\aS\xe7\xb4|\xeb\xbb\xc86\xf6\xa1tÜ}T\x15ٱ^\x9f\x96\xa1L$\xa4\xbf\xc9\xd4\xfb\xf9\xdb\x1b\xf3m\xaf'\xb1\x02\xee\xd0u\xb4\xb8\x9eB\xf6S\x82\xbb\x1d\x18\x8a\x95\xfa\x9a\x14\xcc\xdb\x0f@\xf6\xc1\xc3a\xe6Y\xb9z\xa8\xaa~\xae\xfd\nȖ\xe4_Fo<\xd08\x8d\b\xae\x05\xaa1\"l\xaf[%K#\xf5n\xe7\xdf\x01\x00\xcb\xddmh\xd3\x03\x00\x00",
+		hash:  "ce41bcd1a7706836d92dfef0d8e7721e36850429e9f16d52e16e2412f8bb94dd",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792361128, 0),
+		size:  979,
+	},
 	"searchresults/tools.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffD\xcbA\n\xc3 \x10\x05\xd0u<\x85\xcc\x01\xe2\x05Ի\x94\xc9\x0f\x9a\x8a)\xf3\xdd\x14\xf1\xee\x85v\xd1\xfd{s\x1e8k\x87\x97qߍ\xb2\x96\xdb\"\xd5\xeakx\x9a&\xb9\x18\x88\x87i\x01×\xec\x17%\xc7\xf03\xd9m\xb1\xd5\xfe\U00106584\xe3\xdd\xc0\x02\f\xf1\xc5p&Q\xfe\xfb\xae\xa4d7'\xfa\xb1\xd6'\x00\x00\xff\xff\x01\xea%yy\x00\x00\x00",
 		hash:  "a98871ca472c8a4b10c9916ad166c30071ff142d7e9e52290254dd7f836e036a",
@@ -94,18 +115,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  121,
 	},
 	"searchresults/type/EC.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4\x93An\xc20\x10E\xd7\xce)\xdc\xec\x83Ŷ\x1a\"\x01\xe5\x06\xbd\x80\xc9\f\u0092\xb1#{\xa0\x8d,\u07fd\"\xb8*U\x03EmV\xd1\xfc\xf9\xf6\x93\xf5\u007fJH;\xe3H֛u\x9ds%Rb:\xf4V3\xc9zO\x1a)\x8ccxj\x1a\xb9\xf28Ȧi+\x01\x91:6\xdeI\x83\x8b\x9a\xde{\xeb\x03\x85\xba\xad\x84\x004'\xd9Y\x1d\xe3\xa2\x0e\xfem\x9c}\x1bv\xde\x1e\x0f.^\x04\x01\xfby\xbbD\f\x14\xa3|ѬA\xed\xe7m%'>`\xbd\xb54\xad\t\xe0\xad\xc7aZ\xbc>\"\xfc\xb6R\xf6\xf0\x13\xea\x19\x14\xe3æ\x94fŗ\xf3#FP\xb7\x88\x84\x80ے\x18\x01\x0f\xfe\xe8X.O\xda\xd8\xf3\xcb\xdc!-\x8e\x94f+m\xb5\xeb(g\xb9q\x1c\x06\xb9\x0e\x84\x86\xe3=\xeb\u007f\x18_\x87\xfe\x01\xaek\x94?\x92\x80\xba\x13\x00P%:\xe7\xfb\x14\x9aӘ\xd3\xf2\x03\xaaD\xb9-!\xdf8\xfc\n\xfa迮D\xec\x82\xe99\x9e;\xf1Cc\xef\xed\xb4\xb2\xf3\x9e/EJ\x89\x1c\xe6\xfc\x11\x00\x00\xff\xff\x9d\x93a\x14w\x03\x00\x00",
-		hash:  "5decf7ae2b1cc77506b4c190c36b0a297816c2dbbf15f1cfd7c93b2ad5c606f9",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4\x93Qn\xea0\x10E\xbf\x9dU\xf8\xf9?D\xfc>\r\x91\x80\xc7\x0e\xde\x06LfP,\x19;\xb2\a\xda\xc8\xf2ޫ@*\xa8\b)j\xf3\x15\xcd\xf5\x9d\x1c\xc5\xf7\xa6\x84t0\x8e\xa4\xdamU΅H\x89\xe9\xd8Y\xcd$UK\x1a)\\\xc6\xf0\xa7,\xe5\xc6c/˲.\x04Dj\xd8x'\r\xae\x14\xbdw\xd6\a\n\xaa.\x84\x004g\xd9X\x1d\xe3J\x05\xffv\x99}\x196ޞ\x8e.^\x05\x01\xed\xb2^#\x06\x8aQ\xfeӬ\xa1j\x97u!'\x1e`\xbd\xb74\xad\t\xe0\xbd\xc7~Z\xbc_\x11\xbe;2\x9e\xc3O\xa8\xbfP1\xbelJi1\xfar~\xc5\b\xd53\"!\xe0\xb9$.\x80G\x7fr,\xd7gm\xec\xf0gfHGGJ\x8b\x8d\xb6\xda5\x94\xb3\xdc9\x0e\xbd\xdc\x06B\xc3q\xce\xfa\x1b\xc6\xff}\xf7\x02\xd7=\xca\x0fI\xa0\x9a\t\x00T3ѹϻ\xbe\xde]k\"\xfb\xd0+\xb9\x18\x92?|\x17\u0379.n/P\x8d\xf1\xaf\xc7b\xec\x1c\xde\xca\xf1\xb066\xc1t\x1c\x87\x1e=h콝V\x0e\xde\xf3\xb5|)\x91Ü?\x06\x00\x9e\xfd\xc8v\xab\x03\x00\x00",
+		hash:  "fa660a8e5280a7b21d18acf791ef8f91cd1ff3dc90aceee5be3fcf0aca52573d",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  887,
+		mtime: time.Unix(1792361120, 0),
+		size:  939,
 	},
 	"searchresults/type/FA.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4S\xe1j21\x10\xfc\x9d{\x8a|\xf7\xff\f\xfe\xfdX\x0f\x94\xd6'\xe8\v\xc4슁\x98\x1c\xc9j+!\xef^<S\xb8R\xb5\xb6ͯ\xb0\xb3\xb3\f\xc3L\xceH[\xebI\xb6\xebe[J#rf\xda\x0fN3\xc9vG\x1a)\x8ec\xf8\xd7ur\x15\xf0$\xbb\xaeo\x04$2l\x83\x97\x16\x17-\xbd\r.D\x8am\xdf\b\x01h\x8f\xd28\x9dҢ\x8d\xe1u\x9c}\x1a\x9a\xe0\x0e{\x9f.\x80\x80ݼ_\"FJI>i֠v\xf3\xbe\x91W\x1e\xb0\xde8\xba\x8e\t\xe0M\xc0\xd3upz\"~\xb7R\xf7\xf0C\xd4\u007fP\x8c\x0f\x93r\x9eU^)\x8f\x10A\xddR$\x04܆\xc4(p\x1f\x0e\x9e\xe5\xf2\xa8\xad;;sGie\xe4<[i\xa7\xbd\xa1R\xe4Z\x1b\x0e\x16\xd3=\xd6my\xbf\xf3\xf4\xe54\xd0\xcf\f\xad*\xff\xe8%\xa8;\xf1\x00U\x83uvI\xa1=\x8e)\xae\x1fP5\xe8}\xad\xc0\xb3\xc7I\r\xa6eI&ځӹ-\xe3\xdd)\xc6!\xb8\xf4\xa5^\xdb\x10\xf8R\xaf\x9c\xc9c)\xef\x01\x00\x00\xff\xff\xfc\xee\x95g\x8d\x03\x00\x00",
-		hash:  "e5451723f5b66ce13c8bf4d3804aa8c178a63b67b7caa5aa11b5731a35a5cb01",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4\x93An\xf20\x10\x85\xd7\xce)\xfc{\x1f\"\xb6\xbfL$P\xcb\tz\x01\x93\x19\x14K\xc6\x13\xd9\x03md\xf9\xeeU US\x01)m\xb3\x8a\xe6\xf99O/\xf3\xa5\x04\xb8\xb7\x1e\xa5ڮU΅H\x89\xf1\xd09\xc3(U\x8b\x060\x9c\xc7\xfa_Y\xca\rA/˲.\x84\x8eذ%/-\xac\x14\xbeu\x8e\x02\x06U\x17Bh\xb0'\xd98\x13\xe3J\x05z=Ͼ\f\x1brǃ\x8f\x17A\xe8vY\xaf\x01\x02\xc6(\x9f\f\x1b]\xb5˺\x907\x1e\xcdf\xe7\xf0\xb6&4\xef\b\xfa\xdb\xe2\xf4\x8a\xf0ݑ\xf1\x1c|\x84\xfa\xaf+\x86\x87M)-F_Ώ\x18uu/\x91\x10\xfa\xbe$\xce\x01\x0ft\xf4,\xd7'c\xdd\xd0\xccL\xd2ё\xd2bc\x9c\xf1\r\xe6,\xb7\xa6a\xb2\x10\xe7\\\xf7\xe3\xfd\xaeӗ\xbeß\x15:\xa6\xfcc\x97\xba\x9aY\x0f]\xcd,֔\x06s\xf9\xb3\xad\x8dL\xa1Wr1p1\x94\x04\xf6T\x17\x9f/\xba\x1a\xe1\xa8Gl\x9e=LЙ^\x19\x9b`;\x8e\x03aW\x9fc\"\x17\xaf\x90\xdc\x13\xf1\x05ɔ\xd0C\xce\xef\x03\x00g\xa5\xcb\xfa\xc1\x03\x00\x00",
+		hash:  "920aa42fb4707b28bd26cb05fcef99d4c3b5970c4bbba1ee2fbb95ba85e689f1",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  909,
+		mtime: time.Unix(1792361120, 0),
+		size:  961,
 	},
 	"searchresults/type/ablock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcU\xdfj\xdb>\x14\xbeV\x9e\xe2\xfcD/\u007f\x8e)\xbd+\x8a\xa1i\v\x19ll\x8c\xbd\x80b\x9d\xc4\"\x8ad$%\x9b\x11~\xf7!Y-\xde\x12\xa7\xe9.\xaa+s\xce\xf7\xc9\xdf\xf9\xa7\x13\x82\xc0\x8d\xd4\b\x94\xaf\x95\xa9w\xb4\xefg$\x04\x8f\xfbVq\x8f@\x1b\xe4\x02m2\xb3\xff\x8a\x02\x96FtP\x14Ռ\x00sX{i4H\xb1\xa0\xf8\xabUƢ\xa5\xd5\f\xf2aB\x1e\xa1Vܹ\x05\xb5\xe6\xe7\xc8\xf3\xb7\xb76\xea\xb0\u05ceV\xf0\a$\xc1\x9a\xdb\xeaA쥆e\xd4\xc7\xca\xe6\xb6:\x05y\xbeVxj\x1f|k#\xba\xf3\xbe\xc1o\xa7\x9d\x03@T\x9f\x8d\xd9\x1dZXq\xd7ܳҋ\xb7\x19!\xcc\aR\xe4\xf4\xfde\x12+/\x89\xb8J\xe1\x92\xd7;\xf8\x8e\x1b\xb4\xa8k|\xa7\xd2H~\xe5~\x90\xe0\x15\xcam\xe3\xaf\u05f8J\x8d8\u007fZ\x0e\xc4\x0fP\xf8\xcd\xe2Q\x9a\x83\x83Q\xff]\xa9\xf7\" \x81x\x9a\x9a\r\xaf\xbd\xd9\x17\x0e\xb9\xad\x9bBI\xbd\xa3\xe0\xbb\x16\x17/\xe38\x8a<\xcaɅz)\x11\u007fC\xc9?f\x88\x95\x133\xc3ʉAc\xcd]\xf5\xac\xbd\x95\xe8\xe0\xd1hϥF\x01R\x8fS\a\xcc\xed\xb9R\xa3\x88\xbe\xa0s|\x8b\x8f\xe6\xa0}\xdfC\xbe\x80\x95\x03\x8e\x95\xcdݙ\a!\x04\xcb\xf5\x16\xe1F\xfe\x0f7\xa8\x10\xee\x170\u007fX>I\xd7*\xde\xf5\xfd+\x81\x90\xfc.\xa4L\xe7\x14'\x03=\r\x80L=\x13\x84\x9c\xed\x15\x12\xed\"\xc5\xdc\xc1\x8f\xaeŉ\xc6ȸ\x10\xa2\xd4y\x04N5.!\xe7\vBȴ&2\x9c\xf8\x87\x98u\xd4\xd7\xcfS\xd2\xf3\xd57h?\xe9\x8dy\xb7\xa8\xeb[\x84\x90\x10P\x8bQa\x12J\xc8c5\x8b\xd7\x0f\x1f\xac\xccˤ\xca{\xe6Y\x8bѮ\x19o$W[\xd9zG\xf3\x8dc\x977F\xb9\x93\x15\xb61\xc6\x0f+,+\xf9\x1d\x00\x00\xff\xff)\a>\xed\xf5\x06\x00\x00",
@@ -115,18 +136,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  1781,
 	},
 	"searchresults/type/chainhead.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecV\xcdn\xe20\x10>\x87\xa7\x98\xb5\xd0jW\xda4\xdbkj,\xb5\x80T\xa4\xbd\xf5\tL<4\x16\xc6\xceƦm\x14\xe5\xddWN\x1c\x9a\xa6@iϛ\x03?3\x93o\xbe\xf9\xb3\xa7\xae\x05n\xa4F YΥΑ\v\xd24\x93\xa8\xae\x1d\xee\n\xc5\x1d\x02\xf1B,[1\xfd\x16\xc7pgD\x05q\xcc&\x11P\x8b\x99\x93F\x83\x143\x82/\x852%\x96\x84M <T\xc8'\xc8\x14\xb7vFJ\xf3<Ќ\xb5\x99Q\xfb\x9d\xb6#\x8b\xba.\xb9~D\x98\xca_0E\x85\x90\xce\xe0\xaaiZ\x9b(\x8a\xeaz\xba\xf5\"\xfc\vS\t\xbf\x87\n\xb9\x81\xe9\xf6U\x10Q\xbb\xe3J\xb1y\x1fe\n\x94\xb7\xac7<sf\x17[\xe4e\x96\xc7J\xea-\x01W\x158#\xb8V&\xdb\x12V\xd7\xde\xf5\xd5\xdch\x87\xda]\xdd#\x17MC\x13\xcehҁ\x1e(GQ\x044\xbf\xee\xbc@p\xb9Z\xa4?\x02\xc4J\x17{\xd74?\xfb\x17\xa9-\xb8\x06\xeb*\x853\xb2Q\x86\xbb\xb4\x94\x8f\xb9#\fFN\xff\xa0~ty\xd3\xc0R\xbbR\xa2\xa5\x89\u007f\x95\xd1$\xbf\x1e\xba\xafkT\x16C\u061d\b\xa8\xe3k\x85m\xac!\xc8V0\xcatkL\xddڈ\xea\x88\"\xa2\xae<&\x8e\xa8\x13\xccS\xaa\xe0\x9e\xdb<\xa5\x89\x13'\xed>N\xb8\a:\xe4;$\xabK\xf4qX\x9a\x1c\xa7u\x96\xed\x8b\xc3Rs\x05\xab\x85=\xcfw\x12\x85\x87\xee\xd5\xe1Oו\x10\xdar\xb5\xf0\xed\xf7\xa6R\xcb\x17\xb7ZX\xf0\xd3\xf2\xfaF\xdb\xedJvc⣌1Ј\xa5h#^-|\xa8J2\x18yB-\x06`4٫ㄿ\x96\xa2\x1e\xd6\t\x16\x028\x91\x937s\xfb\x91Ak\xd4\xf6\xf6k\xc0Y\a\x1f\xdb\xfdn\xc7}\x99\x83?x\xe8\x04~ Y\x18\x99\x87\xdc<íR\x879I\xf8\x05\x0e\xd7%$\fb\xb8\xab\x1c\xdat<A\xe1\xbb\x1f\xa4\xcb\xe1z\x9em\x83\x9f@\xf5\xba\xcf`.\xe707\xd6\xc1;\xbc\xe5\xdc\xcb/\x81\xea\x0e\x00\x00\xf8r%\xfc\xac\x93\xfe\xf4\x11\xd2\x16\x8aW\xa96\x1ao\b\xbbU\xaa\x0f<\xfd\x8e;[ܼ\xff\x1c\x14\xec^\n\xfc_\xb0\xf3Py\xf9qJ\x8e\x93\xbd\xbc\x1dΏ\xed\xe7\x8e\b\x9a\x9c\xb8\rh\xd2^ \xa3{G\x8b\x11\xcd {ӟ4\x11\xf2ɟ\xa5\xfd\x0f\x9a\x84\r\x82\x85\xe5b\xa9\xc5`\xc1\x18\xae!6+e\xe1,\tn\x86*g\x8c\xb2\xef\xf6\x96\x8d1\xae\xdb[\x02\x95\u007f\x01\x00\x00\xff\xff\xbf\xbd}\xb8\xed\b\x00\x00",
-		hash:  "9cae6493220b9658790e7c71938d38470ca1f300364642f144844d5511d8de55",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecV_o\xe36\f\x7fN?\x05g\x14\xc5\x06\xd4\xf1\x0e\xb8\xa7\x9c\xe2\xe1\x9a\x04h\xb0\xad;\xdc}\x02\xc5bj\xa1\x8a\xe4Ir\xdb\xc0\xd5w\x1f$˩\x93\xda\xd7?\xcf\xf3C\x1b\x91ԏ䏒Ȧa\xb8\xe5\x12!)J\xcae\x89\x94%ΝM\x9a\xc6\xe2\xae\x12\xd4\"$^\x88:\x88\xc9/i\nW\x8a\xed!M\xf3\xb3\t\x10\x83\x85\xe5J\x02g\xf3\x04\x1f+\xa14\xea$?\x83\xf8\x11\xc6\xef\xa1\x10Ԙy\xa2\xd5COs\xaa-\x94\xa8wҜX\x04+\xb3\xa3B\xe4\x8b.\xbe\x19\x10\x1a\xfcmia\xd5.5HuQ\xa6\x82˻\x04\xec\xbe\xc2y\x82\x1b\xa1\x8a\xbb$o\x9a\xe95R\xe6\x1c\xc9hN\xb2\x16襃\xf2S\x8b\u07b9Z/g\xbf6\xcd4\xc8\xd6K\xe7~붒\xac\xfc4\xb0\xbf\xf2\x8eVW\xde\xe7B\xd5\xd2:\a+i\xf5\x1e\x82\xc8LÊ\xa3\x01\x89\x0fh,l\xb96\xf6\x12*z\x8b\xd04ӛz\xb7A\xedܔd\xd5K\xf4~%B\x8d\xfc6\x93\xc0Թ\x01[M\xe5-\xc2\xf9\xe6\x12\xce7\xe2\x0efs\x88\x81\x99\x01sR~\xce{\x81\xbe\x87W\x8f>\xfd\x13\xf7\x7f\x7fo\xc9\xed\xa8\xfb\x81\xff\xd6(\v\x84hҭ\x9d\xbb\x8cN\xae\x91ߖ\xb6\xd3/\xafڵG9p\xfc9\x1fό_\xc29\n\xf4\x99\x05\x80Hm/\xbb\xc9\x04\x88\xa5\x1b\x81!\x99\x98E\x10\f\x1c\xae\t\xb1\x1b\xc5\xf6\x03\x8a\t\xb1zH<!\x96E֮\xa9)g$\xb3l\xd4\xeeuF=P \x14\x05N=\xe01\x9f\x84B\xa9q\xdb\xe5\xf1Gإ\xb1@^ً\xf8\x7f\x1e@.\xb8\xacj;?BJ\xf2\xef\xadI\xff\xfc\x8f\x05L2\xab\xdf\xcdãE-\xa9\x80\xf5\xd2\xfc\x9c\x89\xb3I\xfcH-\x0e\x8b\xb6\xb2\x10K\xbb^\x86\xaa\xfa\xf0W\x8fv\xbd4\xe0\x9f\x9cgK\xff\x11\xc1۷Ƨ\x9cbt\x9fr\x168\xf4\x97\x95d\x82\xe7p\xe2\x01%끑\xac\x16Á~\x8c\x9a\x0eֲ|\xa1\xa4EiG\xb8\xe8\x7f\xe45\x83`d**{\t\x17-|j\xeaݎ\xfa\x83\x13\xfd\xc1\x8fV\xe0\xdfƼ\xbb\x8a\xa5z\x80\xafB<ם\xbe\xc1\xe1FC\x96C\nW{\x8bf\x06\xf18E7\x7f\xa1\xbc\xb5\xe5\xc0K2\n\x137\xb6W\xe5\x04\xad=\xa3o\xc7Z-`\xa1\x8c\x85\x03\xcej\xe1\xd7o\x81\xc8<\x8f9\x00|\x98q\xffJ$`\xec^\xe0<a\xdcT\x82\xeegRI\xfc\x92\xe4_\x85\xe8\x12\x9d]\xe0\xceT_^\xfe\xed\x15\xe6\x9a3\xfc\xbf0\xb1\x03\xe9\xd7S?\x0e\xee\xed\xe5\xfe\xf9\xf5{\xdfU'\xd9H\x9f Yh-C\x1d\v%;\tvH\xf6\x8e\x1eO2\xc6\xef\xfd\xe3\xd9\xfd Y\x9c\xbf\xf28\x9a\xad$\xeb\x8dg}XSh^Y\x93Dľ\xca*%̋\xa9o\xab\x94m\xa7\xbe.곓a\xb1\rrh\xae\xa8\x8e\xe6\xc1\xb45\x1c\xa2\x88o\xc1\xb7\xaao\x1a﹪\x8ds\x83\xfd\xee0\x9a\x1ez\xdc\xf3lv\xe1\xc1\xe7\xbf'\xf9M\x98\xadB\xef|\x82\x0f\xc24\xcd\xf49\x94\x16R{ĦAaй\xd6\a<AЌU\xf3\t\xbe\x9dLv\xf04\x9e\xfb\r>\xda\x0f\xe7\xed}\x84\xfdI\xfe\x8f`\xc7\xc1\x06\xc1X\x8ca\xd4\xec\x94\xff\r\x00\x0f:\xe5\xd3\x05\f\x00\x00",
+		hash:  "516db312134403465b76601d9c267fb8cc93286dff13616cbfd35361eb605d78",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  2285,
+		mtime: time.Unix(1792369226, 0),
+		size:  3077,
 	},
 	"searchresults/type/dblock.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xd4W\xcbn\xdb:\x10]\xcb_1W\xc8\xf2\xdaB\x90]@\v\xb8\xb1\x13$\xb8(P\xb4\xf9\x01Z\x1cGD(\xd2 鴂\xe0\u007f/\xf8\x88\xad\xf8!?\x9a\xb8\xa9V\x02\xe7px8g8\xe44\r\xc3)\x97\b)\x9b\bU<\xa7\x8bE/i\x1a\x8b\xd5LP\x8b\x90\x96H\x19j?L\xfe\xe9\xf7\xe1F\xb1\x1a\xfa\xfd\xbc\x97\x001XX\xae$p6L\xf1\xe7L(\x8d:\xcd{\x10?\xc2\xf8\v\x14\x82\x1a3L\xb5\xfaѲ\xac[\v%\xe6\x954i\x0eo \x1eV^\xe6c\xae\xb1\xb0J\xd7p\xe38\x92\xac\xbc\xcc7\x81\x96N\x04n\x8e\a\xdbD\xb1z\xbb-\xd8\xf5nc\x00\xb0\xfc\u007f\xac\xbf|\xbb&\x99e\xfb\xb1M3\xf0\xf0Ţ\x1bO\xb2\xae\x95\x0f\xa2\xe5\x059\x92۽\x17uঞ\x85\xe3\xdd\\\b\xb8\xa7\xa6<\x9c\xa2\x9b\xe2f\x9c\x81\xdd#\xaf\xf0\xbb\xa5\xd5\xec\xe8\x00\xde)]Q\x8bl\xe9\xe1\x1cz\xbb#\x00\xf7ȟJ{4\xe1\xf1M\x98x\x06\x9e_5\xbep57\xb0vz\x0f\xe4\xdc\tp\xdfҿ\xcf}\xb8v\xf3\xa8/ESZXU\xf5\rR]\x94}\xc1\xe5s\n\xb6\x9e\xe1\xf0\xb5Ƶ\x02\xe2\xbc,O*\xcd\xc9DCv\xc4\xda\xcb\xc4\xf6\xeb\xbfu\xbb\xca\xe0\xee͞(\x04\xc9v\x145\x92\xed\xa8\x84\xa4\xbc\n\xd9c`\xa4\xa4\xa5\\\"\x03.\x83,@LE\x85hW\a7<Rsi\x17\x8b\x801$\v \x92\x95W\xdbj\xb5_\xd8k\x10\x83\xef\a\xd2M*ɮ\x8a\x9c$[\x93+q\xe3,\u007f\xacg\xb8#\u007f\"\xe2?V\xbd\xeeh\x17n{H\xbb\x17v)\xa2\xbbWޛ|t\x95|\x9e\xa5'\xe9\xaf\t\x1d\x93\xef8\xc2'$\xc0\xc1\xfa\xbcۥ١\xd8:\xf4VZ]\xc3H#\xe3\xb6K\xc1w\xacQ]\xb2\xaec\xf7\xea\x8b\xc5J`\xbf\x97\xb0\x95\x03e\xfe\x90\x03\xff\xa9\xf5\xbes\x91\xe4\xeco\x94z\xbaR:\xee\xe2\x0f\xaa\xdc4\x9a\xca'\x84\v\xfe/\\\xa0@\xb8\x1e\xc2\xe06\x14\xec\xd6ݓ$\x9f\xa1>\x87C\xfe)\xeb3.EuQ\x1c\xb4_\x05G2\x85.\xaa\xfb\xde\xc2\x11\x16Y\xec{\x06\x9fHbTR.\xe1a\xfc\x9b!+\x9c\x1b\xd7!.\xa3\x16\xdf\x0e\xde\xff\xc3\xf8\xb4\xf0uf\x98\xb2T\x80\xcb\"\x8e\xe6\xa0\bFF\xa1\"\x87\xd7̇]\xb4I\xd24(\xd9\xdb\x17\x1f\xc9\x18\u007f\xc9{\xce}\xf8!Yl\x9c\xf3\xd8S\xdfJ\xd6\xea\xab\xdbݷ)4\x9fY\x93F\x8fm\x93UJ\x98\x8dv}\xaa\x94\r\xedzd\xf2+\x00\x00\xff\xffq\xd0\xe5\xf7\xe1\x0f\x00\x00",
-		hash:  "c0b6677fc67ef5062e5f8d7fedd45e6545285789d84f217496e9902761af4400",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecY\xefn\xe28\x10\xff\x9c>\xc5\\T\xad\ue905h\xb5ߪ\x90\xd3\x15Z\x15\x9d\xaa=\xed\xf5\x05\xdcx\xd8\xf8\x9a\xd8\xc81\xbd\xa2(\xef~\xf2\x9f$@C \x94\xb2T\xbaO {f\xfc\xf3\xcco\xc6\xe3\xb8((\xce\x18G\xf0\xe9c*\xe2'\xbf,/\xbc\xa2P\x98\xcdS\xa2\x10\xfc\x04\tEi\x86\xc3_\x06\x03\xb8\x16t\t\x83At\xe1A\x98c\xac\x98\xe0\xc0\xe8\xc8Ǘy*$J?\xba\x00\x00\x00\x00\b){\x868%y>\xf2\xa5\xf8wefs6\x16\xe9\"\xe3\xb9\x1f\xc1\x9a\b\x00@\x98|\x89&Lb\xac\x84\\µ\xc6\x18\x06ɗ赠\"\x8f)\xbe\x1e\xb7s\x8f\x82.\xdb\xe7\xec\xbc\xdc>i\x05h\xf4'.\xef\xbf_\x85\x81\xa2\xbbe\x8bbh\xc4˲[>\f\x94|#,\x13\x90\x9e\xd8\xeeLP\x87Z\xf5$\x18o\x17i\nw$O\xf6\x87\xa8U\xb4\xc6\t\xd0=\xb0\f\xffV$\x9b\xf7v\u0b50\x19QHk\v\xa7\x88\xb7N\x01\xb8C\xf6#Q\xbd\x01O\xae\xad\xe2\tp\xfe%\xf1\x99\x89E\x0e\x1bٻ'\xe6N\x01\x00\x80ھ\xe1>\\\x01@HL)\x9a\x91X\x89l\x90#\x91q2H\x19\x7f\xf2A-\xe78\xaaj܊C\xb4\x95:SI\x14>J\bz\xac]\x13\x1b\xae\x00`\xddl\xc3\xe0\xee\xcd\x1e\x18\x880\xd8R\xd4\xc2`K%\f\x93\xaf\x96=9\x8c\x05W\x84q\xa4\xc0\xb8\r\v\x84yF\xd2t\xb5:\xe8\xe1\xb1XpU\x96V&\x0f\x03+\x14\x06\xc9\u05f6Zm\x1661p\xce7\x03\xfek(\u07b6\x8a\xecy\xad\xe4\xf2\xf48\x8d\x1e\x96s\xdc\xc2\x1f'\xf1\aͪ\x1dm\x93kwi\xf7\u009a\"\xb2{\xe5\x9d\xe4#\r\xf9\fJ\x03\xd2\x1c\x13ґ\xaf\x1f\xe0\x03\b\xb0w|\x8evhvDlS\xf4\x86+\xb9\x84\xb1D\xcaTW\x04\x8fX\xa3\xbaº)\xbb3\xbe\x187\x016{\xb1[\xd93\xcc\xef\x92\xf0g\x1d\xef[\xedIF?b\xa8gM\xa4\xdd.~b\x94\x8bB\x12\xfe\x03\xe1\x92}\x86KL\x11\xaeF0\xbc\xb1\x05{\xe5\xec\xf1\xbcs\xa8\xcf6\xc9ϲ>c\x1dT\xed\xc5\xe1jW\xd0\x13)tA\xdd\xd5\v;1\x87bW\x1b| \x88qB\x18\x87\xe9\xe4\x8d.\x8b\xb5\x19}C\xac\xbd\xe6z\ac\x7f:9\xcc}\x9d\f\x13\x8a\xa4\xa0Y\xc40\xdf˃\x0e\x91\xadȶ\x9b9&\xa0\xbd\xa0\xb4\x8d\xd7i\xfb\x8fN[\x9d\xb4\x06\xae\xb3W\x96E\xc1f\xc0\x11.q\xa8\t\x00\xfe=\xe3\v\x85pO䓽\x90\xb7\x18\xf5v\xb3\\\xfb\xc1\x86kX\x11kk\xdfk`\"\xa7e\xe9~Z7\xf8^mK\xbdxk'k;=箦{M\x91\x83\xed\xafjOVBk\xdd\xeb~et\xdd\xd0\a;`]\x02h\x8d\x13\xdc\xf7\xa6|&\xfa\x01\xfb\xa6\x12\x94Z\xedpt\x87\x9c\x96\xdb)\xe5\xcerx\x90\x84\xe7\xc4|\xdb\xdad\x96\x13Y\x95(\xcb5\x85\x03X\xd6j\xf4,\xc8\xd6 \x82\xe9\x04~U/\x8c\xfev\xb4&\x8a\xc4J5\v\xd4G\xc8\xc3KupT\xbe\x0f\t$\x12gվ\x7f7\xfa\x12cds\xf5\xc9\xfd\x8ef։\x9f\x18\x9f/\xd4h͖\x1f}\xb7B\xf64\xaa\xe2\xf3ޟ\x95\xccQ5\xd5pz&\xacV4ze\t\x8e\x1b\xf9\t\xf2w\xbeP9\x10N\xe1\xdbB\xe9\xff\xfdP[\xfd\xb2\xb4;\xce?C\x95\xe5\xd6X\xb3\x95\xca|-q3nd\xd6.\x81n\xf8<\x8a\xc3\x1a\xb2\x8e\n1\x16Y\xc6\xd4\x11\xaaBm\xe8\xffc\xa7c\xb5\x1e_\x95\xf7\xb8\xc1\xb7գ\xa6K\xeaU\x8fL\xa3\x15\x1b\xba\xac\xd7$k\xef\xe85\xc9\xf4\x8bu\v\xb9\xdc\xf1\xe5q/\xdf\x1aC\xfd\xbe\xdbo\x02xێڳ\xf1\x80=ش\xedYќ\xd2O\xeeN\u0080\xb2\xe7\xe8\xc2\xf3\xaa?a\xe0\xde\xdd\"\xf7$w\xc3\xe9ʳ\xdc\xea\xe3]\x1eK6WyuYX\x9dRB\xa4\xf9\xab\u05fe\x99\x10\xca^.\x1c\x92\xff\x06\x00\x047\xbf\xee \x1c\x00\x00",
+		hash:  "1db33913455eb7f9e0c8e3a42fd7846c0a999448444622ba176075a8c3f5f9be",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  4065,
+		mtime: time.Unix(1792361143, 0),
+		size:  7200,
 	},
 	"searchresults/type/eblock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcV\xdbN\xe30\x10}N\xbfb\xd6\xe2q\xd3\b\xf1\xd6u#\x01eE\xb5BZ-_\xe0\xc6Sb\xe1\xda\xdd\xd8\x01\xaa\xa8\xff\xbe\xf2\xa5%-\xe9U\vyj\xed\xf1̙3\xe3\xe3i\x1a\x8eS\xa1\x10\bN\xa4.\x9e\xc9r\xd9K\x9a\xc6\xe2l.\x99E %2\x8e\x95_\xa6\xdf\xd2\x14n4_@\x9a\xe6\xbd\x04\xa8\xc1\xc2\n\xad@\xf0!\xc1\xb7\xb9\xd4\x15V$\xefA\xfc(\x17/PHf̐T\xfa\xb5\xb5\xb3\xbd[hYϔ!9l\x98x\xb3\xf22\xbfS\xb6Z\xc0\x8d\xc3G\xb3\xf22\xffhd\xd9D\xe2\xc7\xf5\xb07\xd1|ѽ\x17\xf6\xabݛ\xc1\x80\xe7\xbfp\xf1\xf0g@3\xcb\x0f\xdb6Mߛ/\x97\xfb\xedi\xb6/\xf2Q\xb0~\xd6R\xc2=3\xe5\xf1\xd0\xdc\x11w\xe2\v\xd0ݖL\xa8\xf1\xe8Hl\x94\xf9>\x9a\xb2\xc2\xeaYj\x90UE\x99J\xa1\x9e\t\xd8\xc5\x1c\x87\xa4p\xee\\;\x12\x97ǽ\xef\xcb~\x8c\xe1\xb2a\xf9\xa7g\xe4[\x10\xeeQ<\x95\xf6x\xca#\xd4\xd1M8\xf8\x05\xcc\xff\xae\xf0E\xe8\xda@\xeb\xe6\x1c\x89w\xaf\x81\xfb־}\x93\xfb\xa5\x81;{\xa8|Q_Z\x848O\xeb\x9b\xc2r:\xa9 ;!\xfe\xba\xf9}\xfcM\xb7\xef]\xbe?\xe13\vA\xb3\x1d\xa2B\xb3\x1dJD\xcb+/c\x02\r\xdcje\x99P\xc8A\xa8P\x1b\xa0fƤlq\xe3\vw\xabke\x97K\x88\ai\x16\xachV^u(e\xd3TL=!\\\x88\xefp\x81\x12a0\x84~<\xda\xc1Cӈ)\xe0_o\xda\xf74\x92\a\xa1j\x8b\xf0\xc0\xaa\xe7 \xf9\xd0-\xb4\xbeұ\xc4~\x81|\xa2\xf4n\x80:\xf6\xce\xf9\xa4Z\xda\xf0Uen\x1a\x94\x06[\xcc%\xc9\t\x9c%\xbb\bK\x92N\xaa\x12\xb7\xce\xe3\xf3\xb8\xe7\x19\x88v\x87\xaf\xa8sDV\xf4\xad^\x89]\xba\x9a$\xdd\xcc\x1d\x00\xfbf\xb1RL\xc2xd\xf6\xc3\xed%\xf1\xa3\xb5\\\xff\t$C\xec\xf4\xf1\xc85\xb9G{\xf7f\xc7#\x03nNy\xb7\xf4\xb5\x92\"\f(.\xb9\x14c\xf8T\xf8g\xe4\"\xb4\x87\x149lE@\xc5[\xceh\xe60t\x01=\x8f\x9a\x95[\xf7JjeQ\xd9\xff%\xcf\xd4̙j%\\\x04\xf7\xa9\xa9g3\xe6\xaa\x1b\xe3\xc1cX\x18\x00ey\x94\x9f\xc7R\xbfµ\x94\xefB\xb3R妱U\xad\n7\x11\x86\xab\x15\x9c8\xee\\\xbc\xf3a\xb9~'`\xecB\xe2\x90pa\xe6\x92-\x06J+\xfcA\xf2k)a\xc5\xce6ʈ\xbe\v\xe9\xe9\x00O,\xe2Y\xb2\xa0\xf8\x86*l\xaf\xf8\xd3\\\xbc\xb8F_\xfd\xa0Y\x1c\xb0\xf38{\xdf)ޚ\xbf\xdbS\xba)*1\xb7f\xa5\xd9\xed-\xab\xb54\x1f\xc6\xfa\xa9\xd66h|D\xf2/\x00\x00\xff\xff+Q\x86\xa5\t\f\x00\x00",
@@ -150,11 +171,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  1147,
 	},
 	"searchresults/type/entry.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xe4U\xc1n\xa3<\x10>ӧ\x98ߪ\xfe\x1bE\xbdR\a\xa9\rH\x8d\xb4\xb7>\x81\x8b'\xc5Zc#{\xd2\x06!\xde}eC\x14Z\x0eA{]\x0e\xd10\x13\xbe\xef\x9b\x19{f\x18$\x1e\x95A`h\xc8\xf5l\x1c\xef\x92a l;-\b\x815($\xba\xe8\xe6\xff\xa5)\xbcX\xd9C\x9a\x16w\t\xf7X\x93\xb2\x06\x94\xdc1<w\xda:t\xac\xb8K\x12.\xd5'\xd4Zx\xbfc\xce~E\xdf7gm\xf5\xa95~\n$\xbcy,\xaa@\x0e\xa5 \xc1\xb3\xe6q\xf6\x93x\xd78\xd9\t\xa7\xf7\xc0\x1c\xb8<\nW7i\x8c\xce\x10!\xee.f\xc2I\u0380\xaf\xc279\xcfH~\x8b\r\xc3C\b\x8c\xe32³+\xc2O\xb0}#\x94\x81C\xb9B\xe2\"*:\x8a\x9al\x9b\xce´2\xbf\x19P\xdf\xe1\x8e\xd5\xe1\xcbPC\x16X#Ρ\fĢ\xd8J^\x9d\t\x9d\x11\x1a\x0e\xa5_\xe7r\xb1\x13~\xd2\xd7\x17\x00\x80a\x00'\xcc\a\xc2\xfd\xa1\x84|\a\x0fՙ\x0e\xa5\x87\xd0\xca\xc5\xdf\xc2õ\x9a\xba\x18\x8a\x96\xe2L\x98\xaa\xa8\xfa~\x12\xacU\x01?\xf1\xd1\xc8%\x1c\xcf\x16\x1a6\xd7\xd6\x1aBC9\u007fw\x90\x15\xeb\xfc\xe0\xc6\xc3}'\xccB}=\xe1\xa5\xfeԶ\xc2\xf5\xecB\x00o\x93#\a.\n\xee[\xa1u\xf1\xd6\xd8/x֚g\xd3{h\xcbm\xc2(\x14Rx\xe9\t}\x0e\xa1\xaf\x13\xc5/4\x1fԌ\xe3v\x88\x8b\xb6xN\x17H\xd3\xf1\u070eS\xedao=AĨ\xf6\xc1\xde\xf2y\x16jW\x00\xc0_W9\\J\x06\x9ez\x8d;&\x95\xef\xb4\xe8sc\r>\xb1\xe2Y\xebK\x82\xf9\xff\xd8\xfa\xeei\xfd\xbbhƫ\x92\xf8/7\xa3q\xb7ӽ\x8a\xda\xde\xde\x1b\x17\x92gq\xb2\xce#7\xbb\xce\\\x9eI\xf5\x19\xc7\xf9l\xf0l\x9e\xf8ż\v*#\x17\xfb`\xb95|\xedTG~\xb5M\xc8Z\xbd\xf6\x1e\xad\xa5i\xc7\f\x03\x1a9\x8e\u007f\x02\x00\x00\xff\xff\x1a\x98\xf9\x91\x95\x06\x00\x00",
-		hash:  "a9b9fe61fc7a2385e955d54519ae2bb7a9da16621e812e7030e44ee0fc63100b",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xe4U\xcdn\xab:\x10^\x93\xa7\x98kU\xddQ\xd4-5\xbej\x93H\x8dtW\xb7O\xe0\xe2I\xb1\xae\xb1\x91=i\x8b\x10\xef~eLN艎\x8a\xce\xf6\xb0H\x86\x19\xfc}\xdf\xfc\xc0\f\x83£\xb6\b\f-\xf9\x9e\x8d\xe3&\x1b\x06¶3\x92\x10X\x83R\xa1\x9f\xdc\xfc\xaf<\x87'\xa7z\xc8s\xb1\xc9x\xc0\x9a\xb4\xb3\xa0U\xc5\xf0\xb33Σgb\x93e\\\xe9w\xa8\x8d\f\xa1b\xde}L\xbe/\xceڙSkC\nd\xbc\xb9\x17\xfbH\x0e;I\x92\x17\xcd\xfd\xec'\xf9j0\xd9\x19\xa7\xd7\xc8\x1c\xb9\x02J_7\xf9\x14\x9d!bܟ͌\x93\x9a\x01\x9fehJ^\x90\xfa\x12\x1b\x86\xbb\x18\x18Gࡕ\xc6\b.\xa1\xf1x<C\xffM}\x87\x95\xc7\x1auG\xb7\xf3\x7f5\xd5\xe7V\xdb\xeeD\xd5\x0f\x04&\xfeMa^H\xc1\x8b\x19nA\xc8\v\xf2\xbfҸm\xa4\xb6p\xd8]\t\xe4rJ\xf4(krm>\xe7k\xb4\xfd\x8f\xc1$\x8d\xd5\xf1dl\r\x8b\xc9L8\x87\xdd8&\x11\xeb\xc8\xf7\x9f\x84\xdeJ\x03\x87]\xb8.\xd1\xd9\xce\xf8\xc9\\n\x00\x00\x86\x01\xbc\xb4o\b7\x87\x1d\x94\x15\xdc\xed?\xe9\xb0\v\x10'd\xf1X\xbc\xb8\xd1i8b\xe5r\x9c\ts=\xa9\xbeI\x82\x8d\x16\xf03>Z\xb5\x84\xe3\xc5B\xc3\xea\xda:Kh\xa9\xe4\xaf\x1e\nq\x9d\x1f|s\xf1\xd0I\xbbP_'\xbc<\x9c\xdaV\xfa\x9e\x9d\t\xe0%9J\xe0R\xcc\xe3\xf4Ҹ\x0fx4\xe62\x0fr\x05\xe1$\x14rx\xea\tC\t\xb1\xaf\x89\xe2\x1f\xb4oԌ\xe3z\x88\xf9`\x1a\xff\x05R\x9a\xd9\xf58\xfb-l] \x980\xf6\xdbh\xaf9^\xc4\xda\t\x00\xf8\xed*\xc7w\x9dA\xa0\xde`Ŕ\x0e\x9d\x91}i\x9d\xc5\a&\x1e\x8d9'X\xdeb\x1b\xba\x87\xeb\xdfE3\x9e\xb5\xc2?\xb9\x19\x8d\xff>\u074b\xa8\xf5\xed\xfd\xe6\x85\xe4\xc5\xf4\xc1Nw\xbc\xb8|\xcay\xa1\xf4\xbb\xd8\\\f^̋D\xcc+fo\xd5b\xcd,\x97Q\xa8\xbd\xee(\\-)r\xce\\{\x8f\xceQZ]ÀV\x8d\xe3\xff\x03\x00\xa7|\xecH\xec\x06\x00\x00",
+		hash:  "182be36f7a310897c49bc5fa76ab8eca6a4e3b7648e5afd842845e2ee5f17fda",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  1685,
+		mtime: time.Unix(1792361115, 0),
+		size:  1772,
 	},
 	"searchresults/type/entryack.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcTAO\xf30\f=\xa7\xbf\"_\xee]\xb5\xeb'/\a`\x12w\xf8\x03^\x93\xa9\xd1ڤJ\xbcA\x15忣5\x05\x15\xb1\x8e\x1d\x80\x9c*?\xd7\xcf~\xf2s\x8cJ\xef\x8d\xd5\\hK~\xc0\xfa R*X\x8c\xa4\xbb\xbeE\xd2\\4\x1a\x95\xf6c\x18\xfe\x95%\xbfsj\xe0e)\v\x06A\xd7d\x9c\xe5Fm\x84~\xed[\xe7\xb5\x17\xb2`\f\x949\xf1\xba\xc5\x106»\x971\xf6)X\xbb\xf6\xd8ِ\x01\x06\xcdZn\xcf\xfc\xfc٣\r\x98\xab>\x11\xd21@լe\xc1/< ܵ\xfa2ƀvN\r\v \x03\xf2K\x10\x03R\xb9\x99G\f\xcd\u007f\xa8H]M\x05\x1c\xc7\xdfcM\xae+\x83F_7ek\xecAp\x1az\xbd\xc9\xc2\n\x19\xe3\xea\xa3jJP\xa1\xbcV\x1a\xaa\xa5\x0e\xe7\xf3\u007f\x9b2\xe5)y\xef\xba\xce\xd0$镡.\xfczK\xde\xf8b\\e\x9a\a$\\e\xaa\x94n\xa2\xb9\xa1\x9f\x9fV$\xef\xdbo\v2\xb2\xfc\xb1\x1e\xe7\xe5Y^~\xa8&ۜ\xf7\xb7R\xe64\xfau\xfa\x80j\xb2\xb4\x9c̾\xb5jf\xf8\xf9Y\b\xb57=\x05\xf1>\xd1\x1c#\xe7\xda\xf0\xe5\x90읣|Hb\xd4V\xa5\xf4\x16\x00\x00\xff\xff\xe2\x95\b\x0e}\x04\x00\x00",
@@ -171,11 +192,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  784,
 	},
 	"searchresults/type/facttransaction.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW\xddN\xdc<\x10\xbd\xde}\n\u007f\xfe\xa8\xd4J\r\x01Do\x16\xc7\xd26\r-W\xbd\xa0/\xe0\xb5\xcd\xc6\xc2kG\xf6\x04XEy\xf7*N\x02\xa9\xf8\xcb\xf6\x87]ؕ\xa2h2\x1e\x8f\xcf\x19\x8d\xe7T\x95\x90\x17\xcaH\x84/\x18\ap\xccx\xc6AY\x83\xebz:\xa9*\x90\xabB3\x90\b\xe7\x92\t邙\xfc\x17E\xe8\xb3\x15k\x14Et:!^\x86%H\x89\x04˛B['\x1d\xa6\xd3Ʉ\bu\x85\xb8f\xde'\xd8\xd9\xeb`\xfb\xc5ȭ.WƷ\x1f\x10\"\xf9!=e\x1c\xac\x12\xe8\xc7].$\xce\x0f\xe9\x14=\xf0#\xc0\x16Z\x86\x8d\xbdd\x8e\xe7Q0\xe0\x87\xbd\xfb5\v+\xd6Oy\xb4^\x0eyXk\x99\xe0\x05\xe3\x97KgK#\"n\xb5u\xb3\xff\x8f\x0e\x9a?\xa6\x04\x04%q\xf3\xb8}\x89\xc1\x8d\b\xfd\x9cK\xf0\x12\x88[\xed\vf\x12|\x84\xe9\xe3\x9e\xcfǺ\x83\x8a\x8es\xee\x13\xe8\x10\xb8V\x02\xf2٧\x83w' o bZ-͌K\x03ҝ\xe0MB\xe6\xc7\xf4\xcc\x14%x\x12\xe7\xc7\x1b,\xac*\xc7\xccR\xa2=\xf5\x11\xed)\x83f\t\xda\xff*\xa1\x8dU\xd7\xe3\x03\x85,X(\x98\xa6\xe0\xed*\xea\xeaF+s\x89\x11\xac\v\x99\xe0\xd39\xa6U5\x17\xc2I\xefO\xe7\xa9uNrh6n6\xed\xec\xfb\xe7\xe0\x94Y\xd65\x89\x19Ed\xe1P\xbcс\xa4\x11\x9b$\x1e\xaa\xeb\xb7ȻG٦\x8c}/\xe1O)\xb3%\xf4\x9cu\xd1^\x8c4[\xc2\xf6X{\f\x84,\xfdW0d\xe9\x00\x86,\x1d\x03\xc3.\xd5.\x89Ƕ\xa9\x11AG4\xe3ѽxd\xfe\x83[\v\x9d}A\xef\xe1F\x89\x0f\xb3\xe9߂h|\"U\xd5\xd0}\xae\x96ߘ\xcfǐ\xb5\xfbx6'\xd9\x1e\x94\xaf\x1cG\vL\xa3p_n\a\xc2\x01\x91\xf3\x95-\r\xf4\x9di?\xa4\xd6\xdf\xe4\xa8\x1b\xff\xfc\xeb\x06\xba\x1fb\xdb.\xbf\x93\x88\xdf^@o\x04\xf2,\xdde\xb4\xb3\xf4M =\xe8\xc6\xe7\xc0\xa0\xf4[\xea\xc7\xed\xe6/ӎI\xfc\x94`\xbc\x1dX\x1ae\x1b\vu\x15to\xf7B\xe2N\x1a\xd3N4gF\f\x84\xf3P^{\xeeT\x01\x1e\xf7g\x1a~\x03k\xb5\xbf'\xc8/\xac\x85V\x90w\x03\xd9\xcf\x00\x00\x00\xff\xffy\xac\x81\xde\xcc\x0f\x00\x00",
-		hash:  "87249c7c9dd50e7b313ec61605b35b41978f0a5946f208ad8db955ddb3bd0b82",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW\xdbN\x1b1\x10}N\xbeb\xeaR\xd4J]\x16\x10}\t^Wi\x1aZ\x9e*\x95\xfe\x80c;Y\vǎ\xecY Z\xed\xbfW{#i\xb9mz!@\"%+\xef\xf8x\xe6\x9cь'ϥ\x9aj\xab\x80L\xb9@\xf4\xdc\x06.P;K\x8a\xa2\xdf\xcbsT\xf3\x85ᨀ\xa4\x8aK\xe5\xabe\xfa*\x8a\xe0\x93\x93K\x88\"\xd6\xefѠ\xaa-\xa0eB\xd4\xd5\xc28\xaf<a\xfd^\x8fJ}\x01\xc2\xf0\x10\x12\xe2\xdde\xb5\xf6ˢp&\x9b\xdbP\xbf\x00\xa0\xe9\x01;\xe1\x02\x9d\x96\xf0c\xe5\v\x8d\xd3\x03և[>\x14\xf9Ĩ\xea࠸\x17iT-\x90ۭ\xdb=\x13'\x97\xf7Y\xd4V\x1e\x02.\x8dJȄ\x8b\xf3\x99w\x99\x95\x91p\xc6\xf9\xc1\xeb\xc3\xfd\xf2K\x18E\xc9h\\\xfe\\?\xc4\xe8;@?dRYI\x10΄\x05\xb7\t9$\xecnˇ\xb1VT\xb1nƭ\x03\r\x03\x97Zb:\xf8\xb0\xff\xe6\x18\xd5\x15F\xdc\xe8\x99\x1d\beQ\xf9c\xb2\tdz\xc4N\xed\"\xc3@\xe3\xf4h\x83\x8dy\uee5d)\xd8\xd1\xefaG[\x18$\xb0\xf7Ea\x8dU\x14݁\x00\x00(\xaf\x12\xa6Lx7\x8f\x9a\xbc1ڞ\x13\xc0\xe5B%\xe4dHX\x9e\x0f\xa5\xf4*\x84\x93\xe1\xc8y\xaf\x04\x96\a\x97\x876\xeb{g赝\x15\x05\x8d9\x03:\xf1\x10o\x14\x90\xb2r\x13ǫ\xec\xfa#\xf1nH\xb6\xa9b\xdf2\xfc[\xc9\\\x86\xadf\rڣ\x89\xe62ܞjw\x910\x1e\xfd/\x1aƣ5\x1aƣ.4<\xa5ܥq\xd72\xd5\x01\xb4C1\xee\\\x8b;\xfa\xbfֵ\xe0\xf43\xbc\xc5+-\xdf\r\xfa\xff\x8a\xa2\xee\x8e\xe4y)\xf7\x99\x9e}\xe5!-\n\xa0a\u038da\x94C\xeaմm\x97\x1f\xab\xa4\xf1J(\xbd\xc0\xdd\xe6?\x99\xd6-xW\x97\xe55\xf9\r\x89\xb0\xef\xb5Y\x95:q\r\xfb\x12\xe4*\xc3۞R5\xb9ϖG\x87\xdc@Վ\xb7Cᚐù\xcb,\xb6\x85o\xafr\xad\xbd(@s\xbb\fϛ\xe8&\n\xa8\x9bȓd\xfc\xba\xbf\xbd\x10\xcaǣ\xa7\xcc\xf6x\xf4\"\x98^\x05\tg\xc81\v[\xaa\xc7\xf5\xe1\x8fS\x8ei|\xdf<z}\x1f*\a\xe7X\xea\v\xd6_=и\x99\xbcY3\x93\x8f\xad\\\x9b\xcbק\xf7 \xbc^` mL\xeb\xef\xd09\x13n\xcc\xfbS簞\xf7\x9b\xfb\xde\xcf\x01\x00\xc3>D\x15+\x10\x00\x00",
+		hash:  "8a685c2f065944e37ef4034aa7a6e253e7aa8ba45a7cb0cd0ae6a20b37238299",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  4044,
+		mtime: time.Unix(1792361115, 0),
+		size:  4139,
 	},
 	"searchresults/type/fblock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xd4X\xcbn\xeb6\x10]\xdb_\xc1\xb2鮲\xf2\xeaơ\x058\xae\x92\x14m\xd0\"\xed\x0f\xd0\xe2\xd8\"B\x93\x069Jb\b\xfa\xf7\x82\x92|#_\xbf\x94\x97obo\x04ΐ<s\xce\fGb\x9e\v\x98H\r\x84N\xc6\xca$\xf7\xb4(\xba\x9d<G\x98\xcd\x15G 4\x05.\xc0\x96\xc3\xec\xa7  \x97F,H\x10D\xdd\x0ea\x0e\x12\x94F\x13)\x06\x14\x9e\xe6\xcaX\xb04\xea\x92\xfaǄ| \x89\xe2\xce\r\xa85\x8f\r\xcb\xf7\xd6Ĩl\xa6\x1d\x8dȊK閞DW<A#\x05\xb9\xf4\bY\x98\x9eD\xebn\xc8\xc7\n\xd6\xc7+\xdb؈\xc5f[e\xb7ۍ\x95\x83\x88ʸo\xef\xfa,D\xb1\xdf;\xcf{~\xc2\xed]Q\xec\x9e\xc0\xc2]\x9b\xb7Bv\x03r\x9ab{`\xbf_V3\x0e\x00-~JR\xae\xa7@\xee8B{\x84~\x9a\x9fq\x00\x84\xffXx\x90&sd%\xc7ZB\xdd\xe9P:\xf1\xb26&~\xedY\xe0\x80\xdb$\r\x94\xd4\xf7\x94\xe0b\x0e\x83e\xd1\xf9\xa0=\x92?\xa1N\x19\xbeg\xf3W\xd2\xc2\xc2-\xa5\xc0\xc2-\xf5\xc3ҳ\xe8?˵\xe3e\xa9;22\x1a\xb9\xd4 \x88ԫ\xa4\x11\xe6f\\)\x1f\xcb_\xa0\xa7\x98\x16\x05\x895Z\t\x8e\x85\x95\x89\x85\xe9ن\x1a\xcfs[fɑ\xfc\x95\x1c\x81\x02\xd2\x1f\x90^sע\xe8\x92\xcd\x15_\xf2[\x13[\x0e\xd0-\xbc\xec;\x04|\xba\x10\x87\v\x05\x03:\xe6\xc9\xfdԚL\x8b 1\xca\xd8\xfeϧ\xc7\xfeO#\xafzI\xfe\xf3\xc3\xce\x1cl\x95\x84$1\xca\u0379\x1e\xd0S\x1am\xf7ܟm;\xce\xc0M\xdb\xd6\xd1>J\x81i\xff\xb7\xe3_.\x10\x9e0\xe0JNu?\x01\x8d`/h\xcb\xd5\xd2\xf3\xe8\x0f=\xcfб0=o7gEt\xa9\xbd\xe6^\xfb\xde5`\xb5\xd4&\xd1_[gWC_cC!,8w5\x1c\x19k!A\xbf\xaf߯\x1e\xef\xfd\x8bV\xeaiU\u007f\x84\x8d-\tۆ\x02Z\xb4\x84\xbb\xffd\xd9 њ0/\xd0\xe5\xef\f\xdf \x8cɰ\xa9L\xbd\xd8!\xa41\x19\x1eZ\x9b]\x91ǣ\x0f\x88=\x1e5b\x8fGmb\xff\xa1i\xb9\xb5M\xbcGsjݳ\xf7\xc2l\xb4\x0er\xc3]\xda\xef\xbe-\xf4w\xea\xf5<A|FV6\xfdk@\x0f\xf0\x03[\xfe;\xb2j\x90+R\x9e\xcd\a!4\xcf\x1b:\x0eg&Ӹ,\x90^\x89e\xd9&\x96\xaf!\xeeK\x10\xb8|g\xaa\x8e\x93\xcf\xc1䷣\xedkQ\x19\x8f>\x15\x8b\xf1\xe8\xc3\x19|\xf9\v\xfc\x86&\xc0B!\x1f\xa2n\xa7\xb3|`a\xfd%\x1f\xd5\x1f\xf9\xb1\x16\x8d\x0f\xfd\xe6u\x80K\xac\x9c\xa3\xa3\xf5\x8aM\x13\x1a\xa3\xdc\xda\xfd\xc1\xc4\x18\xac\xee\x0fj$\xff\a\x00\x00\xff\xff\x8a\x8c\\/r\x10\x00\x00",
@@ -191,6 +212,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  633,
 	},
+	"searchresults/type/receipt.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4U_o\xdb \x10\x7fv>\x05\xf3\xbb\x83\xfaZ\x11K\xcb\xd6iڴUꪽ\x138˨\x04,\xb8u\x8b\x90\xbf\xfb\x04&\x8b3ے\x95\xbe\x91\xbb\xe3\xf7\xe7.>B\x90\xd0(\x03\xa4t @uX\xf6\xfd\xa6\b\x01\xe1\xd8i\x8e@\xca\x16\xb8\x04\x97\xc2\xec]U\x91\xbd\x95'RU\xf5\xa6`\x1e\x04*k\x88\x92\xbb\x12\xfet\xda:pe\xbd)\n&\xd5+\x11\x9a{\xbf+\x9d\xfd\x9dbWAa\xf5\xaf\xa3\xf1C\xa2`\xed]\xfd4\xd03\xda\xde\xe5 \xf2\x83\x86\xe1\\0<D\xdaH\xe4\x81;\xd1V)\x9b\xefǼ;\x1f\v\x86\xf2\x8cF\x1e\x9b{FQ^\xe5B\xd8~\xe6\xbe\xed\xfbq\x86QtK`ϧ\x0efabb=\xccO\xae\x95\xe4\xb1a\xb3`)\xbd\x8c\x16\x82j\xc86\xfb\xda>\x18t\xa7\xbd\xb6\xe2\xe5+\x9c\xbe=\xc5\xe1\xccr\xa6:\x92\n\xa7\xa4\x8c\xa7\x866\\\xa0=V\xb9\xafZ\x99\x97\x92\u0a43]\t\x87x\xb1\x8c\xea\x16\x89\x19\xe5\xf5\xb2f0\xb2\xefg\x1d|\x8a\xacJ\xae\xf0\x90+ov\xd1L]̐\xdf\xea#5\xe4\x83\x03\xa9p\xf5<\x86\xf2\xdb\xc7\"\x16\xe62\x95\xb1\xde\xd5\xffJ?*\a\x02\xed\x1b\xfe=r\xaa\xf2\x1f\xe8z\x8d\xe3^\xef\x15\n\xab̳\xe3\xc6\xf3\xb4z\x86\x0fy\xc1B.'\xef\x8dh\xad\x9b:8\x9f\x8b\x11\xe0=\x19\xc9]\xe2c\aG\xe8\xe5\xfaР\x99\x8b)q%\xb1\xb8m\x18\x19wf\b\x9d\x83:\x84\x16\x8f\x9al\xbf\xfcx\xfc\x1e\xdb\x19c\v<\x8c\xa6E:\xfcb\xf4\xb2b\x19\x95\xea\xb5\xde\\\x0e\x8c\xe6\xed^\xe7\xbd\xff`\xe4h\xf7\x8f_\b/\x9c\xea\xd0O^\x0e\xb4VO\xa3\x8d\xb58\xbc'g\xcf\x7f\a\x00%\x17\x04@\x84\x06\x00\x00",
+		hash:  "9103dcc1876fa4bced3c050ff7a6386755c96dc30abae3d3e8162f25009b2e1f",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792361134, 0),
+		size:  1668,
+	},
 }
 
 // NotFound is called when no asset is found.
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strconv"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/FactomProject/btcutil/base58"
 	"github.com/FactomProject/factomd/common/adminBlock"
@@ -90,16 +92,12 @@ func HandleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 		TemplateMutex.Unlock()
 		return
 	case "chainhead":
-		arr := getAllChainEntries(content.Input)
-		if arr == nil {
+		chain := getChainPage(content.Input, content.Page)
+		if chain == nil {
 			break
 		}
-		arr[0].Content = struct {
-			Head   interface{}
-			Length int
-		}{arr[0].Content, len(arr) - 1}
 		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, arr)
+		err = templates.ExecuteTemplate(w, content.Type, chain)
 		TemplateMutex.Unlock()
 		return
 	case "eblock":
@@ -116,6 +114,7 @@ func HandleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 		if dblk == nil {
 			break
 		}
+		addDblockContents(dblk)
 		TemplateMutex.Lock()
 		err = templates.ExecuteTemplate(w, content.Type, dblk)
 		TemplateMutex.Unlock()
@@ -183,36 +182,22 @@ func HandleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 		err = templates.ExecuteTemplate(w, content.Type, transaction)
 		TemplateMutex.Unlock()
 		return
-	case "EC":
-		hash := base58.Decode(content.Input)
-		if len(hash) < 34 {
+	case "receipt":
+		receipt := getReceipt(content.Input, content.Receipt)
+		if receipt == nil {
 			break
 		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%d", StatePointer.FactoidState.GetECBalance(fixed))
 		TemplateMutex.Lock()
-		templates.ExecuteTemplate(w, content.Type,
-			struct {
-				Balance string
-				Address string
-			}{bal, content.Input})
+		err = templates.ExecuteTemplate(w, content.Type, receipt)
 		TemplateMutex.Unlock()
 		return
-	case "FA":
-		hash := base58.Decode(content.Input)
-		if len(hash) < 34 {
+	case "EC", "FA":
+		address := getAddressPage(content.Input, content.Page)
+		if address == nil || address.Type != content.Type {
 			break
 		}
-		var fixed [32]byte
-		copy(fixed[:], hash[2:34])
-		bal := fmt.Sprintf("%.8f", float64(StatePointer.FactoidState.GetFactoidBalance(fixed))/1e8)
 		TemplateMutex.Lock()
-		templates.ExecuteTemplate(w, content.Type,
-			struct {
-				Balance string
-				Address string
-			}{bal, content.Input})
+		err = templates.ExecuteTemplate(w, content.Type, address)
 		TemplateMutex.Unlock()
 		return
 	}
@@ -407,7 +392,6 @@ func getAblock(hash string) *AblockHolder {
 	StatePointer.UnlockDB()
 
 	if ablk == nil || err != nil {
-		StatePointer.UnlockDB()
 		return nil
 	}
 	bytes, err := ablk.JSONByte()
@@ -631,6 +615,10 @@ type DblockHolder struct {
 	}
 	FullHash string
 	KeyMR    string
+
+	AdminEntries        []ABDisplayHolder
+	FactoidTransactions []DblockTransaction
+	Commits             []DblockCommit
 }
 
 func getDblock(hash string) *DblockHolder {
@@ -704,6 +692,25 @@ type EntryHolder struct {
 	Time string
 }
 
+// formatExtID returns the html of an external ID, shown as UTF-8 if it is
+// printable UTF-8 and as hex otherwise, with both for the toggle between them
+func formatExtID(data []byte) string {
+	hexString := !utf8.Valid(data)
+	for _, r := range string(data) {
+		if !unicode.IsPrint(r) {
+			hexString = true
+			break
+		}
+	}
+	hexStr := htemp.HTMLEscaper(hex.EncodeToString(data))
+	utf8Str := htemp.HTMLEscaper(string(data))
+	encoding, str := "UTF-8: ", utf8Str
+	if hexString {
+		encoding, str = "Hex  : ", hexStr
+	}
+	return "<span id='encoding'><a>" + encoding + "</a></span><span id='data' data-hex='" + hexStr + "' data-utf8='" + utf8Str + "'>" + str + "</span>"
+}
+
 func getEntry(hash string) *EntryHolder {
 	entryHash, err := primitives.HexToHash(hash)
 	if err != nil {
@@ -723,22 +730,8 @@ func getEntry(hash string) *EntryHolder {
 	holder := new(EntryHolder)
 	holder.Hash = hash
 	holder.ChainID = entry.GetChainID().String()
-	max := byte(0x80)
 	for _, data := range entry.ExternalIDs() {
-		hexString := false
-		for _, bytes := range data {
-			if bytes > max {
-				hexString = true
-				break
-			}
-		}
-		if hexString {
-			str := hex.EncodeToString(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Hex  : </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
-		} else {
-			str := string(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Ascii: </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
-		}
+		holder.ExtIDs = append(holder.ExtIDs[:], formatExtID(data))
 	}
 	holder.Version = 0
 	holder.Height = fmt.Sprintf("%d", entry.GetDatabaseHeight())
@@ -760,62 +753,3 @@ func getEntry(hash string) *EntryHolder {
 	holder.ContentHash = primitives.NewHash(data[:]).String()
 	return holder
 }
-
-func getAllChainEntries(chainIDString string) []SearchedStruct {
-	arr := make([]SearchedStruct, 0)
-	chainID, err := primitives.HexToHash(chainIDString)
-	if err != nil {
-		return nil
-	}
-	s := new(SearchedStruct)
-	s.Type = "chainhead"
-	s.Input = chainID.String()
-
-	dbase := StatePointer.GetAndLockDB()
-	mr, err := dbase.FetchHeadIndexByChainID(chainID)
-	StatePointer.UnlockDB()
-
-	if err != nil || mr == nil {
-		return nil
-	}
-	s.Content = mr.String()
-	arr = append(arr[:], *s)
-	if err != nil {
-		return nil
-	}
-
-	entries := make([]interfaces.IEBEntry, 0)
-
-	dbase = StatePointer.GetAndLockDB()
-	eblks, err := dbase.FetchAllEBlocksByChain(chainID)
-	if err != nil {
-		StatePointer.UnlockDB()
-		return nil
-	}
-
-	for _, eblk := range eblks {
-		hashes := eblk.GetEntryHashes()
-		for _, hash := range hashes {
-			entry, err := dbase.FetchEntry(hash)
-			if err != nil || entry == nil {
-				continue
-			}
-			entries = append(entries, entry)
-		}
-	}
-	//entries, err := dbase.FetchAllEntriesByChainID(chainID)
-	StatePointer.UnlockDB()
-	if err != nil {
-		return nil
-	}
-
-	for _, entry := range entries {
-		s := new(SearchedStruct)
-		s.Type = "entry"
-		e := getEntry(entry.GetHash().String())
-		s.Content = e
-		s.Input = entry.GetHash().String()
-		arr = append(arr[:], *s)
-	}
-	return arr
-}
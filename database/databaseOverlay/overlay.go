@@ -17,6 +17,7 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/database/blockExtractor"
+	"github.com/FactomProject/factomd/tracing"
 )
 
 // the "table" prefix
@@ -120,6 +121,9 @@ type Overlay struct {
 	BatchSemaphore sync.Mutex
 	MultiBatch     []interfaces.Record
 	BlockExtractor blockExtractor.BlockExtractor
+
+	root        *Overlay      // The overlay a traced overlay was made from, see Traced
+	traceParent *tracing.Span // Database calls are traced as its children
 }
 
 var _ interfaces.IDatabase = (*Overlay)(nil)
@@ -136,7 +140,7 @@ func (db *Overlay) SetExportData(path string) {
 }
 
 func (db *Overlay) StartMultiBatch() {
-	db.BatchSemaphore.Lock()
+	db.batchLock().Lock()
 	db.MultiBatch = make([]interfaces.Record, 0, 128)
 }
 
@@ -147,39 +151,47 @@ func (db *Overlay) PutInMultiBatch(records []interfaces.Record) {
 func (db *Overlay) ExecuteMultiBatch() error {
 	defer func() {
 		db.MultiBatch = nil
-		db.BatchSemaphore.Unlock()
+		db.batchLock().Unlock()
 	}()
 	return db.PutInBatch(db.MultiBatch)
 }
 
 func (db *Overlay) PutInBatch(records []interfaces.Record) error {
+	span := db.dbSpan("PutInBatch", nil)
+	span.SetAttribute("db.records", len(records))
+	defer span.End()
 	return db.DB.PutInBatch(records)
 }
 
 func (db *Overlay) Put(bucket, key []byte, data interfaces.BinaryMarshallable) error {
+	defer db.dbSpan("Put", bucket).End()
 	return db.DB.Put(bucket, key, data)
 }
 
 func (db *Overlay) ListAllKeys(bucket []byte) ([][]byte, error) {
+	defer db.dbSpan("ListAllKeys", bucket).End()
 	return db.DB.ListAllKeys(bucket)
 }
 
 func (db *Overlay) GetAll(bucket []byte, sample interfaces.BinaryMarshallableAndCopyable) ([]interfaces.BinaryMarshallableAndCopyable, [][]byte, error) {
+	defer db.dbSpan("GetAll", bucket).End()
 	return db.DB.GetAll(bucket, sample)
 }
 
 func (db *Overlay) Get(bucket, key []byte, destination interfaces.BinaryMarshallable) (interfaces.BinaryMarshallable, error) {
 	GetBucket(bucket)
+	defer db.dbSpan("Get", bucket).End()
 	return db.DB.Get(bucket, key, destination)
 }
 
 func (db *Overlay) Clear(bucket []byte) error {
+	defer db.dbSpan("Clear", bucket).End()
 	return db.DB.Clear(bucket)
 }
 
 func (db *Overlay) Close() (err error) {
-	db.BatchSemaphore.Lock()
-	defer db.BatchSemaphore.Unlock()
+	db.batchLock().Lock()
+	defer db.batchLock().Unlock()
 	return db.DB.Close()
 }
 
@@ -189,9 +201,62 @@ func (db *Overlay) Trim() {
 }
 
 func (db *Overlay) Delete(bucket, key []byte) error {
+	defer db.dbSpan("Delete", bucket).End()
 	return db.DB.Delete(bucket, key)
 }
 
+// Traced returns an overlay of the same database whose calls are traced as
+// children of parent, so an API call or block save traces its own calls and
+// no one else's.  With no parent it returns db.
+func (db *Overlay) Traced(parent *tracing.Span) *Overlay {
+	if parent == nil {
+		return db
+	}
+	answer := NewOverlay(db.DB)
+	answer.ExportData = db.ExportData
+	answer.ExportDataPath = db.ExportDataPath
+	answer.BlockExtractor = db.BlockExtractor
+	answer.root = db
+	if db.root != nil {
+		answer.root = db.root
+	}
+	answer.traceParent = parent
+	return answer
+}
+
+// TraceDB returns db with its calls traced as children of parent, if it is
+// an overlay
+func TraceDB(db interfaces.DBOverlaySimple, parent *tracing.Span) interfaces.DBOverlaySimple {
+	if o, ok := db.(*Overlay); ok && o != nil {
+		return o.Traced(parent)
+	}
+	return db
+}
+
+// batchLock is held over a multibatch.  A traced overlay shares the lock of
+// the overlay it was made from.
+func (db *Overlay) batchLock() *sync.Mutex {
+	if db.root != nil {
+		return &db.root.BatchSemaphore
+	}
+	return &db.BatchSemaphore
+}
+
+// dbSpan starts a span for one call to the underlying database, labelled
+// with the bucket it touches.  Returns nil when tracing is off, or there is
+// no trace parent.
+func (db *Overlay) dbSpan(op string, bucket []byte) *tracing.Span {
+	span := db.traceParent.Child("db." + op)
+	if span != nil && bucket != nil {
+		name, ok := ConstantNamesMap[string(bucket)]
+		if !ok {
+			name = fmt.Sprintf("%x", bucket)
+		}
+		span.SetAttribute("db.bucket", name)
+	}
+	return span
+}
+
 func NewOverlay(db interfaces.IDatabase) *Overlay {
 	answer := new(Overlay)
 	answer.DB = db
@@ -461,6 +526,7 @@ func (db *Overlay) FetchBlockIndexesInHeightRange(numberBucket []byte, startHeig
 }
 
 func (db *Overlay) DoesKeyExist(bucket, key []byte) (bool, error) {
+	defer db.dbSpan("DoesKeyExist", bucket).End()
 	return db.DB.DoesKeyExist(bucket, key)
 }
 
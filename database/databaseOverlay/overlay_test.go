@@ -11,6 +11,7 @@ package databaseOverlay_test
 import (
 	"bytes"
 	"encoding/gob"
+	"sync"
 	"testing"
 
 	"github.com/FactomProject/factomd/common/constants"
@@ -20,6 +21,7 @@ import (
 	. "github.com/FactomProject/factomd/database/databaseOverlay"
 	"github.com/FactomProject/factomd/database/mapdb"
 	"github.com/FactomProject/factomd/testHelper"
+	"github.com/FactomProject/factomd/tracing"
 )
 
 /*
@@ -603,3 +605,50 @@ func TestFetchAllBlockKeysFromBucket(t *testing.T) {
 		}
 	}
 }
+
+type memExporter struct {
+	mutex sync.Mutex
+	spans []*tracing.Span
+}
+
+func (m *memExporter) Export(spans []*tracing.Span) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func (m *memExporter) Shutdown() error {
+	return nil
+}
+
+func TestTraced(t *testing.T) {
+	m := new(memExporter)
+	tracing.SetExporter(m)
+	defer tracing.SetExporter(nil)
+
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	one := tracing.Start("one")
+	two := tracing.Start("two")
+
+	// Each traced overlay's calls are children of its own span, and the
+	// overlay they were made from is not traced
+	dbo.Traced(one).FetchDBlockHead()
+	dbo.Traced(two).FetchDBlockHead()
+	dbo.FetchDBlockHead()
+	if dbo.Traced(nil) != dbo {
+		t.Errorf("Expected no parent to give the overlay itself")
+	}
+	tracing.Flush()
+
+	count := map[tracing.SpanID]int{}
+	for _, span := range m.spans {
+		count[span.Parent]++
+	}
+	if count[one.Context.SpanID] == 0 || count[one.Context.SpanID] != count[two.Context.SpanID] {
+		t.Errorf("Expected the same calls under each span, got %d and %d", count[one.Context.SpanID], count[two.Context.SpanID])
+	}
+	if len(m.spans) != count[one.Context.SpanID]+count[two.Context.SpanID] {
+		t.Errorf("Expected only the traced overlays' calls, got %d spans", len(m.spans))
+	}
+}
@@ -14,14 +14,19 @@ import (
 	"os"
 	"time"
 
+	"github.com/FactomProject/factomd/anchor"
 	"github.com/FactomProject/factomd/common/identity"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
 	"github.com/FactomProject/factomd/controlPanel"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
 	"github.com/FactomProject/factomd/database/leveldb"
+	"github.com/FactomProject/factomd/entryValidation"
 	"github.com/FactomProject/factomd/p2p"
 	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/tracing"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/wsapi"
 
@@ -49,6 +54,24 @@ func GetFnodes() []*FactomNode {
 
 func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
+	if p.ControlPanelHash {
+		hash, err := controlPanel.HashPassword(os.Getenv("FACTOMD_CONTROLPANEL_PASSWORD"))
+		if err != nil {
+			fmt.Println("Set FACTOMD_CONTROLPANEL_PASSWORD to the password to hash:", err)
+			os.Exit(1)
+		}
+		fmt.Println(hash)
+		os.Exit(0)
+	}
+
+	// A repeatable simulation, see simClock.go
+	if p.Seed != 0 {
+		SetSeed(p.Seed)
+	}
+	if p.VirtualClock > 0 {
+		StartVirtualClock(time.Duration(p.VirtualClock) * time.Millisecond)
+	}
+
 	s.PortNumber = 8088
 	s.ControlPanelPort = 8090
 	logPort = p.LogPort
@@ -209,6 +232,36 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	s.UseLogstash = p.useLogstash
 	s.LogstashURL = p.logstashURL
 
+	if p.MsgTrace {
+		s.MsgTrace = state.NewMsgTracer(time.Duration(p.MsgTraceSlow) * time.Millisecond)
+	}
+
+	if err := tracing.Setup(p.Tracing, p.TracingEndpoint, s.FactomNodeName); err != nil {
+		panic("Could not start tracing: " + err.Error())
+	}
+
+	wsapi.Thresholds = wsapi.HealthThresholds{
+		MaxBlockGap:       uint32(p.HealthMaxBlockGap),
+		MaxEntrySyncGap:   uint32(p.HealthMaxEntryGap),
+		MinPeers:          p.HealthMinPeers,
+		MaxTimeSinceBlock: time.Duration(p.HealthMaxBlockAge) * time.Second,
+	}
+
+	if p.EntryRules != "" {
+		rules, err := entryValidation.LoadFile(p.EntryRules)
+		if err != nil {
+			panic("Could not load the entry rules: " + err.Error())
+		}
+		s.EntryValidator = rules
+	}
+	if p.ECKeys != "" {
+		keys, err := compose.OpenKeyStore(p.ECKeys, "Bolt", os.Getenv(compose.PasswordEnv))
+		if err != nil {
+			panic("Could not open the entry credit keys: " + err.Error())
+		}
+		s.ComposeKeys = keys
+	}
+
 	go StartProfiler(p.memProfileRate, p.exposeProfiling)
 
 	s.AddPrefix(p.prefix)
@@ -229,7 +282,7 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
 	mLog.Init(p.RuntimeLog, p.Cnt)
 
-	setupFirstAuthority(s)
+	SetupFirstAuthority(s)
 
 	os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "Build", Build))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "balancehash", messages.AckBalanceHash))
@@ -261,6 +314,16 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "selfaddr", s.FactomdLocations))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "rpcuser", s.RpcUser))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "Start 2nd Sync at ht", s.EntryDBHeightComplete))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "msgtrace", p.MsgTrace))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "tracing", p.Tracing))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "bitcoinrpc set", p.BitcoinRPC != ""))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "ethereumrpc set", p.EthereumRPC != ""))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "anchortracking", p.AnchorTracking))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "entryrules", p.EntryRules))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "eckeys", p.ECKeys))
+	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "scenario", p.Scenario))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "seed", p.Seed))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "virtualclock", p.VirtualClock))
 
 	if "" == s.RpcPass {
 		os.Stderr.WriteString(fmt.Sprintf("%20s %s\n", "rpcpass", "is blank"))
@@ -492,7 +555,31 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		startServers(true)
 	}
 
-	// Start the webserver
+	// Track the anchoring of directory blocks, if asked to
+	if p.BitcoinRPC != "" || p.EthereumRPC != "" || p.AnchorTracking {
+		tracker := anchor.NewTracker(fnodes[0].State.DB, databaseOverlay.AnchorSigPublicKeys)
+		if p.BitcoinRPC != "" {
+			tracker.AddBackend(anchor.NewBitcoinRPC(p.BitcoinRPC))
+		}
+		if p.EthereumRPC != "" {
+			tracker.AddBackend(anchor.NewEthereumRPC(p.EthereumRPC))
+		}
+		fnodes[0].State.Anchor = tracker
+		go tracker.Run(time.Minute, nil)
+	}
+
+	// Only simulated links can be faulted from the debug API
+	if simulating() {
+		network := simNetwork()
+		for _, fnode := range fnodes {
+			fnode.State.SimNetwork = network
+		}
+	}
+
+	// Start the webserver.  The load methods only work when simulating
+	if simulating() {
+		wsapi.LoadGenerator = simLoad
+	}
 	go wsapi.Start(fnodes[0].State)
 
 	// Start prometheus on port
@@ -505,6 +592,16 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
 	go controlPanel.ServeControlPanel(fnodes[0].State.ControlPanelChannel, fnodes[0].State, connectionMetricsChannel, p2pNetwork, Build)
 
+	if p.Scenario != "" {
+		sc, err := LoadScenario(p.Scenario)
+		if err != nil {
+			panic("Could not load the scenario: " + err.Error())
+		}
+		go RunScenarioAndExit(sc)
+	} else if p.VirtualClock > 0 {
+		go runVirtualClock()
+	}
+
 	SimControl(p.ListenTo, listenToStdin)
 
 }
@@ -513,6 +610,12 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 // Functions that access variables in this method to set up Factom Nodes
 // and start the servers.
 //**********************************************************************
+// simulating is true when this factomd runs a network of simulated nodes,
+// rather than a single node
+func simulating() bool {
+	return len(fnodes) > 1
+}
+
 func makeServer(s *state.State) *FactomNode {
 	// All other states are clones of the first state.  Which this routine
 	// gets passed to it.
@@ -524,6 +627,10 @@ func makeServer(s *state.State) *FactomNode {
 		newState.Init()
 	}
 
+	if virtualClock != nil {
+		newState.Clock = newNodeClock(virtualClock)
+	}
+
 	fnode := new(FactomNode)
 	fnode.State = newState
 	fnodes = append(fnodes, fnode)
@@ -537,17 +644,44 @@ func startServers(load bool) {
 		if i > 0 {
 			fnode.State.Init()
 		}
-		go NetworkProcessorNet(fnode)
-		if load {
-			go state.LoadDatabase(fnode.State)
-		}
-		go fnode.State.GoSyncEntries()
-		go Timer(fnode.State)
-		go fnode.State.ValidatorLoop()
+		StartNode(fnode, load)
+	}
+}
+
+// StartNode starts the goroutines of a node, loading its database first if
+// load is set.  They run until StopNode.
+func StartNode(fnode *FactomNode, load bool) {
+	fnode.State.IsRunning = true
+	go NetworkProcessorNet(fnode)
+	if load {
+		go state.LoadDatabase(fnode.State)
+	}
+	go fnode.State.GoSyncEntries()
+	go Timer(fnode.State)
+	go fnode.State.ValidatorLoop()
+}
+
+// StopNode shuts a node down, closing its database, and waits for its
+// validator to stop.  Its other goroutines see it stopped and return.
+func StopNode(fnode *FactomNode) {
+	if !fnode.State.Running() {
+		return
+	}
+	fnode.State.ShutdownChan <- 0
+	for fnode.State.Running() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// Wake the goroutines waiting on the output queues
+	fnode.State.NetworkOutMsgQueue().Enqueue(nil)
+	select {
+	case fnode.State.NetworkInvalidMsgQueue() <- nil:
+	default:
 	}
 }
 
-func setupFirstAuthority(s *state.State) {
+// SetupFirstAuthority makes the bootstrap identity of the network the first
+// authority, unless authorities were loaded by a fast boot
+func SetupFirstAuthority(s *state.State) {
 	var id identity.Identity
 	if len(s.Authorities) > 0 {
 		//Don't initialize first authority if we are loading during fast boot
@@ -13,6 +13,7 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/log"
+	"github.com/FactomProject/factomd/state"
 )
 
 var _ = log.Printf
@@ -83,7 +84,7 @@ func Peers(fnode *FactomNode) {
 		return false
 	}
 
-	for {
+	for fnode.State.Running() {
 		for i := 0; i < 100 && fnode.State.APIQueue().Length() > 0; i++ {
 			msg := fnode.State.APIQueue().Dequeue()
 			if msg != nil {
@@ -110,6 +111,7 @@ func Peers(fnode *FactomNode) {
 					fnode.State.GetTimestamp()) {
 					//fnode.MLog.add2(fnode, false, fnode.State.FactomNodeName, "API", true, msg)
 					if fnode.State.InMsgQueue().Length() < 9000 {
+						fnode.State.MsgTrace.Stamp(msg, state.TraceNetIn)
 						fnode.State.InMsgQueue().Enqueue(msg)
 					}
 				} else {
@@ -174,6 +176,7 @@ func Peers(fnode *FactomNode) {
 
 					// Ignore messages if there are too many.
 					if fnode.State.InMsgQueue().Length() < 9000 && !ignoreMsg(msg) {
+						fnode.State.MsgTrace.Stamp(msg, state.TraceNetIn)
 						fnode.State.InMsgQueue().Enqueue(msg)
 					}
 				} else {
@@ -190,12 +193,15 @@ func Peers(fnode *FactomNode) {
 }
 
 func NetworkOutputs(fnode *FactomNode) {
-	for {
+	for fnode.State.Running() {
 		// if len(fnode.State.NetworkOutMsgQueue()) > 500 {
 		// 	fmt.Print(fnode.State.GetFactomNodeName(), "-", len(fnode.State.NetworkOutMsgQueue()), " ")
 		// }
 		//msg := <-fnode.State.NetworkOutMsgQueue()
 		msg := fnode.State.NetworkOutMsgQueue().BlockingDequeue()
+		if msg == nil {
+			continue // Woken up by StopNode
+		}
 		NetworkOutTotalDequeue.Inc()
 
 		// Local Messages are Not broadcast out.  This is mostly the block signature
@@ -270,7 +276,7 @@ func NetworkOutputs(fnode *FactomNode) {
 
 // Just throw away the trash
 func InvalidOutputs(fnode *FactomNode) {
-	for {
+	for fnode.State.Running() {
 		time.Sleep(1 * time.Millisecond)
 		_ = <-fnode.State.NetworkInvalidMsgQueue()
 		//fmt.Println(invalidMsg)
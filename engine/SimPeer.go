@@ -9,16 +9,18 @@ import (
 	"fmt"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
 	"math/rand"
-	"time"
+	"sync"
 )
 
 var _ = fmt.Print
 var _ = bytes.Compare
 
 type SimPacket struct {
-	data []byte
-	sent int64 // Time in milliseconds
+	data    []byte
+	sent    int64 // Time in milliseconds
+	deliver int64 // Time in milliseconds it may be recieved, with the link's latency and bandwidth
 }
 
 type SimPeer struct {
@@ -35,11 +37,20 @@ type SimPeer struct {
 	// Were we hold delayed packets
 	Delayed *SimPacket
 
+	// Faults injected on this link, see simFaults.go
+	faultMutex sync.Mutex
+	rand       *rand.Rand // Seeded by the link, see simClock.go
+	faults     interfaces.LinkFaults
+	held       *SimPacket // A packet held back to be reordered
+	busyUntil  int64      // Time in milliseconds a bandwidth capped link is sending until
+
 	bytesOut int // Bytes sent out
 	bytesIn  int // Bytes recieved
 
 	Last int64 // Last time reset (nano seconds)
 
+	clock primitives.Clock // The clock of the node sending, the primitives clock if nil
+
 	RateOut int // Rate of Bytes output per ms
 	RateIn  int // Rate of Bytes input per ms
 }
@@ -87,10 +98,18 @@ func (f *SimPeer) Init(fromName, toName string) interfaces.IPeer {
 	f.ToName = toName
 	f.FromName = fromName
 	f.BroadcastOut = make(chan *SimPacket, 10000)
-	f.Last = time.Now().UnixNano()
+	f.rand = linkRand(fromName, toName)
+	f.Last = f.getClock().Now().UnixNano()
 	return f
 }
 
+func (f *SimPeer) getClock() primitives.Clock {
+	if f.clock == nil {
+		return primitives.GetClock()
+	}
+	return f.clock
+}
+
 func (f *SimPeer) GetNameFrom() string {
 	return f.FromName
 }
@@ -99,7 +118,7 @@ func (f *SimPeer) GetNameTo() string {
 }
 
 func (f *SimPeer) computeBandwidth() {
-	now := time.Now().UnixNano()
+	now := f.getClock().Now().UnixNano()
 	delta := (now - f.Last) / 1000000000 // Make delta seconds
 	if delta < 5 {
 		// Wait atleast 5 seconds.
@@ -121,8 +140,8 @@ func (f *SimPeer) Send(msg interfaces.IMsg) error {
 		return err
 	}
 	if len(f.BroadcastOut) < 9000 {
-		packet := SimPacket{data: data, sent: time.Now().UnixNano() / 1000000}
-		f.BroadcastOut <- &packet
+		packet := SimPacket{data: data, sent: f.getClock().Now().UnixNano() / 1000000}
+		f.sendPacket(msg, &packet)
 	}
 	return nil
 }
@@ -139,16 +158,18 @@ func (f *SimPeer) Recieve() (interfaces.IMsg, error) {
 			return nil, nil // Nothing to do
 		}
 		if f.Delay > 0 {
-			f.DelayUse = rand.Int63n(f.Delay)
+			f.faultMutex.Lock()
+			f.DelayUse = f.rand.Int63n(f.Delay)
+			f.faultMutex.Unlock()
 		} else {
 			f.DelayUse = 0
 		}
 
 	}
 
-	now := time.Now().UnixNano() / 1000000
+	now := f.getClock().Now().UnixNano() / 1000000
 
-	if f.Delayed != nil && now-f.Delayed.sent > f.DelayUse {
+	if f.Delayed != nil && now-f.Delayed.sent > f.DelayUse && now >= f.Delayed.deliver {
 		data := f.Delayed.data
 		f.Delayed = nil
 		msg, err := messages.UnmarshalMessage(data)
@@ -193,8 +214,8 @@ func AddSimPeer(fnodes []*FactomNode, i1 int, i2 int) {
 
 	fmt.Println(i1, " -- ", i2)
 
-	peer12 := new(SimPeer).Init(f1.State.FactomNodeName, f2.State.FactomNodeName).(*SimPeer)
-	peer21 := new(SimPeer).Init(f2.State.FactomNodeName, f1.State.FactomNodeName).(*SimPeer)
+	peer12 := (&SimPeer{clock: linkClock(f1.State)}).Init(f1.State.FactomNodeName, f2.State.FactomNodeName).(*SimPeer)
+	peer21 := (&SimPeer{clock: linkClock(f2.State)}).Init(f2.State.FactomNodeName, f1.State.FactomNodeName).(*SimPeer)
 	peer12.BroadcastIn = peer21.BroadcastOut
 	peer21.BroadcastIn = peer12.BroadcastOut
 
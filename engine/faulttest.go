@@ -10,6 +10,8 @@ import (
 
 	"math/rand"
 	"os"
+
+	"github.com/FactomProject/factomd/common/primitives"
 )
 
 func waitToKill(k *bool) {
@@ -17,9 +19,9 @@ func waitToKill(k *bool) {
 	for t > 0 {
 		os.Stderr.WriteString(fmt.Sprintf("     Will kill some servers in about %d seconds\n", t))
 		if t < 30 {
-			time.Sleep(time.Duration(t) * time.Second)
+			primitives.Sleep(time.Duration(t) * time.Second)
 		} else {
-			time.Sleep(30 * time.Second)
+			primitives.Sleep(30 * time.Second)
 		}
 		t -= 30
 	}
@@ -36,9 +38,9 @@ func bringback(f *FactomNode) {
 		}
 		os.Stderr.WriteString(fmt.Sprintf("  Bringing %s back in %d seconds.\n", f.State.FactomNodeName, t))
 		if t < 30 {
-			time.Sleep(time.Duration(t) * time.Second)
+			primitives.Sleep(time.Duration(t) * time.Second)
 		} else {
-			time.Sleep(30 * time.Second)
+			primitives.Sleep(30 * time.Second)
 		}
 		t -= 30
 	}
@@ -58,7 +60,7 @@ func offlineReport(faulting *bool) {
 			os.Stderr.WriteString(stmt + "\n")
 		}
 
-		time.Sleep(20 * time.Second)
+		primitives.Sleep(20 * time.Second)
 	}
 
 }
@@ -140,7 +142,7 @@ func faultTest(faulting *bool) {
 			killsome = false
 			// Wait some random amount of time.
 			delta := rand.Int() % 20
-			time.Sleep(time.Duration(delta) * time.Second)
+			primitives.Sleep(time.Duration(delta) * time.Second)
 
 			kill := 1
 			maxLeadersToKill := numleaders / 2
@@ -162,13 +164,13 @@ func faultTest(faulting *bool) {
 					leaders[n].State.SetNetStateOff(true)
 					go bringback(leaders[n])
 					i++
-					time.Sleep(time.Duration(rand.Int()%40) * time.Second)
+					primitives.Sleep(time.Duration(rand.Int()%40) * time.Second)
 					totalServerFaults++
 				}
 			}
 
 		} else {
-			time.Sleep(1 * time.Second)
+			primitives.Sleep(1 * time.Second)
 		}
 	}
 }
@@ -0,0 +1,391 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/state"
+)
+
+// LoadGenerator submits a mix of new chains, entries and factoid
+// transactions to a simulated node at target rates.  It pays with the
+// simulator's funded keys, buying entry credits as the load needs them, and
+// times how long each item takes to be acknowledged and to be in a block.
+//
+// When stopped, it stops submitting, but keeps timing what it submitted for
+// up to three blocks more.
+type LoadGenerator struct {
+	mutex   sync.Mutex
+	config  interfaces.LoadConfig
+	running bool // Until the timing is done
+	loading bool // Until stopped
+	stop    chan struct{}
+	start   time.Time
+	end     time.Time
+
+	chains  []interfaces.IHash // Acknowledged chains the load made
+	pending []*loadItem
+	counts  [3]loadCount
+	made    int
+	funded  time.Time
+}
+
+// The kinds of load, indexes into counts
+const (
+	loadChain = iota
+	loadEntry
+	loadTransaction
+)
+
+type loadItem struct {
+	kind      int
+	hash      interfaces.IHash // The entry hash, or transaction ID
+	chainID   interfaces.IHash // The chain a first entry makes
+	submitted time.Time
+	acked     bool
+}
+
+type loadCount struct {
+	interfaces.LoadCount
+	ackTotal   int64
+	blockTotal int64
+}
+
+var _ interfaces.ILoadGenerator = (*LoadGenerator)(nil)
+
+// simLoad is the load generator of the simulator, for the debug API and
+// scenarios
+var simLoad = new(LoadGenerator)
+
+// DefaultLoadSize is the content of an entry, in bytes, when the load does
+// not give it
+var DefaultLoadSize int = 100
+
+// StartLoad checks the config, and starts submitting the load
+func (g *LoadGenerator) StartLoad(config interfaces.LoadConfig) error {
+	if err := simNetwork().checkNodes(config.Node); err != nil || config.Node < 0 {
+		return fmt.Errorf("There is no node %d, there are %d nodes", config.Node, len(fnodes))
+	}
+	if config.Chains < 0 || config.Entries < 0 || config.Transactions < 0 {
+		return fmt.Errorf("Negative rates")
+	}
+	if config.Chains+config.Entries+config.Transactions == 0 {
+		return fmt.Errorf("No load given")
+	}
+	if config.Size < 0 || config.Size > 10000 { // Entries are at most 10KiB
+		return fmt.Errorf("The size must be between 0 and 10000 bytes")
+	}
+	if config.Size == 0 {
+		config.Size = DefaultLoadSize
+	}
+	if config.Duration < 0 {
+		return fmt.Errorf("A negative duration")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.running {
+		return fmt.Errorf("A load is already running")
+	}
+	g.config = config
+	g.running = true
+	g.loading = true
+	g.stop = make(chan struct{})
+	g.start = primitives.Now()
+	g.end = time.Time{}
+	g.chains = nil
+	g.pending = nil
+	g.counts = [3]loadCount{}
+	g.funded = time.Time{}
+	go g.run(fnodes[config.Node].State, g.stop)
+	return nil
+}
+
+// StopLoad stops submitting the load
+func (g *LoadGenerator) StopLoad() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.loading {
+		g.loading = false
+		g.end = primitives.Now()
+		close(g.stop)
+	}
+}
+
+// timing returns true until the load has stopped, and what it submitted
+// has been timed
+func (g *LoadGenerator) timing() bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.running
+}
+
+func (g *LoadGenerator) GetLoadReport() interfaces.LoadReport {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	var r interfaces.LoadReport
+	r.Running = g.loading
+	r.Config = g.config
+	if !g.start.IsZero() {
+		end := g.end
+		if end.IsZero() {
+			end = primitives.Now()
+		}
+		r.Seconds = int64(end.Sub(g.start).Seconds())
+	}
+	for kind, c := range g.counts {
+		count := c.LoadCount
+		if count.Acked > 0 {
+			count.Ack.Mean = c.ackTotal / int64(count.Acked)
+		}
+		if count.InBlock > 0 {
+			count.Block.Mean = c.blockTotal / int64(count.InBlock)
+		}
+		switch kind {
+		case loadChain:
+			r.Chains = count
+		case loadEntry:
+			r.Entries = count
+		case loadTransaction:
+			r.Transactions = count
+		}
+	}
+	return r
+}
+
+func (g *LoadGenerator) run(st *state.State, stop chan struct{}) {
+	defer func() {
+		g.mutex.Lock()
+		g.running = false
+		g.mutex.Unlock()
+	}()
+	if err := g.fund(st); err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("Load: could not buy entry credits: %v\n", err))
+	}
+
+	for {
+		select {
+		case <-stop:
+			g.drain(st)
+			return
+		default:
+		}
+		if g.config.Duration > 0 && primitives.Since(g.start) >= time.Duration(g.config.Duration)*time.Second {
+			g.StopLoad()
+			continue
+		}
+
+		if err := g.fund(st); err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("Load: could not buy entry credits: %v\n", err))
+		}
+		seconds := primitives.Since(g.start).Seconds()
+		for g.owed(loadChain, g.config.Chains, seconds) {
+			g.submitEntry(st, true)
+		}
+		for g.owed(loadEntry, g.config.Entries, seconds) && g.hasChains(st) {
+			g.submitEntry(st, false)
+		}
+		for g.owed(loadTransaction, g.config.Transactions, seconds) {
+			g.submitTransaction(st)
+		}
+		g.track(st)
+		primitives.Sleep(100 * time.Millisecond)
+	}
+}
+
+// drain times the load submitted for up to three blocks after it stopped
+func (g *LoadGenerator) drain(st *state.State) {
+	deadline := primitives.Now().Add(3 * time.Duration(st.GetDirectoryBlockInSeconds()) * time.Second)
+	for primitives.Now().Before(deadline) && g.track(st) > 0 {
+		primitives.Sleep(100 * time.Millisecond)
+	}
+}
+
+// owed returns true if fewer of a kind of load were submitted than the rate
+// asks for by now
+func (g *LoadGenerator) owed(kind int, rate float64, seconds float64) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return float64(g.counts[kind].Submitted) < rate*seconds
+}
+
+// hasChains returns true if there are chains to put entries in.  Without
+// any, the load makes one.
+func (g *LoadGenerator) hasChains(st *state.State) bool {
+	g.mutex.Lock()
+	n := len(g.chains)
+	making := g.counts[loadChain].Submitted - g.counts[loadChain].Failed
+	g.mutex.Unlock()
+	if n == 0 && making == 0 {
+		g.submitEntry(st, true)
+	}
+	return n > 0
+}
+
+// fund buys entry credits for a minute of load, when there are less than
+// enough for 20 seconds, and none were bought in the last 10 seconds
+func (g *LoadGenerator) fund(st *state.State) error {
+	priv, err := hex.DecodeString(ecSec)
+	if err != nil {
+		return err
+	}
+	pub, err := primitives.PrivateKeyToPublicKey(priv)
+	if err != nil {
+		return err
+	}
+	sample := entryBlock.NewEntry()
+	sample.Content = primitives.ByteSlice{Bytes: make([]byte, g.config.Size+50)} // With room for the external IDs
+	cost, err := compose.EntryCost(sample)
+	if err != nil {
+		return err
+	}
+	perSecond := g.config.Chains*float64(compose.NewChainCost+int(cost)) + g.config.Entries*float64(cost)
+	if perSecond == 0 {
+		return nil
+	}
+	balance := st.GetFactoidState().GetECBalance(factoid.NewAddress(pub).Fixed())
+	if float64(balance) >= 20*perSecond || primitives.Since(g.funded) < 10*time.Second {
+		return nil
+	}
+	g.funded = primitives.Now()
+	return fundWallet(st, uint64(60*perSecond+1)*st.GetFactoshisPerEC())
+}
+
+// newEntry makes an entry of the load, in chainID, or the first entry of a
+// new chain if nil
+func (g *LoadGenerator) newEntry(chainID interfaces.IHash) *entryBlock.Entry {
+	g.made++
+	entry := entryBlock.NewEntry()
+	content := []byte(fmt.Sprintf("Load %d %d ", g.start.UnixNano(), g.made))
+	for len(content) < g.config.Size {
+		content = append(content, '.')
+	}
+	entry.Content = primitives.ByteSlice{Bytes: content}
+	if chainID == nil {
+		entry.ExtIDs = []primitives.ByteSlice{
+			{Bytes: []byte("load")},
+			{Bytes: []byte(fmt.Sprintf("%d %d", g.start.UnixNano(), g.made))},
+		}
+		entry.ChainID = entryBlock.NewChainID(entry)
+	} else {
+		entry.ChainID = chainID
+	}
+	return entry
+}
+
+func (g *LoadGenerator) submitEntry(st *state.State, newChain bool) {
+	priv, _ := hex.DecodeString(ecSec)
+	g.mutex.Lock()
+	var entry *entryBlock.Entry
+	kind := loadChain
+	if newChain {
+		entry = g.newEntry(nil)
+	} else {
+		kind = loadEntry
+		entry = g.newEntry(g.chains[g.made%len(g.chains)])
+	}
+	g.counts[kind].Submitted++
+	g.mutex.Unlock()
+
+	err := SubmitEntry(st, entry, priv, newChain)
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if err != nil {
+		g.counts[kind].Failed++
+		return
+	}
+	item := &loadItem{kind: kind, hash: entry.GetHash(), submitted: primitives.Now()}
+	if newChain {
+		item.chainID = entry.ChainID
+	}
+	g.pending = append(g.pending, item)
+}
+
+func (g *LoadGenerator) submitTransaction(st *state.State) {
+	g.mutex.Lock()
+	g.made++
+	to := factoid.NewAddress(primitives.Sha([]byte(fmt.Sprintf("Load %d %d", g.start.UnixNano(), g.made))).Bytes())
+	g.counts[loadTransaction].Submitted++
+	g.mutex.Unlock()
+
+	trans := new(factoid.Transaction)
+	trans.AddOutput(to, 1e6)
+	txid, err := SubmitFaucetTransaction(st, trans, 1e6)
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if err != nil {
+		g.counts[loadTransaction].Failed++
+		return
+	}
+	g.pending = append(g.pending, &loadItem{kind: loadTransaction, hash: txid, submitted: primitives.Now()})
+}
+
+// track times the pending load that was acknowledged or put in a block, and
+// returns how much is still pending
+func (g *LoadGenerator) track(st *state.State) int {
+	g.mutex.Lock()
+	pending := g.pending
+	g.mutex.Unlock()
+
+	var still []*loadItem
+	for _, item := range pending {
+		status, _, _, _, err := st.GetSpecificACKStatus(item.hash)
+		if err != nil {
+			still = append(still, item)
+			continue
+		}
+		ms := primitives.Since(item.submitted).Nanoseconds() / 1e6
+
+		g.mutex.Lock()
+		c := &g.counts[item.kind]
+		if (status == constants.AckStatusACK || status == constants.AckStatusDBlockConfirmed) && !item.acked {
+			item.acked = true
+			c.Acked++
+			c.ackTotal += ms
+			c.Ack = addLatency(c.Ack, ms, c.Acked)
+			if item.chainID != nil {
+				g.chains = append(g.chains, item.chainID)
+			}
+		}
+		if status == constants.AckStatusDBlockConfirmed {
+			c.InBlock++
+			c.blockTotal += ms
+			c.Block = addLatency(c.Block, ms, c.InBlock)
+		} else {
+			still = append(still, item)
+		}
+		g.mutex.Unlock()
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	// Keep what was submitted while tracking
+	g.pending = append(still, g.pending[len(pending):]...)
+	return len(g.pending)
+}
+
+// addLatency adds a time in milliseconds to the min and max of cnt times
+func addLatency(l interfaces.LoadLatency, ms int64, cnt int) interfaces.LoadLatency {
+	if cnt == 1 || ms < l.Min {
+		l.Min = ms
+	}
+	if ms > l.Max {
+		l.Max = ms
+	}
+	return l
+}
@@ -0,0 +1,26 @@
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestLoadGeneratorConfig(t *testing.T) {
+	g := new(LoadGenerator)
+	for _, config := range []interfaces.LoadConfig{
+		{},
+		{Node: -1, Entries: 1},
+		{Entries: -1},
+		{Entries: 1, Size: 10001},
+		{Entries: 1, Duration: -1},
+	} {
+		if err := g.StartLoad(config); err == nil {
+			t.Errorf("Expected an error for %+v", config)
+		}
+	}
+	if r := g.GetLoadReport(); r.Running || r.Seconds != 0 || r.Entries.Submitted != 0 {
+		t.Errorf("Expected an empty report, got %+v", r)
+	}
+}
@@ -0,0 +1,620 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// A Scenario scripts a simulation, so it can run unattended, given to
+// factomd with -scenario.  Steps run in order; each waits for its trigger,
+// then runs its action.  A failed assertion, or running past the timeout,
+// fails the scenario.  Times are on the primitives clock, so virtual with
+// -virtualclock, when the scenario steps the clock as it waits.  For
+// example:
+//
+//	{
+//	  "name": "leader goes offline",
+//	  "timeout": 900,
+//	  "steps": [
+//	    {"height": 2, "action": "offline", "nodes": [1]},
+//	    {"at": 120, "action": "assert-height", "nodes": [0, 2], "min": 4, "within": 300},
+//	    {"action": "online", "nodes": [1]},
+//	    {"action": "assert-height", "nodes": [1], "min": 4, "within": 300}
+//	  ]
+//	}
+type Scenario struct {
+	Name    string         `json:"name"`
+	Timeout int            `json:"timeout"` // Seconds the scenario may run, 0 for no limit
+	Steps   []ScenarioStep `json:"steps"`
+}
+
+// ScenarioStep is one step of a Scenario.  Nodes are given by their index in
+// the simulation, 0 being the first.  The actions are:
+//
+//	wait                Nothing, just wait for the trigger
+//	offline, online     Take nodes off the network, or bring them back
+//	partition           Cut the links between nodes of different groups, only
+//	                    from a group to the groups after it if oneway
+//	heal                Remove the faults of every link
+//	link                Inject faults on the links from one node to another
+//	drop                Drop rate messages per 1000 on the links from one node to another
+//	delay               Hold messages up to ms milliseconds on the links from one node to another, as a uniform latency
+//	identities          Add count identities to the pool promoted nodes are given
+//	leader, audit       Make nodes leaders or audit servers
+//	demote              Remove nodes from the authority set
+//	load                Make chains new chains, and submit count entries into them
+//	start-load          Start the load generator, submitting load at its rates
+//	stop-load           Stop the load generator, and report its latencies
+//	assert-height       The highest saved block of the nodes is between min and max
+//	assert-authorities  The nodes see exactly the leaders and audits given
+//	assert-balance      The balance of an FA or EC address is between min and max
+//
+// Assertions check every node online when no nodes are given.
+type ScenarioStep struct {
+	// Trigger.  With neither, the step runs as soon as the step before it is done
+	At     int    `json:"at,omitempty"`     // Seconds after the scenario started
+	Height uint32 `json:"height,omitempty"` // Once Node has saved this directory block
+	Node   int    `json:"node,omitempty"`
+
+	Action string `json:"action"`
+
+	Nodes  []int                  `json:"nodes,omitempty"`  // offline, online, leader, audit, demote and assertions
+	Groups [][]int                `json:"groups,omitempty"` // partition
+	Oneway bool                   `json:"oneway,omitempty"` // partition
+	From   *int                   `json:"from,omitempty"`   // link, drop and delay; every node if not given
+	To     *int                   `json:"to,omitempty"`     // link, drop and delay; every node if not given
+	Faults *interfaces.LinkFaults `json:"faults,omitempty"` // link
+	Rate   int                    `json:"rate,omitempty"`   // drop, messages dropped per 1000
+	Ms     int64                  `json:"ms,omitempty"`     // delay, the most milliseconds a message is held
+	Count  int                    `json:"count,omitempty"`  // identities to make, or entries to load
+	Chains int                    `json:"chains,omitempty"` // chains to load
+	Load   *interfaces.LoadConfig `json:"load,omitempty"`   // start-load
+
+	// Assertions
+	Min     *int64 `json:"min,omitempty"`
+	Max     *int64 `json:"max,omitempty"`
+	Leaders []int  `json:"leaders,omitempty"`
+	Audits  []int  `json:"audits,omitempty"`
+	Address string `json:"address,omitempty"`
+	Within  int    `json:"within,omitempty"` // Seconds an assertion may take to hold
+}
+
+var scenarioActions map[string]bool = map[string]bool{
+	"wait":               true,
+	"offline":            true,
+	"online":             true,
+	"partition":          true,
+	"heal":               true,
+	"link":               true,
+	"drop":               true,
+	"delay":              true,
+	"identities":         true,
+	"leader":             true,
+	"audit":              true,
+	"demote":             true,
+	"load":               true,
+	"start-load":         true,
+	"stop-load":          true,
+	"assert-height":      true,
+	"assert-authorities": true,
+	"assert-balance":     true,
+}
+
+// LoadScenario reads a Scenario from a JSON file
+func LoadScenario(filename string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScenario(data)
+}
+
+// ParseScenario reads a Scenario from JSON, and checks its steps
+func ParseScenario(data []byte) (*Scenario, error) {
+	sc := new(Scenario)
+	if err := json.Unmarshal(data, sc); err != nil {
+		return nil, err
+	}
+	if sc.Timeout < 0 {
+		return nil, fmt.Errorf("A negative timeout")
+	}
+	for i, step := range sc.Steps {
+		if err := step.check(); err != nil {
+			return nil, fmt.Errorf("Step %d (%s): %v", i, step.Action, err)
+		}
+	}
+	return sc, nil
+}
+
+func (step *ScenarioStep) check() error {
+	if scenarioActions[step.Action] == false {
+		return fmt.Errorf("Unknown action")
+	}
+	if step.At < 0 || step.Within < 0 {
+		return fmt.Errorf("Times must not be negative")
+	}
+	switch step.Action {
+	case "offline", "online", "leader", "audit", "demote":
+		if len(step.Nodes) == 0 {
+			return fmt.Errorf("No nodes given")
+		}
+	case "partition":
+		if len(step.Groups) < 2 {
+			return fmt.Errorf("A partition needs at least two groups")
+		}
+	case "link":
+		if step.Faults == nil {
+			return fmt.Errorf("No faults given")
+		}
+		return CheckLinkFaults(*step.Faults)
+	case "drop":
+		if step.Rate < 0 || step.Rate > 1000 {
+			return fmt.Errorf("The rate must be between 0 and 1000")
+		}
+	case "delay":
+		if step.Ms < 0 {
+			return fmt.Errorf("A negative delay")
+		}
+	case "identities":
+		if step.Count <= 0 || step.Count > 100 {
+			return fmt.Errorf("The count must be between 1 and 100")
+		}
+	case "load":
+		if step.Count < 0 || step.Chains < 0 || step.Count+step.Chains == 0 {
+			return fmt.Errorf("Nothing to load")
+		}
+	case "start-load":
+		if step.Load == nil {
+			return fmt.Errorf("No load given")
+		}
+	case "assert-height":
+		if step.Min == nil && step.Max == nil {
+			return fmt.Errorf("Give a min or a max")
+		}
+	case "assert-authorities":
+		if step.Leaders == nil && step.Audits == nil {
+			return fmt.Errorf("Give the leaders or the audit servers")
+		}
+	case "assert-balance":
+		if primitives.ValidateFUserStr(step.Address) == false && primitives.ValidateECUserStr(step.Address) == false {
+			return fmt.Errorf("Invalid address %q", step.Address)
+		}
+		if step.Min == nil && step.Max == nil {
+			return fmt.Errorf("Give a min or a max")
+		}
+	}
+	return nil
+}
+
+// CheckNodes checks every node a step names is one of the cnt nodes
+// simulated
+func (sc *Scenario) CheckNodes(cnt int) error {
+	for i, step := range sc.Steps {
+		nodes := append([]int{step.Node}, step.Nodes...)
+		nodes = append(nodes, step.Leaders...)
+		nodes = append(nodes, step.Audits...)
+		for _, g := range step.Groups {
+			nodes = append(nodes, g...)
+		}
+		if step.From != nil {
+			nodes = append(nodes, *step.From)
+		}
+		if step.To != nil {
+			nodes = append(nodes, *step.To)
+		}
+		if step.Load != nil {
+			nodes = append(nodes, step.Load.Node)
+		}
+		for _, n := range nodes {
+			if n < 0 || n >= cnt {
+				return fmt.Errorf("Step %d (%s): there is no node %d, there are %d nodes", i, step.Action, n, cnt)
+			}
+		}
+	}
+	return nil
+}
+
+// scenarioRun is the state of a running scenario
+type scenarioRun struct {
+	sc       *Scenario
+	start    time.Time
+	deadline time.Time
+	chains   []interfaces.IHash // Chains made by load steps
+	loaded   int
+}
+
+// RunScenario runs a scenario against the simulated nodes, and returns the
+// first failure
+func RunScenario(sc *Scenario) error {
+	if err := sc.CheckNodes(len(fnodes)); err != nil {
+		return err
+	}
+	r := new(scenarioRun)
+	r.sc = sc
+	r.start = primitives.Now()
+	if sc.Timeout > 0 {
+		r.deadline = r.start.Add(time.Duration(sc.Timeout) * time.Second)
+	}
+
+	for i := range sc.Steps {
+		step := &sc.Steps[i]
+		if err := r.waitFor(step); err != nil {
+			return fmt.Errorf("Step %d (%s): %v", i, step.Action, err)
+		}
+		os.Stderr.WriteString(fmt.Sprintf("Scenario %s: step %d, %s\n", sc.Name, i, step.Action))
+		if err := r.run(step); err != nil {
+			return fmt.Errorf("Step %d (%s): %v", i, step.Action, err)
+		}
+	}
+	return nil
+}
+
+// RunScenarioAndExit runs a scenario, then exits factomd, with 1 if the
+// scenario failed
+func RunScenarioAndExit(sc *Scenario) {
+	err := RunScenario(sc)
+	if err != nil {
+		os.Stderr.WriteString(fmt.Sprintf("Scenario %s FAILED: %v\n", sc.Name, err))
+		os.Exit(1)
+	}
+	os.Stderr.WriteString(fmt.Sprintf("Scenario %s passed, %s\n", sc.Name, lastBlock()))
+	os.Exit(0)
+}
+
+// lastBlock describes the highest block saved by the first node, so runs of
+// a repeatable simulation can be compared
+func lastBlock() string {
+	s := fnodes[0].State
+	height := s.GetHighestSavedBlk()
+	dblock := s.GetDirectoryBlockByHeight(height)
+	if dblock == nil {
+		return fmt.Sprintf("node 0 at height %d", height)
+	}
+	return fmt.Sprintf("node 0 at height %d, directory block %s", height, dblock.GetKeyMR().String())
+}
+
+func (r *scenarioRun) timedOut() bool {
+	return r.deadline.IsZero() == false && primitives.Now().After(r.deadline)
+}
+
+func (r *scenarioRun) waitFor(step *ScenarioStep) error {
+	at := r.start.Add(time.Duration(step.At) * time.Second)
+	for primitives.Now().Before(at) || fnodes[step.Node].State.GetHighestSavedBlk() < step.Height {
+		if r.timedOut() {
+			return fmt.Errorf("Timed out waiting for the trigger")
+		}
+		stepClock(100 * time.Millisecond)
+	}
+	return nil
+}
+
+func (r *scenarioRun) run(step *ScenarioStep) error {
+	switch step.Action {
+	case "offline", "online":
+		for _, n := range step.Nodes {
+			fnodes[n].State.SetNetStateOff(step.Action == "offline")
+		}
+	case "partition":
+		return simNetwork().Partition(step.Groups, step.Oneway)
+	case "heal":
+		simNetwork().Heal()
+	case "link":
+		return simNetwork().SetLinkFaults(linkEnd(step.From), linkEnd(step.To), *step.Faults)
+	case "drop":
+		for _, peer := range simNetwork().links(linkEnd(step.From), linkEnd(step.To)) {
+			faults := peer.GetFaults()
+			faults.DropRate = step.Rate
+			peer.SetFaults(faults)
+		}
+	case "delay":
+		for _, peer := range simNetwork().links(linkEnd(step.From), linkEnd(step.To)) {
+			faults := peer.GetFaults()
+			faults.Latency = nil
+			if step.Ms > 0 {
+				faults.Latency = &interfaces.Latency{Distribution: "uniform", Max: step.Ms}
+			}
+			peer.SetFaults(faults)
+		}
+	case "identities":
+		return addIdentities(fnodes[step.Node].State, step.Count)
+	case "leader", "audit":
+		serverType := 0
+		if step.Action == "audit" {
+			serverType = 1
+		}
+		for _, n := range step.Nodes {
+			if err := promote(fnodes[n], serverType); err != nil {
+				return err
+			}
+		}
+	case "demote":
+		for _, n := range step.Nodes {
+			if err := demote(fnodes[n]); err != nil {
+				return err
+			}
+		}
+	case "load":
+		return r.load(fnodes[step.Node].State, step.Chains, step.Count)
+	case "start-load":
+		return simLoad.StartLoad(*step.Load)
+	case "stop-load":
+		simLoad.StopLoad()
+		for simLoad.timing() {
+			stepClock(100 * time.Millisecond)
+		}
+		report, err := json.Marshal(simLoad.GetLoadReport())
+		if err != nil {
+			return err
+		}
+		os.Stderr.WriteString(fmt.Sprintf("Scenario %s: load %s\n", r.sc.Name, report))
+	case "assert-height", "assert-authorities", "assert-balance":
+		return r.assert(step)
+	}
+	return nil
+}
+
+// assert checks an assertion until it holds, or Within seconds have passed
+func (r *scenarioRun) assert(step *ScenarioStep) error {
+	nodes := step.Nodes
+	if len(nodes) == 0 {
+		for i, f := range fnodes {
+			if !f.State.GetNetStateOff() {
+				nodes = append(nodes, i)
+			}
+		}
+	}
+
+	until := primitives.Now().Add(time.Duration(step.Within) * time.Second)
+	for {
+		var err error
+		for _, n := range nodes {
+			if err = checkAssertion(step, n); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			return nil
+		}
+		if primitives.Now().After(until) || r.timedOut() {
+			return err
+		}
+		stepClock(time.Second)
+	}
+}
+
+func checkAssertion(step *ScenarioStep, n int) error {
+	s := fnodes[n].State
+	switch step.Action {
+	case "assert-height":
+		return checkRange(fmt.Sprintf("Node %d height", n), int64(s.GetHighestSavedBlk()), step.Min, step.Max)
+	case "assert-authorities":
+		pl := s.LeaderPL
+		if pl == nil {
+			return fmt.Errorf("Node %d has no process list", n)
+		}
+		if step.Leaders != nil {
+			if err := checkServers(fmt.Sprintf("Node %d leaders", n), pl.FedServers, step.Leaders); err != nil {
+				return err
+			}
+		}
+		if step.Audits != nil {
+			if err := checkServers(fmt.Sprintf("Node %d audit servers", n), pl.AuditServers, step.Audits); err != nil {
+				return err
+			}
+		}
+	case "assert-balance":
+		var address [32]byte
+		copy(address[:], primitives.ConvertUserStrToAddress(step.Address))
+		var balance int64
+		if primitives.ValidateECUserStr(step.Address) {
+			balance = s.GetFactoidState().GetECBalance(address)
+		} else {
+			balance = s.GetFactoidState().GetFactoidBalance(address)
+		}
+		return checkRange(fmt.Sprintf("Node %d balance of %s", n, step.Address), balance, step.Min, step.Max)
+	}
+	return nil
+}
+
+func checkRange(what string, v int64, min, max *int64) error {
+	if min != nil && v < *min {
+		return fmt.Errorf("%s is %d, expected at least %d", what, v, *min)
+	}
+	if max != nil && v > *max {
+		return fmt.Errorf("%s is %d, expected at most %d", what, v, *max)
+	}
+	return nil
+}
+
+// checkServers checks the servers are exactly the identities of the nodes
+func checkServers(what string, servers []interfaces.IServer, nodes []int) error {
+	expected := map[[32]byte]int{}
+	for _, n := range nodes {
+		expected[fnodes[n].State.IdentityChainID.Fixed()] = n
+	}
+	for _, server := range servers {
+		if _, ok := expected[server.GetChainID().Fixed()]; !ok {
+			return fmt.Errorf("%s include %s, which is none of nodes %v", what, server.GetChainID().String()[:10], nodes)
+		}
+		delete(expected, server.GetChainID().Fixed())
+	}
+	for _, n := range expected {
+		return fmt.Errorf("%s do not include node %d", what, n)
+	}
+	return nil
+}
+
+// linkEnd returns the node at an end of a link, -1 for every node
+func linkEnd(n *int) int {
+	if n == nil {
+		return -1
+	}
+	return *n
+}
+
+// addIdentities adds count identities to the pool, as the g command does
+func addIdentities(st *state.State, count int) error {
+	if nextAuthority == -1 {
+		if err := fundWallet(st, 2e7); err != nil {
+			return err
+		}
+		setUpAuthorites(st, true)
+	}
+	if err := fundWallet(st, uint64(count*5e7)); err != nil {
+		return err
+	}
+	auths, _, err := authorityToBlockchain(count, st)
+	if err != nil {
+		return err
+	}
+	if len(auths) < count {
+		return fmt.Errorf("Only %d of %d identities were made", len(auths), count)
+	}
+	return nil
+}
+
+// promote gives the node the next identity of the pool if it has none, and
+// makes it a leader (serverType 0) or an audit server (serverType 1)
+func promote(f *FactomNode, serverType int) error {
+	if f.State.IdentityChainID.String()[:6] != "888888" {
+		index := 0
+		for index < len(authKeyLibrary) && authKeyLibrary[index].Taken {
+			index++
+		}
+		if index >= len(authKeyLibrary) {
+			return fmt.Errorf("No identity left for %s, add some with an identities step", f.State.FactomNodeName)
+		}
+		authKeyLibrary[index].Taken = true
+		f.State.IdentityChainID = authKeyLibrary[index].ChainID
+		key, pKey, _ := authKeyLookup(f.State.IdentityChainID)
+		f.State.LocalServerPrivKey = key
+		f.State.SimSetNewKeys(pKey)
+	}
+
+	msg := messages.NewAddServerMsg(f.State, serverType)
+	priv, err := primitives.NewPrivateKeyFromHex(LOCAL_NET_PRIV_KEY)
+	if err != nil {
+		return err
+	}
+	if err := msg.(*messages.AddServerMsg).Sign(priv); err != nil {
+		return err
+	}
+	f.State.InMsgQueue().Enqueue(msg)
+	return nil
+}
+
+// demote removes the node from the federated or audit servers
+func demote(f *FactomNode) error {
+	serverType := 1
+	if pl := f.State.LeaderPL; pl != nil {
+		for _, fed := range pl.FedServers {
+			if fed.GetChainID().IsSameAs(f.State.IdentityChainID) {
+				serverType = 0
+			}
+		}
+	}
+
+	msg := messages.NewRemoveServerMsg(f.State, f.State.IdentityChainID, serverType)
+	priv, err := primitives.NewPrivateKeyFromHex(LOCAL_NET_PRIV_KEY)
+	if err != nil {
+		return err
+	}
+	if err := msg.(*messages.RemoveServerMsg).Sign(priv); err != nil {
+		return err
+	}
+	f.State.InMsgQueue().Enqueue(msg)
+	return nil
+}
+
+// load funds the simulator's entry credit key, makes chains new chains, and
+// submits count entries round robin into the chains the scenario made
+func (r *scenarioRun) load(st *state.State, chains, count int) error {
+	if len(r.chains) == 0 && chains == 0 {
+		chains = 1
+	}
+	// Generously, as entries may be up to 10k
+	credits := (chains + count) * (compose.NewChainCost + 10)
+	if err := fundWallet(st, uint64(credits)*st.GetFactoshisPerEC()); err != nil {
+		return err
+	}
+	priv, err := hex.DecodeString(ecSec)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < chains+count; i++ {
+		r.loaded++
+		entry := entryBlock.NewEntry()
+		entry.Content = primitives.ByteSlice{Bytes: []byte(fmt.Sprintf("Scenario %s load %d", r.sc.Name, r.loaded))}
+		newChain := i < chains
+		if newChain {
+			entry.ExtIDs = []primitives.ByteSlice{
+				{Bytes: []byte(r.sc.Name)},
+				{Bytes: []byte(fmt.Sprintf("%d %d", r.start.UnixNano(), r.loaded))},
+			}
+			entry.ChainID = entryBlock.NewChainID(entry)
+			r.chains = append(r.chains, entry.ChainID)
+		} else {
+			entry.ChainID = r.chains[r.loaded%len(r.chains)]
+		}
+		if err := SubmitEntry(st, entry, priv, newChain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubmitEntry commits, paying with the entry credit key priv, and reveals an
+// entry, or the first entry of a new chain if newChain
+func SubmitEntry(st *state.State, entry *entryBlock.Entry, priv []byte, newChain bool) error {
+	var commit interfaces.BinaryMarshallable
+	var err error
+	if newChain {
+		commit, err = compose.NewChainCommit(entry, priv, st.GetTimestamp())
+	} else {
+		commit, err = compose.NewEntryCommit(entry, priv, st.GetTimestamp())
+	}
+	if err != nil {
+		return err
+	}
+	commitBin, err := commit.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	entryBin, err := entry.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	commitParams := wsapi.MessageRequest{Message: hex.EncodeToString(commitBin)}
+	var jErr *primitives.JSONError
+	if newChain {
+		_, jErr = wsapi.HandleV2CommitChain(st, commitParams)
+	} else {
+		_, jErr = wsapi.HandleV2CommitEntry(st, commitParams)
+	}
+	if jErr != nil {
+		return fmt.Errorf("%s %v", jErr.Message, jErr.Data)
+	}
+	_, jErr = wsapi.HandleV2RevealEntry(st, wsapi.EntryRequest{Entry: hex.EncodeToString(entryBin)})
+	if jErr != nil {
+		return fmt.Errorf("%s %v", jErr.Message, jErr.Data)
+	}
+	return nil
+}
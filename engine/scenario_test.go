@@ -0,0 +1,66 @@
+package engine_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestParseScenario(t *testing.T) {
+	sc, err := ParseScenario([]byte(`{
+		"name": "test",
+		"timeout": 60,
+		"steps": [
+			{"height": 2, "action": "offline", "nodes": [1]},
+			{"at": 30, "action": "partition", "groups": [[0], [1, 2]]},
+			{"action": "drop", "from": 0, "to": 2, "rate": 500},
+			{"action": "link", "from": 1, "faults": {"latency": {"distribution": "normal", "mean": 200, "stddev": 50}, "drop": [{"type": "EOM", "vm": 2}]}},
+			{"action": "heal"},
+			{"action": "start-load", "load": {"node": 1, "entries": 5, "transactions": 1}},
+			{"action": "stop-load"},
+			{"action": "assert-height", "node": 2, "min": 3, "within": 10},
+			{"action": "assert-balance", "address": "EC3Eh7yQKShgjkUSFrPbnQpboykCzf4kw9QHxi47GGz5P2k3dbab", "max": 0}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if sc.Name != "test" || sc.Timeout != 60 || len(sc.Steps) != 9 {
+		t.Errorf("Wrong scenario %+v", sc)
+	}
+	if sc.Steps[0].Height != 2 || sc.Steps[1].At != 30 || *sc.Steps[2].To != 2 || sc.Steps[3].Faults.Latency.Mean != 200 || sc.Steps[5].Load.Entries != 5 || *sc.Steps[7].Min != 3 {
+		t.Errorf("Wrong steps %+v", sc.Steps)
+	}
+
+	if err := sc.CheckNodes(3); err != nil {
+		t.Errorf("%v", err)
+	}
+	if err := sc.CheckNodes(2); err == nil {
+		t.Errorf("Expected an error for node 2 of 2 nodes")
+	}
+}
+
+func TestParseScenarioErrors(t *testing.T) {
+	for _, s := range []string{
+		`not json`,
+		`{"timeout": -1}`,
+		`{"steps": [{"action": "explode"}]}`,
+		`{"steps": [{"action": "offline"}]}`,
+		`{"steps": [{"action": "partition", "groups": [[0, 1]]}]}`,
+		`{"steps": [{"action": "link"}]}`,
+		`{"steps": [{"action": "link", "faults": {"drop": [{"type": "nothing"}]}}]}`,
+		`{"steps": [{"action": "drop", "rate": 1001}]}`,
+		`{"steps": [{"action": "delay", "ms": -1}]}`,
+		`{"steps": [{"action": "identities", "count": 0}]}`,
+		`{"steps": [{"action": "load"}]}`,
+		`{"steps": [{"action": "start-load"}]}`,
+		`{"steps": [{"action": "assert-height"}]}`,
+		`{"steps": [{"action": "assert-authorities"}]}`,
+		`{"steps": [{"action": "assert-balance", "address": "EC1", "min": 1}]}`,
+		`{"steps": [{"at": -5, "action": "wait"}]}`,
+	} {
+		if _, err := ParseScenario([]byte(s)); err == nil {
+			t.Errorf("Expected an error for %s", s)
+		}
+	}
+}
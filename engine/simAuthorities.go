@@ -116,56 +116,54 @@ func copyOver(st *state.State) {
 }
 
 func fundWallet(st *state.State, amt uint64) error {
-	inSec, _ := primitives.HexToHash("FB3B471B1DCDADFEB856BD0B02D8BF49ACE0EDD372A3D9F2A95B78EC12A324D6")
 	outEC, _ := primitives.HexToHash("c23ae8eec2beb181a0da926bd2344e988149fbe839fbc7489f2096e7d6110243")
+	trans := new(factoid.Transaction)
+	trans.AddECOutput(factoid.NewAddress(outEC.Bytes()), amt)
+	_, err := SubmitFaucetTransaction(st, trans, amt)
+	return err
+}
+
+// SubmitFaucetTransaction pays for the outputs of trans, amt in all, and the
+// fee from the simulator's funded factoid address.  It signs the
+// transaction, submits it to the node, and returns its ID.
+func SubmitFaucetTransaction(st *state.State, trans *factoid.Transaction, amt uint64) (interfaces.IHash, error) {
+	inSec, _ := primitives.HexToHash("FB3B471B1DCDADFEB856BD0B02D8BF49ACE0EDD372A3D9F2A95B78EC12A324D6")
 	inHash, _ := primitives.HexToHash("646F3E8750C550E4582ECA5047546FFEF89C13A175985E320232BACAC81CC428")
 	var sec [64]byte
 	copy(sec[:32], inSec.Bytes())
 
 	pub := ed.GetPublicKey(&sec)
-	//inRcd := shad(inPub.Bytes())
-
 	rcd := factoid.NewRCD_1(pub[:])
-	inAdd := factoid.NewAddress(inHash.Bytes())
-	outAdd := factoid.NewAddress(outEC.Bytes())
-
-	trans := new(factoid.Transaction)
-	trans.AddInput(inAdd, amt)
-	trans.AddECOutput(outAdd, amt)
-
+	trans.AddInput(factoid.NewAddress(inHash.Bytes()), amt)
 	trans.AddRCD(rcd)
-	trans.AddAuthorization(rcd)
 	trans.SetTimestamp(primitives.NewTimestampNow())
 
 	fee, err := trans.CalculateFee(st.GetFactoshisPerEC())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	input, err := trans.GetInput(0)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	input.SetAmount(amt + fee)
 
 	dataSig, err := trans.MarshalBinarySig()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	sig := factoid.NewSingleSignatureBlock(inSec.Bytes(), dataSig)
 	trans.SetSignatureBlock(0, sig)
 
-	t := new(wsapi.TransactionRequest)
-	data, _ := trans.MarshalBinary()
-	t.Transaction = hex.EncodeToString(data)
-	j := primitives.NewJSON2Request("factoid-submit", 0, t)
-	_, err = v2Request(j, st.GetPort())
-	//_, err = wsapi.HandleV2Request(st, j)
+	data, err := trans.MarshalBinary()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_ = err
-
-	return nil
+	t := wsapi.TransactionRequest{Transaction: hex.EncodeToString(data)}
+	if _, jErr := wsapi.HandleV2FactoidSubmit(st, t); jErr != nil {
+		return nil, fmt.Errorf("%s %v", jErr.Message, jErr.Data)
+	}
+	return trans.GetSigHash(), nil
 }
 
 func setUpAuthorites(st *state.State, buildMain bool) []hardCodedAuthority {
@@ -0,0 +1,148 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/state"
+)
+
+// A simulation is repeatable when run with -seed, so every random choice is
+// the same, and -virtualclock, so every timestamp and timeout is.  Nothing
+// but the simulation moves the virtual clock: the scenario, or the simulator
+// loop when there is none, steps it only once every node is idle, however
+// long that takes on the wall clock, so the nodes do the same work between
+// the same ticks every run.  Given the same seed and scenario, the nodes
+// build the same blocks.
+
+// VirtualEpoch is the time the virtual clock starts at
+var VirtualEpoch = time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// simSeed seeds the randomness of the simulated links
+var simSeed int64 = time.Now().UnixNano()
+
+// The virtual clock, nil on the wall clock, and the time it moves each step
+var virtualClock *primitives.VirtualClock
+var clockStep time.Duration
+
+// clockSettle is the wall time the nodes must stay idle before the clock
+// steps.  It is longer than the wall clock sleeps of the loops that pass
+// messages between the links and the nodes' queues, so a message one of
+// them holds is seen.
+var clockSettle = 20 * time.Millisecond
+
+// SetSeed seeds all the randomness of factomd: math/rand, the simulated
+// links and p2p.  It must be called before the nodes are made.
+func SetSeed(seed int64) {
+	simSeed = seed
+	rand.Seed(seed)
+	p2p.RandomSeed = seed
+}
+
+// StartVirtualClock runs factomd on a virtual clock, starting at
+// VirtualEpoch and moving step at a time.  It must be called before the
+// nodes are made.
+func StartVirtualClock(step time.Duration) *primitives.VirtualClock {
+	virtualClock = primitives.NewVirtualClock(VirtualEpoch)
+	clockStep = step
+	primitives.SetClock(virtualClock)
+	return virtualClock
+}
+
+// stepClock moves the virtual clock on a step, once the nodes are idle.  On
+// the wall clock it just sleeps for wait.
+func stepClock(wait time.Duration) {
+	if virtualClock == nil {
+		time.Sleep(wait)
+		return
+	}
+	for !nodesSettled() {
+		time.Sleep(time.Millisecond)
+	}
+	virtualClock.Advance(clockStep)
+}
+
+// runVirtualClock is the simulator loop, stepping the virtual clock when no
+// scenario is
+func runVirtualClock() {
+	for {
+		stepClock(0)
+	}
+}
+
+// nodesSettled is true when the nodes are idle, and still are clockSettle
+// later
+func nodesSettled() bool {
+	if !nodesIdle() {
+		return false
+	}
+	time.Sleep(clockSettle)
+	return nodesIdle()
+}
+
+// nodesIdle is true when the running nodes can do nothing more until time
+// passes: the timer and validator of each are asleep on the node's clock,
+// and no message is waiting to go out or to be passed along a link
+func nodesIdle() bool {
+	for _, f := range fnodes {
+		if !f.State.Running() {
+			continue
+		}
+		if f.State.NetworkOutMsgQueue().Length() > 0 || f.State.APIQueue().Length() > 0 {
+			return false
+		}
+		for _, p := range f.Peers {
+			if peer, ok := p.(*SimPeer); ok && len(peer.BroadcastOut) > 0 {
+				return false
+			}
+		}
+		clock, ok := f.State.Clock.(*nodeClock)
+		if !ok || clock.asleep() < 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeClock is the virtual clock as a node runs on it.  Only the node's timer
+// and validator sleep on it, so the simulation can tell when both are asleep.
+type nodeClock struct {
+	*primitives.VirtualClock
+}
+
+func newNodeClock(c *primitives.VirtualClock) *nodeClock {
+	return &nodeClock{VirtualClock: c}
+}
+
+func (c *nodeClock) Sleep(d time.Duration) {
+	c.VirtualClock.SleepAs(c, d)
+}
+
+// asleep returns how many of the node's goroutines sleep on the clock
+func (c *nodeClock) asleep() int {
+	return c.VirtualClock.SleepersOf(c)
+}
+
+// linkClock returns the clock the links of a node run on.  A packet held on a
+// link waits on the virtual clock itself, as it is not the node's work.
+func linkClock(s *state.State) primitives.Clock {
+	if c, ok := s.Clock.(*nodeClock); ok {
+		return c.VirtualClock
+	}
+	return s.GetClock()
+}
+
+// linkRand returns the random source of the link from one node to another,
+// the same for every run with the same seed
+func linkRand(from, to string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(from + "->" + to))
+	return rand.New(rand.NewSource(simSeed ^ int64(h.Sum64())))
+}
@@ -0,0 +1,82 @@
+package engine_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	. "github.com/FactomProject/factomd/engine"
+)
+
+const repeatableScenario = `{
+	"name": "repeatable",
+	"timeout": 600,
+	"steps": [
+		{"height": 1, "action": "load", "chains": 1, "count": 3},
+		{"height": 3, "action": "assert-height", "min": 3, "within": 60}
+	]
+}`
+
+// TestRepeatableScenario runs the same scenario twice, with the same seed on
+// the virtual clock, and checks both runs build the same blocks.  The
+// simulator's nodes are globals, so each run is a process of its own,
+// running TestRunScenarioProcess.
+func TestRepeatableScenario(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Runs two simulations")
+	}
+	dir, err := ioutil.TempDir("", "scenario")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	scenario := filepath.Join(dir, "repeatable.json")
+	if err := ioutil.WriteFile(scenario, []byte(repeatableScenario), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ended := regexp.MustCompile(`Scenario repeatable (passed|FAILED).*`)
+	var results []string
+	for i := 0; i < 2; i++ {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestRunScenarioProcess$")
+		cmd.Env = append(os.Environ(), "FACTOMD_SCENARIO="+scenario)
+		out, err := cmd.CombinedOutput()
+		result := ended.Find(out)
+		if err != nil || result == nil {
+			t.Fatalf("Run %d did not pass the scenario, %v: %s", i, err, result)
+		}
+		results = append(results, string(result))
+	}
+	if results[0] != results[1] {
+		t.Errorf("The runs differ:\n%s\n%s", results[0], results[1])
+	}
+}
+
+// TestRunScenarioProcess is a run of TestRepeatableScenario
+func TestRunScenarioProcess(t *testing.T) {
+	scenario := os.Getenv("FACTOMD_SCENARIO")
+	if scenario == "" {
+		t.Skip("Run by TestRepeatableScenario")
+	}
+	params := ParseCmdLine([]string{
+		"-db=Map",
+		"-network=LOCAL",
+		"-net=alot+",
+		"-enablenet=true",
+		"-blktime=10",
+		"-count=3",
+		"-logPort=37100",
+		"-port=37101",
+		"-ControlPanelPort=37102",
+		"-networkPort=37103",
+		"-startdelay=1",
+		"-seed=7",
+		"-virtualclock=100",
+		"-scenario=" + scenario,
+	})
+	Factomd(params, false)
+	select {} // The scenario exits the process
+}
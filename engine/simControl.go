@@ -6,6 +6,7 @@ package engine
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
@@ -1077,6 +1078,28 @@ func SimControl(listenTo int, listenStdin bool) {
 					}
 				}
 
+			case 'L' == b[0]:
+				if len(b) > 1 && b[1] == 'h' {
+					simNetwork().Heal()
+					os.Stderr.WriteString("Removed the faults of every link\n")
+					break
+				}
+				for _, link := range simNetwork().GetLinkFaults() {
+					faults, _ := json.Marshal(link.Faults)
+					if string(faults) != "{}" {
+						os.Stderr.WriteString(fmt.Sprintf("%10s -> %-10s %s\n", link.From, link.To, faults))
+					}
+				}
+			case 'P' == b[0]:
+				groups, oneway, err := parsePartition(b[1:])
+				if err == nil {
+					err = simNetwork().Partition(groups, oneway)
+				}
+				if err != nil {
+					os.Stderr.WriteString(fmt.Sprintf("Could not partition, %s\n", err.Error()))
+					break
+				}
+				os.Stderr.WriteString(fmt.Sprintf("Partitioned %v, one way %v\n", groups, oneway))
 			case 'h' == b[0]:
 				os.Stderr.WriteString("-------------------------------------------------------------------------------\n")
 				os.Stderr.WriteString("<enter>       Running Enter with nothing repeats the previous command.\n\n")
@@ -1121,6 +1144,10 @@ func SimControl(listenTo int, listenStdin bool) {
 				os.Stderr.WriteString("Onnn          Set Drop Rate to nnn on this node\n")
 				os.Stderr.WriteString("Dnnn          Set the Delay on messages from the current node to nnn milliseconds\n")
 				os.Stderr.WriteString("Fnnn          Set the Delay on messages from all nodes to nnn milliseconds\n")
+				os.Stderr.WriteString("L             List the links with faults injected\n")
+				os.Stderr.WriteString("Lh            Remove the faults of every link\n")
+				os.Stderr.WriteString("Pa.b/c.d      Partition nodes a and b from c and d.  More groups may follow, '/' separated\n")
+				os.Stderr.WriteString("Pa.b>c.d      Cut only the links from nodes a and b to nodes c and d\n")
 				os.Stderr.WriteString("/             Toggle the sort order between ChainID and Factom Node Name\n")
 
 				//os.Stderr.WriteString("i[m/b/a][N]   Shows only the Mhash, block signing key, or anchor key up to the Nth identity\n")
@@ -0,0 +1,305 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// reorderHold is how long a packet held back to be reordered waits for the
+// next one, before it is sent on its own
+const reorderHold = 100 * time.Millisecond
+
+// SetFaults sets the faults injected on the link.  A packet held back to be
+// reordered is sent now.
+func (f *SimPeer) SetFaults(faults interfaces.LinkFaults) {
+	f.faultMutex.Lock()
+	f.faults = faults
+	held := f.held
+	f.held = nil
+	f.faultMutex.Unlock()
+
+	if held != nil {
+		f.BroadcastOut <- held
+	}
+}
+
+func (f *SimPeer) GetFaults() interfaces.LinkFaults {
+	f.faultMutex.Lock()
+	defer f.faultMutex.Unlock()
+	return f.faults
+}
+
+// sendPacket puts a packet on the link, injecting the link's faults.  The
+// packets are sent once the faults are unlocked, as the link may be full.
+func (f *SimPeer) sendPacket(msg interfaces.IMsg, packet *SimPacket) {
+	for _, p := range f.injectFaults(msg, packet) {
+		f.BroadcastOut <- p
+	}
+}
+
+// injectFaults returns the packets to send for packet, none if it is dropped
+// or held back
+func (f *SimPeer) injectFaults(msg interfaces.IMsg, packet *SimPacket) []*SimPacket {
+	f.faultMutex.Lock()
+	defer f.faultMutex.Unlock()
+	faults := &f.faults
+
+	if faults.Cut || (faults.DropRate > 0 && f.rand.Intn(1000) < faults.DropRate) {
+		return nil
+	}
+	for _, filter := range faults.Drop {
+		if matchMsgFilter(filter, msg) {
+			return nil
+		}
+	}
+
+	ready := packet.sent
+	if faults.Bandwidth > 0 {
+		if f.busyUntil > ready {
+			ready = f.busyUntil
+		}
+		ready += int64(len(packet.data)) * 1000 / int64(faults.Bandwidth)
+		f.busyUntil = ready
+	}
+	packet.deliver = ready + sampleLatency(f.rand, faults.Latency)
+
+	if faults.Reorder > 0 && f.held == nil && f.rand.Intn(1000) < faults.Reorder {
+		f.held = packet
+		go f.flushHeld(packet)
+		return nil
+	}
+	packets := []*SimPacket{packet}
+	if faults.Duplicate > 0 && f.rand.Intn(1000) < faults.Duplicate {
+		dup := *packet
+		packets = append(packets, &dup)
+	}
+	if f.held != nil {
+		packets = append(packets, f.held)
+		f.held = nil
+	}
+	return packets
+}
+
+// flushHeld sends a packet held back to be reordered, if no packet has
+// followed it within reorderHold
+func (f *SimPeer) flushHeld(packet *SimPacket) {
+	f.getClock().Sleep(reorderHold)
+	f.faultMutex.Lock()
+	held := f.held == packet
+	if held {
+		f.held = nil
+	}
+	f.faultMutex.Unlock()
+
+	if held {
+		f.BroadcastOut <- packet
+	}
+}
+
+func matchMsgFilter(filter interfaces.MsgFilter, msg interfaces.IMsg) bool {
+	if strings.EqualFold(filter.Type, messages.MessageName(msg.Type())) == false {
+		return false
+	}
+	return filter.VM == nil || *filter.VM == msg.GetVMIndex()
+}
+
+// sampleLatency returns the milliseconds a message takes on the link
+func sampleLatency(r *rand.Rand, l *interfaces.Latency) int64 {
+	if l == nil {
+		return 0
+	}
+	var ms float64
+	switch l.Distribution {
+	case "fixed":
+		ms = float64(l.Mean)
+	case "uniform":
+		ms = float64(l.Min)
+		if l.Max > l.Min {
+			ms += float64(r.Int63n(l.Max - l.Min))
+		}
+	case "normal":
+		ms = math.Max(float64(l.Min), r.NormFloat64()*float64(l.StdDev)+float64(l.Mean))
+	case "exponential":
+		ms = float64(l.Min) + r.ExpFloat64()*float64(l.Mean)
+	}
+	return int64(ms)
+}
+
+// CheckLinkFaults checks the rates, latency and message filters of faults
+func CheckLinkFaults(faults interfaces.LinkFaults) error {
+	for _, rate := range []int{faults.DropRate, faults.Duplicate, faults.Reorder} {
+		if rate < 0 || rate > 1000 {
+			return fmt.Errorf("Rates are per 1000, between 0 and 1000")
+		}
+	}
+	if faults.Bandwidth < 0 {
+		return fmt.Errorf("A negative bandwidth")
+	}
+	if l := faults.Latency; l != nil {
+		if l.Min < 0 || l.Max < 0 || l.Mean < 0 || l.StdDev < 0 {
+			return fmt.Errorf("A negative latency")
+		}
+		switch l.Distribution {
+		case "fixed", "normal", "exponential":
+		case "uniform":
+			if l.Max < l.Min {
+				return fmt.Errorf("The latency max is less than its min")
+			}
+		default:
+			return fmt.Errorf("Unknown latency distribution %q, use fixed, uniform, normal or exponential", l.Distribution)
+		}
+	}
+	for _, filter := range faults.Drop {
+		known := false
+		for i := 0; i < 256; i++ {
+			known = known || strings.EqualFold(filter.Type, messages.MessageName(byte(i)))
+		}
+		if !known || strings.HasPrefix(filter.Type, "Unknown") {
+			return fmt.Errorf("Unknown message type %q", filter.Type)
+		}
+	}
+	return nil
+}
+
+// SimNetwork controls the links between a network of simulated nodes, for
+// the debug API and scenarios
+type SimNetwork struct {
+	fnodes []*FactomNode
+}
+
+var _ interfaces.ISimNetwork = (*SimNetwork)(nil)
+var _ interfaces.INodeStatusReporter = (*SimNetwork)(nil)
+
+// NewSimNetwork returns the controls of the links between the nodes
+func NewSimNetwork(fnodes []*FactomNode) *SimNetwork {
+	return &SimNetwork{fnodes: fnodes}
+}
+
+// simNetwork is the network of the simulator's nodes
+func simNetwork() *SimNetwork {
+	return NewSimNetwork(fnodes)
+}
+
+func (n *SimNetwork) GetLinkFaults() []interfaces.LinkStatus {
+	var answer []interfaces.LinkStatus
+	for _, peer := range n.links(-1, -1) {
+		answer = append(answer, interfaces.LinkStatus{From: peer.FromName, To: peer.ToName, Faults: peer.GetFaults()})
+	}
+	return answer
+}
+
+func (n *SimNetwork) SetLinkFaults(from, to int, faults interfaces.LinkFaults) error {
+	if err := n.checkNodes(from, to); err != nil {
+		return err
+	}
+	if err := CheckLinkFaults(faults); err != nil {
+		return err
+	}
+	for _, peer := range n.links(from, to) {
+		peer.SetFaults(faults)
+	}
+	return nil
+}
+
+func (n *SimNetwork) Partition(groups [][]int, oneway bool) error {
+	group := map[string]int{}
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			if err := n.checkNodes(i); err != nil || i < 0 {
+				return fmt.Errorf("There is no node %d, there are %d nodes", i, len(n.fnodes))
+			}
+			group[n.fnodes[i].State.FactomNodeName] = g
+		}
+	}
+	for _, peer := range n.links(-1, -1) {
+		from, ok1 := group[peer.FromName]
+		to, ok2 := group[peer.ToName]
+		if ok1 && ok2 && (from < to || (from > to && !oneway)) {
+			faults := peer.GetFaults()
+			faults.Cut = true
+			peer.SetFaults(faults)
+		}
+	}
+	return nil
+}
+
+func (n *SimNetwork) Heal() {
+	for _, peer := range n.links(-1, -1) {
+		peer.SetFaults(interfaces.LinkFaults{})
+	}
+}
+
+func (n *SimNetwork) GetNodeStatus() []*interfaces.NodeStatus {
+	var answer []*interfaces.NodeStatus
+	for _, f := range n.fnodes {
+		answer = append(answer, f.State.GetNodeStatus())
+	}
+	return answer
+}
+
+func (n *SimNetwork) checkNodes(nodes ...int) error {
+	for _, i := range nodes {
+		if i < -1 || i >= len(n.fnodes) {
+			return fmt.Errorf("There is no node %d, there are %d nodes", i, len(n.fnodes))
+		}
+	}
+	return nil
+}
+
+// links returns the simulated links from one node to another, -1 being
+// every node
+func (n *SimNetwork) links(from, to int) []*SimPeer {
+	var answer []*SimPeer
+	for i, f := range n.fnodes {
+		if from >= 0 && from != i {
+			continue
+		}
+		for _, p := range f.Peers {
+			peer, ok := p.(*SimPeer)
+			if !ok {
+				continue
+			}
+			if to >= 0 && peer.ToName != n.fnodes[to].State.FactomNodeName {
+				continue
+			}
+			answer = append(answer, peer)
+		}
+	}
+	return answer
+}
+
+// parsePartition parses the groups of the P command, "0.1/2.3", or
+// "0.1>2.3" for a one way partition
+func parsePartition(s string) ([][]int, bool, error) {
+	sep := "/"
+	if strings.Contains(s, ">") {
+		sep = ">"
+	}
+	var groups [][]int
+	for _, g := range strings.Split(s, sep) {
+		var group []int
+		for _, n := range strings.Split(g, ".") {
+			i, err := strconv.Atoi(n)
+			if err != nil {
+				return nil, false, fmt.Errorf("Bad node %q", n)
+			}
+			group = append(group, i)
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) < 2 {
+		return nil, false, fmt.Errorf("Give at least two groups")
+	}
+	return groups, sep == ">", nil
+}
@@ -0,0 +1,115 @@
+package engine_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func newSimLink() (*SimPeer, *SimPeer) {
+	peer12 := new(SimPeer).Init("one", "two").(*SimPeer)
+	peer21 := new(SimPeer).Init("two", "one").(*SimPeer)
+	peer12.BroadcastIn = peer21.BroadcastOut
+	peer21.BroadcastIn = peer12.BroadcastOut
+	return peer12, peer21
+}
+
+func newEOM(vm int) *messages.EOM {
+	eom := new(messages.EOM)
+	eom.Timestamp = primitives.NewTimestampNow()
+	eom.ChainID = primitives.NewZeroHash()
+	eom.VMIndex = vm
+	return eom
+}
+
+// recieved returns the VMs of the EOMs waiting on a link
+func recieved(t *testing.T, peer *SimPeer) []int {
+	// Packets are recieved the millisecond after they are sent at the soonest
+	time.Sleep(2 * time.Millisecond)
+	var vms []int
+	for {
+		msg, err := peer.Recieve()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if msg == nil {
+			return vms
+		}
+		vms = append(vms, msg.GetVMIndex())
+	}
+}
+
+func TestSimPeerFaults(t *testing.T) {
+	vm := 2
+	for i, test := range []struct {
+		Faults   interfaces.LinkFaults
+		Expected []int
+	}{
+		{interfaces.LinkFaults{}, []int{1, 2}},
+		{interfaces.LinkFaults{Cut: true}, nil},
+		{interfaces.LinkFaults{DropRate: 1000}, nil},
+		{interfaces.LinkFaults{Drop: []interfaces.MsgFilter{{Type: "eom", VM: &vm}}}, []int{1}},
+		{interfaces.LinkFaults{Drop: []interfaces.MsgFilter{{Type: "EOM"}}}, nil},
+		{interfaces.LinkFaults{Drop: []interfaces.MsgFilter{{Type: "Ack"}}}, []int{1, 2}},
+		{interfaces.LinkFaults{Duplicate: 1000}, []int{1, 1, 2, 2}},
+		{interfaces.LinkFaults{Reorder: 1000}, []int{2, 1}},
+		{interfaces.LinkFaults{Latency: &interfaces.Latency{Distribution: "fixed", Mean: 60000}}, nil},
+		{interfaces.LinkFaults{Bandwidth: 1}, nil},
+	} {
+		peer12, peer21 := newSimLink()
+		peer12.SetFaults(test.Faults)
+		peer12.Send(newEOM(1))
+		peer12.Send(newEOM(2))
+
+		vms := recieved(t, peer21)
+		if len(vms) != len(test.Expected) {
+			t.Errorf("%d: recieved %v, expected %v", i, vms, test.Expected)
+			continue
+		}
+		for j := range vms {
+			if vms[j] != test.Expected[j] {
+				t.Errorf("%d: recieved %v, expected %v", i, vms, test.Expected)
+			}
+		}
+	}
+
+	// The faults are one way
+	peer12, peer21 := newSimLink()
+	peer12.SetFaults(interfaces.LinkFaults{Cut: true})
+	peer21.Send(newEOM(1))
+	if vms := recieved(t, peer12); len(vms) != 1 {
+		t.Errorf("Recieved %v the other way", vms)
+	}
+}
+
+func TestCheckLinkFaults(t *testing.T) {
+	vm := 0
+	good := interfaces.LinkFaults{
+		DropRate:  10,
+		Latency:   &interfaces.Latency{Distribution: "uniform", Min: 10, Max: 100},
+		Bandwidth: 1000,
+		Duplicate: 1000,
+		Drop:      []interfaces.MsgFilter{{Type: "Commit Entry"}, {Type: "EOM", VM: &vm}},
+	}
+	if err := CheckLinkFaults(good); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	for i, faults := range []interfaces.LinkFaults{
+		{DropRate: 1001},
+		{Reorder: -1},
+		{Bandwidth: -1},
+		{Latency: &interfaces.Latency{Distribution: "gamma"}},
+		{Latency: &interfaces.Latency{Distribution: "uniform", Min: 10, Max: 5}},
+		{Latency: &interfaces.Latency{Distribution: "normal", Mean: -1}},
+		{Drop: []interfaces.MsgFilter{{Type: "Not a message"}}},
+	} {
+		if err := CheckLinkFaults(faults); err == nil {
+			t.Errorf("%d: expected an error", i)
+		}
+	}
+}
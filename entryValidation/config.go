@@ -0,0 +1,112 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package entryValidation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// ChainRules are the rules of one chain in a rules file.  A rules file is a
+// JSON array of them, for example:
+//
+//	[{
+//		"chainid": "<chain ID>",
+//		"minextids": 2,
+//		"signature": {"keyextid": 0, "signatureextid": 1, "publickeys": ["<hex key>"]},
+//		"schema": {"type": "object", "required": ["id"]}
+//	}]
+type ChainRules struct {
+	ChainID   string          `json:"chainid"`
+	MinExtIDs int             `json:"minextids,omitempty"`
+	MaxExtIDs int             `json:"maxextids,omitempty"` // 0 for no limit
+	Signature *SignatureRule  `json:"signature,omitempty"`
+	Schema    json.RawMessage `json:"schema,omitempty"`
+}
+
+type SignatureRule struct {
+	KeyExtID       int      `json:"keyextid"`
+	SignatureExtID int      `json:"signatureextid"`
+	PublicKeys     []string `json:"publickeys,omitempty"`
+}
+
+// LoadFile reads a rules file into a new Validator
+func LoadFile(filename string) (*Validator, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	v, err := Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return v, nil
+}
+
+// Load reads the JSON of a rules file into a new Validator
+func Load(data []byte) (*Validator, error) {
+	var rules []*ChainRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	v := NewValidator()
+	for _, r := range rules {
+		if err := v.AddRules(r); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// AddRules registers the hooks for the rules of a chain
+func (v *Validator) AddRules(r *ChainRules) error {
+	chainID, err := primitives.HexToHash(r.ChainID)
+	if err != nil {
+		return fmt.Errorf("Chain %q: %v", r.ChainID, err)
+	}
+	var hooks []Hook
+
+	if r.MinExtIDs < 0 || r.MaxExtIDs < 0 || (r.MaxExtIDs > 0 && r.MaxExtIDs < r.MinExtIDs) {
+		return fmt.Errorf("Chain %s: bad external ID counts %d to %d", r.ChainID, r.MinExtIDs, r.MaxExtIDs)
+	}
+	if r.MinExtIDs > 0 || r.MaxExtIDs > 0 {
+		hooks = append(hooks, &ExtIDCount{Min: r.MinExtIDs, Max: r.MaxExtIDs})
+	}
+
+	if r.Signature != nil {
+		if r.Signature.KeyExtID < 0 || r.Signature.SignatureExtID < 0 || r.Signature.KeyExtID == r.Signature.SignatureExtID {
+			return fmt.Errorf("Chain %s: bad signature external IDs", r.ChainID)
+		}
+		s := &ExtIDSignature{KeyExtID: r.Signature.KeyExtID, SignatureExtID: r.Signature.SignatureExtID}
+		for _, k := range r.Signature.PublicKeys {
+			key, err := hex.DecodeString(k)
+			if err != nil || len(key) != 32 {
+				return fmt.Errorf("Chain %s: bad public key %q", r.ChainID, k)
+			}
+			s.PublicKeys = append(s.PublicKeys, key)
+		}
+		hooks = append(hooks, s)
+	}
+
+	if len(r.Schema) > 0 {
+		s, err := NewSchema(r.Schema)
+		if err != nil {
+			return fmt.Errorf("Chain %s: schema %v", r.ChainID, err)
+		}
+		hooks = append(hooks, s)
+	}
+
+	if len(hooks) == 0 {
+		return fmt.Errorf("Chain %s: no rules", r.ChainID)
+	}
+	for _, h := range hooks {
+		v.Register(chainID, h)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package entryValidation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Schema requires the content of an entry to be a JSON document matching a
+// JSON Schema.  The keywords supported are type, enum, properties, required,
+// additionalProperties (true or false), items (one schema), minItems,
+// maxItems, minLength, maxLength, pattern, minimum and maximum.  Any other
+// keyword, but for the annotations $schema, $id, title and description, is
+// refused by NewSchema rather than ignored.
+type Schema struct {
+	root *schemaNode
+}
+
+var _ Hook = (*Schema)(nil)
+
+type schemaNode struct {
+	Types                []string
+	Enum                 []interface{}
+	Properties           map[string]*schemaNode
+	Required             []string
+	AdditionalProperties *bool
+	Items                *schemaNode
+	MinItems             *int
+	MaxItems             *int
+	MinLength            *int
+	MaxLength            *int
+	Pattern              *regexp.Regexp
+	Minimum              *float64
+	Maximum              *float64
+}
+
+var schemaAnnotations map[string]bool = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"title":       true,
+	"description": true,
+}
+
+var schemaTypes map[string]bool = map[string]bool{
+	"object":  true,
+	"array":   true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"null":    true,
+}
+
+func NewSchema(data []byte) (*Schema, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	root, err := compileSchema(doc, "")
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{root: root}, nil
+}
+
+func compileSchema(doc interface{}, path string) (*schemaNode, error) {
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: a schema must be an object", schemaPath(path))
+	}
+	n := new(schemaNode)
+	var err error
+	for k, v := range m {
+		switch k {
+		case "type":
+			n.Types, err = schemaStrings(v)
+			for _, t := range n.Types {
+				if schemaTypes[t] == false {
+					err = fmt.Errorf("unknown type %q", t)
+				}
+			}
+		case "enum":
+			a, ok := v.([]interface{})
+			if !ok {
+				err = fmt.Errorf("enum must be an array")
+			}
+			n.Enum = a
+		case "properties":
+			props, ok := v.(map[string]interface{})
+			if !ok {
+				err = fmt.Errorf("properties must be an object")
+				break
+			}
+			n.Properties = map[string]*schemaNode{}
+			for name, p := range props {
+				n.Properties[name], err = compileSchema(p, path+"/"+name)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			if _, ok := v.([]interface{}); !ok {
+				err = fmt.Errorf("required must be an array")
+				break
+			}
+			n.Required, err = schemaStrings(v)
+		case "additionalProperties":
+			b, ok := v.(bool)
+			if !ok {
+				err = fmt.Errorf("only true or false are supported for additionalProperties")
+			}
+			n.AdditionalProperties = &b
+		case "items":
+			n.Items, err = compileSchema(v, path+"/[]")
+			if err != nil {
+				return nil, err
+			}
+		case "minItems":
+			n.MinItems, err = schemaInt(v)
+		case "maxItems":
+			n.MaxItems, err = schemaInt(v)
+		case "minLength":
+			n.MinLength, err = schemaInt(v)
+		case "maxLength":
+			n.MaxLength, err = schemaInt(v)
+		case "pattern":
+			s, ok := v.(string)
+			if !ok {
+				err = fmt.Errorf("pattern must be a string")
+				break
+			}
+			n.Pattern, err = regexp.Compile(s)
+		case "minimum":
+			n.Minimum, err = schemaNumber(v)
+		case "maximum":
+			n.Maximum, err = schemaNumber(v)
+		default:
+			if schemaAnnotations[k] == false {
+				err = fmt.Errorf("unsupported keyword %q", k)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", schemaPath(path), err)
+		}
+	}
+	return n, nil
+}
+
+func schemaStrings(v interface{}) ([]string, error) {
+	if s, ok := v.(string); ok {
+		return []string{s}, nil
+	}
+	a, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a string or an array of strings")
+	}
+	answer := make([]string, len(a))
+	for i := range a {
+		s, ok := a[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string or an array of strings")
+		}
+		answer[i] = s
+	}
+	return answer, nil
+}
+
+func schemaInt(v interface{}) (*int, error) {
+	f, ok := v.(float64)
+	if !ok || f < 0 || f != math.Trunc(f) {
+		return nil, fmt.Errorf("expected a non-negative integer")
+	}
+	i := int(f)
+	return &i, nil
+}
+
+func schemaNumber(v interface{}) (*float64, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return nil, fmt.Errorf("expected a number")
+	}
+	return &f, nil
+}
+
+func schemaPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+func (s *Schema) Name() string {
+	return "schema"
+}
+
+func (s *Schema) Check(entry interfaces.IEBEntry) error {
+	return s.Validate(entry.GetContent())
+}
+
+// Validate checks a JSON document against the schema
+func (s *Schema) Validate(data []byte) error {
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("Content is not JSON: %v", err)
+	}
+	if dec.More() {
+		return fmt.Errorf("Content is not JSON: more than one document")
+	}
+	return s.root.validate(doc, "")
+}
+
+func (n *schemaNode) validate(doc interface{}, path string) error {
+	if len(n.Types) > 0 {
+		t := jsonType(doc)
+		ok := false
+		for _, v := range n.Types {
+			if v == t || (v == "number" && t == "integer") {
+				ok = true
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s: %s, expected %v", schemaPath(path), t, n.Types)
+		}
+	}
+	if n.Enum != nil {
+		ok := false
+		for _, v := range n.Enum {
+			if jsonEqual(v, doc) {
+				ok = true
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s: not one of the allowed values", schemaPath(path))
+		}
+	}
+
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for _, name := range n.Required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s: missing %q", schemaPath(path), name)
+			}
+		}
+		// Sorted, so the same document always gives the same error
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p, ok := n.Properties[name]
+			if !ok {
+				if n.AdditionalProperties != nil && *n.AdditionalProperties == false {
+					return fmt.Errorf("%s: %q is not allowed", schemaPath(path), name)
+				}
+				continue
+			}
+			if err := p.validate(v[name], path+"/"+name); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if n.MinItems != nil && len(v) < *n.MinItems {
+			return fmt.Errorf("%s: %d items, at least %d are required", schemaPath(path), len(v), *n.MinItems)
+		}
+		if n.MaxItems != nil && len(v) > *n.MaxItems {
+			return fmt.Errorf("%s: %d items, at most %d are allowed", schemaPath(path), len(v), *n.MaxItems)
+		}
+		if n.Items != nil {
+			for i := range v {
+				if err := n.Items.validate(v[i], fmt.Sprintf("%s/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		l := utf8.RuneCountInString(v)
+		if n.MinLength != nil && l < *n.MinLength {
+			return fmt.Errorf("%s: shorter than %d", schemaPath(path), *n.MinLength)
+		}
+		if n.MaxLength != nil && l > *n.MaxLength {
+			return fmt.Errorf("%s: longer than %d", schemaPath(path), *n.MaxLength)
+		}
+		if n.Pattern != nil && n.Pattern.MatchString(v) == false {
+			return fmt.Errorf("%s: does not match %s", schemaPath(path), n.Pattern)
+		}
+	case float64:
+		if n.Minimum != nil && v < *n.Minimum {
+			return fmt.Errorf("%s: %v is less than %v", schemaPath(path), v, *n.Minimum)
+		}
+		if n.Maximum != nil && v > *n.Maximum {
+			return fmt.Errorf("%s: %v is more than %v", schemaPath(path), v, *n.Maximum)
+		}
+	}
+	return nil
+}
+
+func jsonType(doc interface{}) string {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
+
+func jsonEqual(a, b interface{}) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package entryValidation_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/entryValidation"
+)
+
+func TestSchema(t *testing.T) {
+	schema, err := NewSchema([]byte(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title": "Reading",
+		"type": "object",
+		"required": ["sensor", "value"],
+		"additionalProperties": false,
+		"properties": {
+			"sensor": {"type": "string", "pattern": "^[a-z]+-[0-9]+$", "maxLength": 16},
+			"value": {"type": "number", "minimum": -40, "maximum": 125},
+			"count": {"type": "integer"},
+			"unit": {"enum": ["C", "F"]},
+			"tags": {"type": "array", "maxItems": 2, "items": {"type": "string", "minLength": 1}},
+			"note": {"type": ["string", "null"]}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	for _, good := range []string{
+		`{"sensor": "probe-1", "value": 21.5}`,
+		`{"sensor": "probe-1", "value": 21, "count": 3, "unit": "C", "tags": ["a", "b"], "note": null}`,
+		` {"sensor": "probe-1", "value": -40, "note": "cold"} `,
+	} {
+		if err := schema.Validate([]byte(good)); err != nil {
+			t.Errorf("%v: %v", good, err)
+		}
+	}
+
+	for _, bad := range []string{
+		`not json`,
+		`{"sensor": "probe-1", "value": 1} {}`,
+		`[]`,
+		`{"sensor": "probe-1"}`,
+		`{"sensor": "Probe 1", "value": 1}`,
+		`{"sensor": "probe-1234567890123", "value": 1}`,
+		`{"sensor": "probe-1", "value": 200}`,
+		`{"sensor": "probe-1", "value": "1"}`,
+		`{"sensor": "probe-1", "value": 1, "count": 1.5}`,
+		`{"sensor": "probe-1", "value": 1, "unit": "K"}`,
+		`{"sensor": "probe-1", "value": 1, "tags": ["a", "b", "c"]}`,
+		`{"sensor": "probe-1", "value": 1, "tags": [""]}`,
+		`{"sensor": "probe-1", "value": 1, "note": 5}`,
+		`{"sensor": "probe-1", "value": 1, "extra": true}`,
+	} {
+		if err := schema.Validate([]byte(bad)); err == nil {
+			t.Errorf("Expected %v to fail", bad)
+		}
+	}
+}
+
+func TestNewSchemaErrors(t *testing.T) {
+	for _, bad := range []string{
+		`[]`,
+		`{"type": "float"}`,
+		`{"type": 5}`,
+		`{"required": "a"}`,
+		`{"properties": {"a": 5}}`,
+		`{"additionalProperties": {"type": "string"}}`,
+		`{"minLength": -1}`,
+		`{"maxItems": 1.5}`,
+		`{"pattern": "("}`,
+		`{"allOf": []}`,
+	} {
+		if _, err := NewSchema([]byte(bad)); err == nil {
+			t.Errorf("Expected %v to be refused", bad)
+		}
+	}
+}
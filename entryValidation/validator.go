@@ -0,0 +1,175 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package entryValidation checks the content of entries against rules set
+// per chain by the node operator.  The rules are local to the node: they are
+// checked by the API before an entry is revealed, and never in consensus.
+// A commit holds only the hash of its entry, so a commit is only checked
+// when the entry is sent along with it (the optional "entry" of
+// commit-chain and commit-entry).  An entry refused at reveal leaves its
+// commit paid for and unused; clients should send the entry with the
+// commit, check it with validate-transaction, or use
+// compose-and-submit-entry.
+package entryValidation
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Hook is a rule the entries of a chain must follow.
+type Hook interface {
+	// Name is reported in the RuleError of a failed check
+	Name() string
+	// Check returns why the entry breaks the rule, or nil
+	Check(entry interfaces.IEBEntry) error
+}
+
+// RuleError is returned by Validate for an entry breaking a rule of its chain.
+type RuleError struct {
+	ChainID string `json:"chainid"`
+	Rule    string `json:"rule"`
+	Reason  string `json:"reason"`
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("Entry breaks the %s rule of chain %s: %s", e.Rule, e.ChainID, e.Reason)
+}
+
+// Validator holds the hooks of each chain.  A nil Validator has no hooks.
+type Validator struct {
+	mutex sync.RWMutex
+	hooks map[[32]byte][]Hook
+}
+
+var _ interfaces.IEntryValidator = (*Validator)(nil)
+
+func NewValidator() *Validator {
+	v := new(Validator)
+	v.hooks = map[[32]byte][]Hook{}
+	return v
+}
+
+// Register adds a hook to a chain.  Hooks are checked in the order they were
+// registered.
+func (v *Validator) Register(chainID interfaces.IHash, hook Hook) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.hooks[chainID.Fixed()] = append(v.hooks[chainID.Fixed()], hook)
+}
+
+// Remove drops every hook of a chain
+func (v *Validator) Remove(chainID interfaces.IHash) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	delete(v.hooks, chainID.Fixed())
+}
+
+// Chains returns the number of chains with hooks
+func (v *Validator) Chains() int {
+	if v == nil {
+		return 0
+	}
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+	return len(v.hooks)
+}
+
+// Validate checks an entry against the hooks of its chain, and returns a
+// *RuleError for the first one it breaks.
+func (v *Validator) Validate(entry interfaces.IEBEntry) error {
+	if v == nil || entry == nil || entry.GetChainIDHash() == nil {
+		return nil
+	}
+	v.mutex.RLock()
+	hooks := v.hooks[entry.GetChainIDHash().Fixed()]
+	v.mutex.RUnlock()
+
+	for _, h := range hooks {
+		if err := h.Check(entry); err != nil {
+			return &RuleError{ChainID: entry.GetChainIDHash().String(), Rule: h.Name(), Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// ExtIDCount requires an entry to have at least Min and, if Max is not 0, at
+// most Max external IDs.
+type ExtIDCount struct {
+	Min int
+	Max int
+}
+
+var _ Hook = (*ExtIDCount)(nil)
+
+func (r *ExtIDCount) Name() string {
+	return "extids"
+}
+
+func (r *ExtIDCount) Check(entry interfaces.IEBEntry) error {
+	n := len(entry.ExternalIDs())
+	if n < r.Min {
+		return fmt.Errorf("%d external IDs, at least %d are required", n, r.Min)
+	}
+	if r.Max > 0 && n > r.Max {
+		return fmt.Errorf("%d external IDs, at most %d are allowed", n, r.Max)
+	}
+	return nil
+}
+
+// ExtIDSignature requires the content of an entry to be signed.  External ID
+// KeyExtID holds the ed25519 public key, and external ID SignatureExtID the
+// signature of the content.  If PublicKeys is not empty the key must be one of
+// them.
+type ExtIDSignature struct {
+	KeyExtID       int
+	SignatureExtID int
+	PublicKeys     [][]byte
+}
+
+var _ Hook = (*ExtIDSignature)(nil)
+
+func (r *ExtIDSignature) Name() string {
+	return "signature"
+}
+
+func (r *ExtIDSignature) Check(entry interfaces.IEBEntry) error {
+	extIDs := entry.ExternalIDs()
+	if r.KeyExtID >= len(extIDs) || r.SignatureExtID >= len(extIDs) {
+		return fmt.Errorf("Missing external ID %d or %d", r.KeyExtID, r.SignatureExtID)
+	}
+	key := extIDs[r.KeyExtID]
+	if len(r.PublicKeys) > 0 {
+		known := false
+		for _, k := range r.PublicKeys {
+			if primitives.AreBytesEqual(k, key) {
+				known = true
+				break
+			}
+		}
+		if known == false {
+			return fmt.Errorf("Key %x is not allowed", key)
+		}
+	}
+	return primitives.VerifySignature(entry.GetContent(), key, extIDs[r.SignatureExtID])
+}
+
+// HookFunc makes a Hook of a function.
+type HookFunc struct {
+	RuleName string
+	Func     func(entry interfaces.IEBEntry) error
+}
+
+var _ Hook = (*HookFunc)(nil)
+
+func (r *HookFunc) Name() string {
+	return r.RuleName
+}
+
+func (r *HookFunc) Check(entry interfaces.IEBEntry) error {
+	return r.Func(entry)
+}
@@ -0,0 +1,135 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package entryValidation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/entryValidation"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func newEntry(content string, extIDs ...[]byte) *entryBlock.Entry {
+	e := entryBlock.NewEntry()
+	e.ChainID = testHelper.GetChainID()
+	for _, v := range extIDs {
+		e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: v})
+	}
+	e.Content = primitives.ByteSlice{Bytes: []byte(content)}
+	return e
+}
+
+func TestValidator(t *testing.T) {
+	var v *Validator
+	if v.Validate(newEntry("")) != nil || v.Chains() != 0 {
+		t.Errorf("A nil Validator should check nothing")
+	}
+
+	v = NewValidator()
+	v.Register(testHelper.GetChainID(), &ExtIDCount{Min: 1, Max: 2})
+	v.Register(testHelper.GetChainID(), &HookFunc{RuleName: "nonempty", Func: func(entry interfaces.IEBEntry) error {
+		if len(entry.GetContent()) == 0 {
+			return fmt.Errorf("Empty content")
+		}
+		return nil
+	}})
+
+	if err := v.Validate(newEntry("x", []byte("a"))); err != nil {
+		t.Errorf("%v", err)
+	}
+	err := v.Validate(newEntry("x"))
+	if r, ok := err.(*RuleError); !ok || r.Rule != "extids" || r.ChainID != testHelper.GetChainID().String() {
+		t.Errorf("Expected an extids RuleError, got %v", err)
+	}
+	if err := v.Validate(newEntry("x", []byte("a"), []byte("b"), []byte("c"))); err == nil {
+		t.Errorf("Expected too many external IDs to fail")
+	}
+	err = v.Validate(newEntry("", []byte("a")))
+	if r, ok := err.(*RuleError); !ok || r.Rule != "nonempty" {
+		t.Errorf("Expected a nonempty RuleError, got %v", err)
+	}
+
+	// Other chains have no rules
+	e := newEntry("")
+	e.ChainID = primitives.NewZeroHash()
+	if err := v.Validate(e); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	v.Remove(testHelper.GetChainID())
+	if v.Chains() != 0 || v.Validate(newEntry("")) != nil {
+		t.Errorf("Expected the rules to be removed")
+	}
+}
+
+func TestExtIDSignature(t *testing.T) {
+	priv := testHelper.NewPrimitivesPrivateKey(0)
+	other := testHelper.NewPrimitivesPrivateKey(1)
+	content := "signed content"
+	sig := priv.Sign([]byte(content)).GetSignature()[:]
+
+	rule := &ExtIDSignature{KeyExtID: 0, SignatureExtID: 1}
+	if err := rule.Check(newEntry(content, priv.Pub[:], sig)); err != nil {
+		t.Errorf("%v", err)
+	}
+	if err := rule.Check(newEntry("other content", priv.Pub[:], sig)); err == nil {
+		t.Errorf("Expected a signature of other content to fail")
+	}
+	if err := rule.Check(newEntry(content, priv.Pub[:])); err == nil {
+		t.Errorf("Expected a missing signature to fail")
+	}
+
+	rule.PublicKeys = [][]byte{other.Pub[:]}
+	if err := rule.Check(newEntry(content, priv.Pub[:], sig)); err == nil {
+		t.Errorf("Expected an unknown key to fail")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	priv := testHelper.NewPrimitivesPrivateKey(0)
+	rules := fmt.Sprintf(`[{
+		"chainid": "%s",
+		"minextids": 2,
+		"signature": {"keyextid": 0, "signatureextid": 1, "publickeys": ["%x"]},
+		"schema": {"type": "object", "required": ["id"]}
+	}]`, testHelper.GetChainID(), priv.Pub[:])
+
+	v, err := Load([]byte(rules))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if v.Chains() != 1 {
+		t.Errorf("Expected rules for 1 chain, got %v", v.Chains())
+	}
+
+	content := `{"id": 5}`
+	sig := priv.Sign([]byte(content)).GetSignature()[:]
+	if err := v.Validate(newEntry(content, priv.Pub[:], sig)); err != nil {
+		t.Errorf("%v", err)
+	}
+	content = `{"name": "x"}`
+	sig = priv.Sign([]byte(content)).GetSignature()[:]
+	if r, ok := v.Validate(newEntry(content, priv.Pub[:], sig)).(*RuleError); !ok || r.Rule != "schema" {
+		t.Errorf("Expected the schema to fail")
+	}
+
+	for _, bad := range []string{
+		`{}`,
+		`[{"chainid": "zz", "minextids": 1}]`,
+		`[{"chainid": "%s"}]`,
+		`[{"chainid": "%s", "minextids": 3, "maxextids": 2}]`,
+		`[{"chainid": "%s", "signature": {"keyextid": 1, "signatureextid": 1}}]`,
+		`[{"chainid": "%s", "signature": {"keyextid": 0, "signatureextid": 1, "publickeys": ["abcd"]}}]`,
+		`[{"chainid": "%s", "schema": {"type": "object", "oneOf": []}}]`,
+	} {
+		if _, err := Load([]byte(fmt.Sprintf(bad, testHelper.GetChainID()))); err == nil {
+			t.Errorf("Expected %v to be refused", bad)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/identityBuilder"
+)
+
+func main() {
+	var (
+		at = flag.Int64("time", 0, "Unix time the entries are to be submitted at, now if 0")
+	)
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 || (args[0] != "keygen" && len(args) != 2) {
+		fmt.Println("Usage:")
+		fmt.Println("IdentityBuilder keygen > keys.json")
+		fmt.Println("IdentityBuilder [-time unix] build keys.json > identity.json")
+		fmt.Println("IdentityBuilder [-time unix] validate identity.json")
+		fmt.Println("IdentityBuilder requests identity.json")
+		fmt.Println("Builds and checks the entries making a server identity.  keygen makes random identity and block signing keys;")
+		fmt.Println("fill in the entry credit key paying for the entries, and any Bitcoin key or Matryoshka hash.  build signs the")
+		fmt.Println("entries and commits, and requests prints them as API requests, to be sent to factomd in order within 12 hours.")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	now := *at
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+
+	switch args[0] {
+	case "keygen":
+		ks, err := identityBuilder.NewKeySet()
+		if err != nil {
+			exit(err)
+		}
+		write(ks)
+	case "build":
+		ks := new(identityBuilder.KeySet)
+		read(args[1], ks)
+		id, err := identityBuilder.Build(ks, primitives.NewTimestampFromSeconds(uint32(now)))
+		if err != nil {
+			exit(err)
+		}
+		if err := identityBuilder.Validate(id.Steps, now); err != nil {
+			exit(err)
+		}
+		if ks.IdentityNonce == "" || ks.ManagementNonce == "" {
+			fmt.Fprintf(os.Stderr, "Add \"identitynonce\": \"%s\" and \"managementnonce\": \"%s\" to %s to build the same chains again\n",
+				id.IdentityNonce, id.ManagementNonce, args[1])
+		}
+		write(id)
+	case "validate":
+		id := new(identityBuilder.Identity)
+		read(args[1], id)
+		if err := identityBuilder.Validate(id.Steps, now); err != nil {
+			exit(err)
+		}
+		fmt.Printf("Identity %s is valid\n", id.ChainID)
+	case "requests":
+		id := new(identityBuilder.Identity)
+		read(args[1], id)
+		for i, s := range id.Steps {
+			commit, reveal := s.Requests(i)
+			fmt.Println(commit.String())
+			fmt.Println(reveal.String())
+		}
+	default:
+		fmt.Printf("Unknown command %s\n", args[0])
+		os.Exit(1)
+	}
+}
+
+func read(filename string, v interface{}) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		exit(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		exit(fmt.Errorf("%s: %v", filename, err))
+	}
+}
+
+func write(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		exit(err)
+	}
+	fmt.Println(string(data))
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,384 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package identityBuilder builds and signs the entries making a server
+// identity, from the identity chain through to the keys an authority needs,
+// with the commits paying for them.  Validate checks a sequence of them
+// against the rules factomd applies when it loads an identity, see
+// state/identity.go.
+package identityBuilder
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	. "github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/state"
+)
+
+// The steps of an identity, in the order they are submitted
+const (
+	StepIdentityChain      = "identity-chain"
+	StepRegisterIdentity   = "register-identity"
+	StepManagementChain    = "management-chain"
+	StepRegisterManagement = "register-management"
+	StepSigningKey         = "signing-key"
+	StepBTCKey             = "btc-key"
+	StepMatryoshkaHash     = "matryoshka-hash"
+	StepLinkECKey          = "link-ec-key"
+)
+
+// KeySet is the keys an identity is built from.  Keys are hex, except the
+// entry credit key paying for the entries, which is Es...
+type KeySet struct {
+	IdentityKeys []string `json:"identitykeys"` // Private identity keys, levels 1 to 4
+	SigningKey   string   `json:"signingkey"`   // Private block signing key
+
+	// A Bitcoin key to anchor with, the 20 byte hash of a P2PKH key (type 0)
+	// or P2SH script (type 1).  None if empty.
+	BTCKey      string `json:"btckey,omitempty"`
+	BTCKeyLevel byte   `json:"btckeylevel,omitempty"`
+	BTCKeyType  byte   `json:"btckeytype,omitempty"`
+
+	MatryoshkaHash string `json:"matryoshkahash,omitempty"` // The outermost M-hash, none if empty
+
+	ECKey string `json:"eckey"`
+	// Link the entry credit key to the identity.  factomd does not read the
+	// link yet.
+	LinkECKey bool `json:"linkeckey,omitempty"`
+
+	// The nonces giving chain IDs starting 888888.  Build finds them when
+	// empty, which takes a while, so keep them to build the same chains again.
+	IdentityNonce   string `json:"identitynonce,omitempty"`
+	ManagementNonce string `json:"managementnonce,omitempty"`
+}
+
+// Identity is the chains of a built identity, and the steps making them
+type Identity struct {
+	ChainID           string  `json:"chainid"`
+	ManagementChainID string  `json:"managementchainid"`
+	IdentityNonce     string  `json:"identitynonce"`
+	ManagementNonce   string  `json:"managementnonce"`
+	Steps             []*Step `json:"steps"`
+}
+
+// Step is an entry, or the first entry of a chain, and the commit paying for
+// it.  Commit and Reveal are the hex messages for the API methods.
+type Step struct {
+	Name         string `json:"name"`
+	ChainID      string `json:"chainid"`
+	EntryHash    string `json:"entryhash"`
+	CommitMethod string `json:"commitmethod"` // commit-chain or commit-entry
+	Commit       string `json:"commit"`
+	RevealMethod string `json:"revealmethod"` // reveal-chain or reveal-entry
+	Reveal       string `json:"reveal"`
+}
+
+// NewChain is true if the step creates its chain
+func (s *Step) NewChain() bool {
+	return s.CommitMethod == "commit-chain"
+}
+
+// Requests returns the API requests committing and revealing the step
+func (s *Step) Requests(id int) (commit *primitives.JSON2Request, reveal *primitives.JSON2Request) {
+	commit = primitives.NewJSON2Request(s.CommitMethod, id, map[string]string{"message": s.Commit})
+	reveal = primitives.NewJSON2Request(s.RevealMethod, id, map[string]string{"entry": s.Reveal})
+	return
+}
+
+// NewKeySet returns random identity and block signing keys.  The entry
+// credit key, and any Bitcoin key or Matryoshka hash, are left to be filled
+// in.
+func NewKeySet() (*KeySet, error) {
+	ks := new(KeySet)
+	for i := 0; i < 5; i++ {
+		pk := new(primitives.PrivateKey)
+		if err := pk.GenerateKey(); err != nil {
+			return nil, err
+		}
+		if i < 4 {
+			ks.IdentityKeys = append(ks.IdentityKeys, pk.PrivateKeyString())
+		} else {
+			ks.SigningKey = pk.PrivateKeyString()
+		}
+	}
+	return ks, nil
+}
+
+// keys is a KeySet decoded
+type keys struct {
+	identity [4]*primitives.PrivateKey
+	signing  *primitives.PrivateKey
+	btcKey   []byte
+	mHash    interfaces.IHash
+	ec       []byte
+}
+
+func (ks *KeySet) decode() (*keys, error) {
+	k := new(keys)
+	if len(ks.IdentityKeys) != 4 {
+		return nil, fmt.Errorf("Expected 4 identity keys, got %d", len(ks.IdentityKeys))
+	}
+	for i, v := range ks.IdentityKeys {
+		pk, err := primitives.NewPrivateKeyFromHex(v)
+		if err != nil {
+			return nil, fmt.Errorf("Identity key %d: %v", i+1, err)
+		}
+		k.identity[i] = pk
+	}
+	pk, err := primitives.NewPrivateKeyFromHex(ks.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("Signing key: %v", err)
+	}
+	k.signing = pk
+
+	if ks.BTCKey != "" {
+		k.btcKey, err = hex.DecodeString(ks.BTCKey)
+		if err != nil || len(k.btcKey) != 20 {
+			return nil, fmt.Errorf("The Bitcoin key must be 20 bytes of hex")
+		}
+		if ks.BTCKeyType > 1 {
+			return nil, fmt.Errorf("The Bitcoin key type must be 0, P2PKH, or 1, P2SH")
+		}
+	}
+	if ks.MatryoshkaHash != "" {
+		k.mHash, err = primitives.HexToHash(ks.MatryoshkaHash)
+		if err != nil {
+			return nil, fmt.Errorf("Matryoshka hash: %v", err)
+		}
+	}
+
+	k.ec, err = primitives.HumanReadableECPrivateKeyToPrivateKey(ks.ECKey)
+	if err != nil {
+		return nil, fmt.Errorf("Entry credit key: %v", err)
+	}
+	return k, nil
+}
+
+// IdentityKeyHash returns the identity key of an ed25519 public key, as the
+// identity chain holds it
+func IdentityKeyHash(pub []byte) interfaces.IHash {
+	return primitives.Shad(preimage(pub))
+}
+
+// preimage is the type prefix and public key, hashing to the identity key
+func preimage(pub []byte) []byte {
+	return append([]byte{0x01}, pub...)
+}
+
+// Build builds and signs the steps making the identity of the key set, with
+// timestamps and commits at ts.  They must be submitted in order, and within
+// 12 hours of ts.
+func Build(ks *KeySet, ts interfaces.Timestamp) (*Identity, error) {
+	k, err := ks.decode()
+	if err != nil {
+		return nil, err
+	}
+	b := &builder{ec: k.ec, ts: ts}
+	id := new(Identity)
+
+	ics := new(IdentityChainStructure)
+	ics.FunctionName = []byte("Identity Chain")
+	ics.Key1 = IdentityKeyHash(k.identity[0].Public())
+	ics.Key2 = IdentityKeyHash(k.identity[1].Public())
+	ics.Key3 = IdentityKeyHash(k.identity[2].Public())
+	ics.Key4 = IdentityKeyHash(k.identity[3].Public())
+	ics.Nonce, err = nonce(ks.IdentityNonce, ics.ToExternalIDs()[:6])
+	if err != nil {
+		return nil, fmt.Errorf("Identity nonce: %v", err)
+	}
+	chainID := ics.GetChainID()
+	if err := b.add(StepIdentityChain, chainID, ics.ToExternalIDs(), true); err != nil {
+		return nil, err
+	}
+	id.ChainID = chainID.String()
+	id.IdentityNonce = hex.EncodeToString(ics.Nonce)
+
+	key1 := k.identity[0]
+	pre := preimage(key1.Public())
+	ts8 := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts8, uint64(ts.GetTimeSeconds()))
+
+	rfi := new(RegisterFactomIdentityStructure)
+	rfi.FunctionName = []byte("Register Factom Identity")
+	rfi.IdentityChainID = chainID
+	rfi.PreimageIdentityKey = pre
+	rfi.Signature = primitives.Sign(key1.Key[:], rfi.MarshalForSig())
+	list, err := primitives.HexToHash(state.MAIN_FACTOM_IDENTITY_LIST)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.add(StepRegisterIdentity, list, rfi.ToExternalIDs(), false); err != nil {
+		return nil, err
+	}
+
+	sm := new(ServerManagementStructure)
+	sm.FunctionName = []byte("Server Management")
+	sm.RootIdentityChainID = chainID
+	sm.Nonce, err = nonce(ks.ManagementNonce, sm.ToExternalIDs()[:3])
+	if err != nil {
+		return nil, fmt.Errorf("Management nonce: %v", err)
+	}
+	managementID := sm.GetChainID()
+	if err := b.add(StepManagementChain, managementID, sm.ToExternalIDs(), true); err != nil {
+		return nil, err
+	}
+	id.ManagementChainID = managementID.String()
+	id.ManagementNonce = hex.EncodeToString(sm.Nonce)
+
+	rsm := new(RegisterServerManagementStructure)
+	rsm.FunctionName = []byte("Register Server Management")
+	rsm.SubchainChainID = managementID
+	rsm.PreimageIdentityKey = pre
+	rsm.Signature = primitives.Sign(key1.Key[:], rsm.MarshalForSig())
+	if err := b.add(StepRegisterManagement, chainID, rsm.ToExternalIDs(), false); err != nil {
+		return nil, err
+	}
+
+	nbsk := new(NewBlockSigningKeyStruct)
+	nbsk.FunctionName = []byte("New Block Signing Key")
+	nbsk.RootIdentityChainID = chainID
+	nbsk.NewPublicKey = k.signing.Public()
+	nbsk.Timestamp = ts8
+	nbsk.PreimageIdentityKey = pre
+	nbsk.Signature = primitives.Sign(key1.Key[:], nbsk.MarshalForSig())
+	if err := b.add(StepSigningKey, managementID, nbsk.ToExternalIDs(), false); err != nil {
+		return nil, err
+	}
+
+	if k.btcKey != nil {
+		nbks := new(NewBitcoinKeyStructure)
+		nbks.FunctionName = []byte("New Bitcoin Key")
+		nbks.RootIdentityChainID = chainID
+		nbks.BitcoinKeyLevel = ks.BTCKeyLevel
+		nbks.KeyType = ks.BTCKeyType
+		copy(nbks.NewKey[:], k.btcKey)
+		nbks.Timestamp = ts8
+		nbks.PreimageIdentityKey = pre
+		nbks.Signature = primitives.Sign(key1.Key[:], nbks.MarshalForSig())
+		if err := b.add(StepBTCKey, managementID, nbks.ToExternalIDs(), false); err != nil {
+			return nil, err
+		}
+	}
+
+	if k.mHash != nil {
+		nmh := new(NewMatryoshkaHashStructure)
+		nmh.FunctionName = []byte("New Matryoshka Hash")
+		nmh.RootIdentityChainID = chainID
+		nmh.OutermostMHash = k.mHash
+		nmh.Timestamp = ts8
+		nmh.PreimageIdentityKey = pre
+		nmh.Signature = primitives.Sign(key1.Key[:], nmh.MarshalForSig())
+		if err := b.add(StepMatryoshkaHash, managementID, nmh.ToExternalIDs(), false); err != nil {
+			return nil, err
+		}
+	}
+
+	if ks.LinkECKey {
+		// [0 (version)] [Link Entry Credit Key] [identity ChainID] [Entry Credit public key] [signature of version through ChainID],
+		// signed with the entry credit key, see identityEntries.LinkEntryCreditKeyStructure
+		pub, err := primitives.PrivateKeyToPublicKey(k.ec)
+		if err != nil {
+			return nil, err
+		}
+		extIDs := [][]byte{{0}, []byte("Link Entry Credit Key"), chainID.Bytes(), pub}
+		sigmsg, err := state.AppendExtIDs(extIDs, 0, 2)
+		if err != nil {
+			return nil, err
+		}
+		extIDs = append(extIDs, primitives.Sign(k.ec, sigmsg))
+		if err := b.add(StepLinkECKey, chainID, extIDs, false); err != nil {
+			return nil, err
+		}
+	}
+
+	id.Steps = b.steps
+	return id, nil
+}
+
+type builder struct {
+	ec    []byte
+	ts    interfaces.Timestamp
+	steps []*Step
+}
+
+// add builds the entry of a step, signs the commit paying for it, and adds it
+func (b *builder) add(name string, chainID interfaces.IHash, extIDs [][]byte, newChain bool) error {
+	entry := entryBlock.NewEntry()
+	entry.ChainID = chainID
+	for _, v := range extIDs {
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: v})
+	}
+
+	s := &Step{Name: name, ChainID: chainID.String(), EntryHash: entry.GetHash().String()}
+	var commit interfaces.BinaryMarshallable
+	var err error
+	if newChain {
+		commit, err = compose.NewChainCommit(entry, b.ec, b.ts)
+		s.CommitMethod, s.RevealMethod = "commit-chain", "reveal-chain"
+	} else {
+		commit, err = compose.NewEntryCommit(entry, b.ec, b.ts)
+		s.CommitMethod, s.RevealMethod = "commit-entry", "reveal-entry"
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	bin, err := commit.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	s.Commit = hex.EncodeToString(bin)
+	bin, err = entry.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	s.Reveal = hex.EncodeToString(bin)
+
+	b.steps = append(b.steps, s)
+	return nil
+}
+
+// nonce decodes a nonce given in hex, or with none finds the first 8 byte
+// nonce giving a chain ID starting 888888 after the other external IDs
+func nonce(given string, extIDs [][]byte) ([]byte, error) {
+	if given != "" {
+		n, err := hex.DecodeString(given)
+		if err != nil {
+			return nil, err
+		}
+		if !isIdentityChainID(entryBlock.ExternalIDsToChainID(append(extIDs, n))) {
+			return nil, fmt.Errorf("%s does not give a chain ID starting 888888", given)
+		}
+		return n, nil
+	}
+
+	// The chain ID is the hash of the hashes of the external IDs, so only the
+	// nonce's hash and the last hash change
+	buf := make([]byte, 0, 32*(len(extIDs)+1))
+	for _, v := range extIDs {
+		h := sha256.Sum256(v)
+		buf = append(buf, h[:]...)
+	}
+	buf = buf[:cap(buf)]
+	n := make([]byte, 8)
+	for i := uint64(0); ; i++ {
+		binary.BigEndian.PutUint64(n, i)
+		h := sha256.Sum256(n)
+		copy(buf[len(buf)-32:], h[:])
+		id := sha256.Sum256(buf)
+		if id[0] == 0x88 && id[1] == 0x88 && id[2] == 0x88 {
+			return n, nil
+		}
+	}
+}
+
+func isIdentityChainID(chainID interfaces.IHash) bool {
+	return chainID.String()[:6] == "888888"
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identityBuilder_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	. "github.com/FactomProject/factomd/identityBuilder"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+const (
+	testChainID      = "888888e888a320c1c5244ff3098b6c940236c3cf72698f17d9e0d0adca6c88d8"
+	testManagementID = "8888882b7639356b527c84e177dcc22b10b619b1ff19c372dad0f46eef280f86"
+	testTime         = 1500000000
+)
+
+// testKeySet has the nonces of its chains, which take a while to find
+func testKeySet() *KeySet {
+	ks := new(KeySet)
+	for i := uint64(1); i <= 4; i++ {
+		ks.IdentityKeys = append(ks.IdentityKeys, testHelper.NewPrivKeyString(i))
+	}
+	ks.SigningKey = testHelper.NewPrivKeyString(5)
+	ks.BTCKey = "c5b7fd920dce5f61934e792c7e6fcc829aff533d"
+	ks.MatryoshkaHash = "bf1e78e5755851242a2ebf703e8bf6aca1af9dbae09ebc495cd2da220e5d370f"
+	ks.ECKey, _ = primitives.PrivateKeyStringToHumanReadableECPrivateKey(testHelper.NewPrivKeyString(6))
+	ks.LinkECKey = true
+	ks.IdentityNonce = "0000000001753768"
+	ks.ManagementNonce = "0000000001522ac1"
+	return ks
+}
+
+func build(t *testing.T) *Identity {
+	id, err := Build(testKeySet(), primitives.NewTimestampFromSeconds(testTime))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return id
+}
+
+func TestBuild(t *testing.T) {
+	id := build(t)
+	if id.ChainID != testChainID || id.ManagementChainID != testManagementID {
+		t.Errorf("Bad chains %v and %v", id.ChainID, id.ManagementChainID)
+	}
+
+	names := []string{StepIdentityChain, StepRegisterIdentity, StepManagementChain, StepRegisterManagement,
+		StepSigningKey, StepBTCKey, StepMatryoshkaHash, StepLinkECKey}
+	if len(id.Steps) != len(names) {
+		t.Fatalf("Got %v steps, expected %v", len(id.Steps), len(names))
+	}
+	for i, s := range id.Steps {
+		if s.Name != names[i] {
+			t.Errorf("Step %v is %v, expected %v", i, s.Name, names[i])
+		}
+		if s.NewChain() != (s.Name == StepIdentityChain || s.Name == StepManagementChain) {
+			t.Errorf("Step %v commits with %v", s.Name, s.CommitMethod)
+		}
+	}
+	if c, r := id.Steps[0].Requests(1); c.Method != "commit-chain" || r.Method != "reveal-chain" {
+		t.Errorf("Bad requests %v and %v", c.Method, r.Method)
+	}
+
+	if err := Validate(id.Steps, testTime); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	// Only the keys are needed
+	ks := testKeySet()
+	ks.BTCKey, ks.MatryoshkaHash, ks.LinkECKey = "", "", false
+	id, err := Build(ks, primitives.NewTimestampFromSeconds(testTime))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(id.Steps) != 5 {
+		t.Errorf("Got %v steps, expected 5", len(id.Steps))
+	}
+	if err := Validate(id.Steps, testTime); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	ks.IdentityNonce = "00"
+	if _, err := Build(ks, primitives.NewTimestampFromSeconds(testTime)); err == nil {
+		t.Errorf("Expected an error for a nonce not giving an identity chain ID")
+	}
+	ks = testKeySet()
+	ks.IdentityKeys = ks.IdentityKeys[:3]
+	if _, err := Build(ks, primitives.NewTimestampFromSeconds(testTime)); err == nil {
+		t.Errorf("Expected an error for 3 identity keys")
+	}
+}
+
+// TestLoad loads the built entries into a state as factomd loads an
+// identity: its identity chain, then its registration, then its management
+// chain
+func TestLoad(t *testing.T) {
+	id := build(t)
+	entries := map[string][]*entryBlock.Entry{}
+	for _, s := range id.Steps {
+		bin, _ := hex.DecodeString(s.Reveal)
+		entry := entryBlock.NewEntry()
+		if err := entry.UnmarshalBinary(bin); err != nil {
+			t.Fatalf("%v", err)
+		}
+		entries[s.ChainID] = append(entries[s.ChainID], entry)
+	}
+
+	s := testHelper.CreateEmptyTestState()
+	s.Clock = primitives.NewVirtualClock(time.Unix(testTime, 0))
+	chainID, _ := primitives.HexToHash(testChainID)
+	index := s.CreateBlankFactomIdentity(chainID)
+	for _, entry := range entries[testChainID] {
+		state.LoadIdentityByEntry(entry, s, 1, true)
+	}
+	for _, entry := range entries[state.MAIN_FACTOM_IDENTITY_LIST] {
+		if err := state.RegisterFactomIdentity(entry, chainID, 1, s); err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+	for _, entry := range entries[testManagementID] {
+		state.LoadIdentityByEntry(entry, s, 1, true)
+	}
+
+	ks := testKeySet()
+	signingKey, _ := primitives.NewPrivateKeyFromHex(ks.SigningKey)
+	identity := s.Identities[index]
+	switch {
+	case identity.IdentityRegistered == 0 || identity.ManagementRegistered == 0:
+		t.Errorf("The identity is not registered")
+	case identity.ManagementChainID == nil || identity.ManagementChainID.String() != testManagementID:
+		t.Errorf("Bad management chain %v", identity.ManagementChainID)
+	case identity.SigningKey == nil || !identity.SigningKey.IsSameAs(primitives.NewHash(signingKey.Pub[:])):
+		t.Errorf("Bad signing key %v", identity.SigningKey)
+	case identity.MatryoshkaHash == nil || identity.MatryoshkaHash.String() != ks.MatryoshkaHash:
+		t.Errorf("Bad matryoshka hash %v", identity.MatryoshkaHash)
+	case len(identity.AnchorKeys) != 1:
+		t.Errorf("Got %d anchor keys, expected 1", len(identity.AnchorKeys))
+	}
+}
+
+// resign changes the external IDs of the entry of a step, and pays for it again
+func resign(t *testing.T, s *Step, change func(extIDs [][]byte)) {
+	bin, _ := hex.DecodeString(s.Reveal)
+	entry := entryBlock.NewEntry()
+	if err := entry.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("%v", err)
+	}
+	extIDs := entry.ExternalIDs()
+	change(extIDs)
+	for i := range extIDs {
+		entry.ExtIDs[i].Bytes = extIDs[i]
+	}
+
+	priv := testHelper.NewPrivKey(6)
+	commit, err := compose.NewEntryCommit(entry, priv, primitives.NewTimestampFromSeconds(testTime))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	bin, _ = commit.MarshalBinary()
+	s.Commit = hex.EncodeToString(bin)
+	bin, _ = entry.MarshalBinary()
+	s.Reveal = hex.EncodeToString(bin)
+	s.EntryHash = entry.GetHash().String()
+}
+
+func TestValidate(t *testing.T) {
+	for name, test := range map[string]struct {
+		change func(steps []*Step) []*Step
+		now    int64
+		err    string
+	}{
+		"unregistered management chain": {
+			change: func(steps []*Step) []*Step { return append(steps[:3:3], steps[4:]...) },
+			err:    "registered management chain",
+		},
+		"keys first": {
+			change: func(steps []*Step) []*Step { return append(steps[4:5:5], steps...) },
+			err:    "before the identity chain",
+		},
+		"no signing key": {
+			change: func(steps []*Step) []*Step { return steps[:4] },
+			err:    "no block signing key",
+		},
+		"old timestamp": {
+			change: func(steps []*Step) []*Step { return steps },
+			now:    testTime + 13*60*60,
+			err:    "12 hours",
+		},
+		"commit of another entry": {
+			change: func(steps []*Step) []*Step {
+				steps[1].Commit = steps[3].Commit
+				return steps
+			},
+			err: "The commit is for entry",
+		},
+		"changed signing key": {
+			change: func(steps []*Step) []*Step {
+				resign(t, steps[4], func(extIDs [][]byte) { extIDs[3][0]++ })
+				return steps
+			},
+			err: "Bad signature",
+		},
+		"signing key in the identity chain": {
+			change: func(steps []*Step) []*Step {
+				steps[4].ChainID = testChainID
+				bin, _ := hex.DecodeString(steps[4].Reveal)
+				id, _ := primitives.HexToHash(testChainID)
+				copy(bin[1:33], id.Bytes())
+				steps[4].Reveal = hex.EncodeToString(bin)
+				resign(t, steps[4], func([][]byte) {})
+				return steps
+			},
+			err: "registered management chain",
+		},
+		"version": {
+			change: func(steps []*Step) []*Step {
+				resign(t, steps[1], func(extIDs [][]byte) { extIDs[0][0] = 1 })
+				return steps
+			},
+			err: "Wrong version",
+		},
+	} {
+		now := test.now
+		if now == 0 {
+			now = testTime
+		}
+		err := Validate(test.change(build(t).Steps), now)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got %v, expected %s", name, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identityBuilder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	. "github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/state"
+)
+
+// Validate checks the steps, in the order they are to be submitted, against
+// the rules factomd applies to identity entries, and that each commit pays
+// for its entry.  now, in Unix seconds, is when they are to be submitted, and
+// the timestamps of key entries must be within 12 hours of it.  The steps
+// must make an identity factomd will load: both chains, their registrations
+// and a block signing key.
+func Validate(steps []*Step, now int64) error {
+	v := new(validator)
+	for i, s := range steps {
+		if err := v.step(s, now); err != nil {
+			return fmt.Errorf("Step %d, %s: %v", i+1, s.Name, err)
+		}
+	}
+
+	switch {
+	case v.identity == nil:
+		return fmt.Errorf("There is no identity chain")
+	case !v.registered:
+		return fmt.Errorf("The identity is not registered")
+	case v.management == nil:
+		return fmt.Errorf("There is no registered server management chain")
+	case !v.signingKey:
+		return fmt.Errorf("There is no block signing key")
+	}
+	return nil
+}
+
+// validator is what the steps so far have made, as the state would hold it
+type validator struct {
+	identity   interfaces.IHash
+	key1       interfaces.IHash
+	registered bool
+	created    interfaces.IHash // The management chain, once created
+	management interfaces.IHash // and once registered
+	signingKey bool
+}
+
+func (v *validator) step(s *Step, now int64) error {
+	entry, err := decodeStep(s)
+	if err != nil {
+		return err
+	}
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) < 2 || len(extIDs[0]) != 1 {
+		return fmt.Errorf("Not an identity entry")
+	}
+	if extIDs[0][0] != 0 {
+		return fmt.Errorf("Wrong version - expected 0, got %v", extIDs[0][0])
+	}
+	chainID := entry.GetChainID()
+
+	name := string(extIDs[1])
+	if name != "Identity Chain" && v.identity == nil {
+		return fmt.Errorf("%s before the identity chain", name)
+	}
+	switch name {
+	case "Identity Chain":
+		if v.identity != nil {
+			return fmt.Errorf("A second identity chain")
+		}
+		if !s.NewChain() {
+			return fmt.Errorf("The identity chain must be created with a chain commit")
+		}
+		ics, err := DecodeIdentityChainStructureFromExtIDs(extIDs)
+		if err != nil {
+			return err
+		}
+		v.identity, v.key1 = chainID, ics.Key1
+
+	case "Register Factom Identity":
+		if chainID.String() != state.MAIN_FACTOM_IDENTITY_LIST {
+			return fmt.Errorf("Not in the identity registration chain %s", state.MAIN_FACTOM_IDENTITY_LIST)
+		}
+		if err := v.check(extIDs, []int{1, 24, 32, 33, 64}, 2); err != nil {
+			return err
+		}
+		v.registered = true
+
+	case "Server Management":
+		if v.created != nil {
+			return fmt.Errorf("A second server management chain")
+		}
+		if !s.NewChain() {
+			return fmt.Errorf("The server management chain must be created with a chain commit")
+		}
+		sm, err := DecodeServerManagementStructureFromExtIDs(extIDs)
+		if err != nil {
+			return err
+		}
+		if !sm.RootIdentityChainID.IsSameAs(v.identity) {
+			return fmt.Errorf("For identity %v, not %v", sm.RootIdentityChainID, v.identity)
+		}
+		v.created = chainID
+
+	case "Register Server Management":
+		if !chainID.IsSameAs(v.identity) {
+			return fmt.Errorf("Not in the identity chain")
+		}
+		if !CheckExternalIDsLength(extIDs, []int{1, 26, 32, 33, 64}) {
+			return fmt.Errorf("Invalid external ID length")
+		}
+		if v.created == nil || !bytes.Equal(extIDs[2], v.created.Bytes()) {
+			return fmt.Errorf("Registers %x, not the server management chain created", extIDs[2])
+		}
+		if err := v.checkSig(extIDs, 2); err != nil {
+			return err
+		}
+		v.management = v.created
+
+	case "New Block Signing Key":
+		if err := v.checkKeyEntry(chainID, extIDs, []int{1, 21, 32, 32, 8, 33, 64}, 4, now); err != nil {
+			return err
+		}
+		v.signingKey = true
+
+	case "New Bitcoin Key":
+		if err := v.checkKeyEntry(chainID, extIDs, []int{1, 15, 32, 1, 1, 20, 8, 33, 64}, 6, now); err != nil {
+			return err
+		}
+		if extIDs[4][0] > 1 {
+			return fmt.Errorf("Invalid Bitcoin key type %d", extIDs[4][0])
+		}
+
+	case "New Matryoshka Hash":
+		if err := v.checkKeyEntry(chainID, extIDs, []int{1, 19, 32, 32, 8, 33, 64}, 4, now); err != nil {
+			return err
+		}
+
+	case "Link Entry Credit Key":
+		if !chainID.IsSameAs(v.identity) {
+			return fmt.Errorf("Not in the identity chain")
+		}
+		if !CheckExternalIDsLength(extIDs, []int{1, 21, 32, 32, 64}) {
+			return fmt.Errorf("Invalid external ID length")
+		}
+		sigmsg, err := state.AppendExtIDs(extIDs, 0, 2)
+		if err != nil {
+			return err
+		}
+		if !primitives.VerifySlice(extIDs[3], sigmsg, extIDs[4]) {
+			return fmt.Errorf("Bad signature")
+		}
+
+	default:
+		return fmt.Errorf("Unknown identity entry '%s'", name)
+	}
+	return nil
+}
+
+// checkKeyEntry checks an entry of the management chain, whose timestamp is
+// the external ID before the preimage, as RegisterBlockSigningKey and the
+// like do
+func (v *validator) checkKeyEntry(chainID interfaces.IHash, extIDs [][]byte, lengths []int, signed int, now int64) error {
+	if v.management == nil || !chainID.IsSameAs(v.management) {
+		return fmt.Errorf("Entry was not placed in the registered management chain")
+	}
+	if err := v.check(extIDs, lengths, signed); err != nil {
+		return err
+	}
+	if !state.CheckTimestamp(extIDs[signed], now) {
+		return fmt.Errorf("The timestamp is not within 12 hours of %d", now)
+	}
+	return nil
+}
+
+// check checks the lengths of the external IDs, that the third is the
+// identity chain, and the signature of the first to signed
+func (v *validator) check(extIDs [][]byte, lengths []int, signed int) error {
+	if !CheckExternalIDsLength(extIDs, lengths) {
+		return fmt.Errorf("Invalid external ID length")
+	}
+	if !bytes.Equal(extIDs[2], v.identity.Bytes()) {
+		return fmt.Errorf("For identity %x, not %v", extIDs[2], v.identity)
+	}
+	return v.checkSig(extIDs, signed)
+}
+
+// checkSig checks the signature of the first to signed external IDs by
+// identity key 1, whose preimage follows them
+func (v *validator) checkSig(extIDs [][]byte, signed int) error {
+	sigmsg, err := state.AppendExtIDs(extIDs, 0, signed)
+	if err != nil {
+		return err
+	}
+	pre := extIDs[signed+1]
+	if pre[0] != 1 {
+		return fmt.Errorf("Invalid identity key preimage prefix byte - expected 1, got %v", pre[0])
+	}
+	if !state.CheckSig(v.key1, pre[1:33], sigmsg, extIDs[signed+2]) {
+		return fmt.Errorf("Bad signature, or not by identity key 1")
+	}
+	return nil
+}
+
+// decodeStep decodes the entry of a step, and checks its commit pays for it
+func decodeStep(s *Step) (*entryBlock.Entry, error) {
+	bin, err := hex.DecodeString(s.Reveal)
+	if err != nil {
+		return nil, fmt.Errorf("Reveal: %v", err)
+	}
+	entry := entryBlock.NewEntry()
+	if err := entry.UnmarshalBinary(bin); err != nil {
+		return nil, fmt.Errorf("Reveal: %v", err)
+	}
+	if !entry.IsValid() {
+		return nil, fmt.Errorf("Invalid entry")
+	}
+	credits, err := compose.EntryCost(entry)
+	if err != nil {
+		return nil, err
+	}
+	if s.ChainID != entry.GetChainID().String() || s.EntryHash != entry.GetHash().String() {
+		return nil, fmt.Errorf("The chain ID or entry hash does not match the entry")
+	}
+
+	bin, err = hex.DecodeString(s.Commit)
+	if err != nil {
+		return nil, fmt.Errorf("Commit: %v", err)
+	}
+	switch {
+	case s.NewChain() && s.RevealMethod == "reveal-chain":
+		if !entryBlock.NewChainID(entry).IsSameAs(entry.GetChainID()) {
+			return nil, fmt.Errorf("The chain ID is not that of the first entry")
+		}
+		commit := entryCreditBlock.NewCommitChain()
+		if err := commit.UnmarshalBinary(bin); err != nil {
+			return nil, fmt.Errorf("Commit: %v", err)
+		}
+		chainID := entry.GetChainID().Bytes()
+		switch {
+		case !commit.IsValid():
+			return nil, fmt.Errorf("Invalid chain commit")
+		case !commit.EntryHash.IsSameAs(entry.GetHash()):
+			return nil, fmt.Errorf("The commit is for entry %v", commit.EntryHash)
+		case !bytes.Equal(commit.ChainIDHash.Bytes(), primitives.DoubleSha(chainID)):
+			return nil, fmt.Errorf("The commit is for another chain")
+		case !bytes.Equal(commit.Weld.Bytes(), primitives.DoubleSha(append(entry.GetHash().Bytes(), chainID...))):
+			return nil, fmt.Errorf("The commit's weld does not match the entry")
+		case commit.Credits < credits+compose.NewChainCost:
+			return nil, fmt.Errorf("The commit pays %d credits, the chain costs %d", commit.Credits, credits+compose.NewChainCost)
+		}
+	case s.CommitMethod == "commit-entry" && s.RevealMethod == "reveal-entry":
+		commit := entryCreditBlock.NewCommitEntry()
+		if err := commit.UnmarshalBinary(bin); err != nil {
+			return nil, fmt.Errorf("Commit: %v", err)
+		}
+		switch {
+		case !commit.IsValid():
+			return nil, fmt.Errorf("Invalid entry commit")
+		case !commit.EntryHash.IsSameAs(entry.GetHash()):
+			return nil, fmt.Errorf("The commit is for entry %v", commit.EntryHash)
+		case commit.Credits < credits:
+			return nil, fmt.Errorf("The commit pays %d credits, the entry costs %d", commit.Credits, credits)
+		}
+	default:
+		return nil, fmt.Errorf("Unknown methods %s and %s", s.CommitMethod, s.RevealMethod)
+	}
+	return entry, nil
+}
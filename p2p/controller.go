@@ -174,7 +174,7 @@ func (e *CommandChangeLogging) String() string {
 func (c *Controller) Init(ci ControllerInit) *Controller {
 	note("ctrlr", "\n\n\n\n\nController.Init(%s) %#x", ci.Port, ci.Network)
 	note("ctrlr", "\n\n\n\n\nController.Init(%s) ci: %+v\n\n", ci.Port, ci)
-	RandomGenerator = rand.New(rand.NewSource(time.Now().UnixNano()))
+	RandomGenerator = rand.New(rand.NewSource(randomSeed()))
 	NodeID = uint64(RandomGenerator.Int63()) // This is a global used by all connections
 	c.keepRunning = true
 	c.commandChannel = make(chan interface{}, StandardChannelSize) // Commands from App
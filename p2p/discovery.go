@@ -39,7 +39,7 @@ func (d *Discovery) Init(peersFile string, seed string) *Discovery {
 	UpdateKnownPeers.Lock()
 	d.knownPeers = map[string]Peer{}
 	UpdateKnownPeers.Unlock()
-	d.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	d.rng = rand.New(rand.NewSource(randomSeed()))
 	d.peersFilePath = peersFile
 	d.seedURL = seed
 	//d.LoadPeers()
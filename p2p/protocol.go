@@ -17,6 +17,14 @@ import (
 
 // This file contains the global variables and utility functions for the p2p network operation.  The global variables and constants can be tweaked here.
 
+// randomSeed returns RandomSeed, or the time if it is not set
+func randomSeed() int64 {
+	if RandomSeed != 0 {
+		return RandomSeed
+	}
+	return time.Now().UnixNano()
+}
+
 // BlockFreeChannelSend will remove things from the queue to make room for new messages if the queue is full.
 // This prevents channel blocking on full.
 //		Returns: The number of elements cleared from the channel to make room
@@ -75,6 +83,7 @@ var (
 
 	CRCKoopmanTable = crc32.MakeTable(crc32.Koopman)
 	RandomGenerator *rand.Rand // seeded pseudo-random number generator
+	RandomSeed      int64      // Seeds RandomGenerator and discovery, if not 0, for repeatable simulations
 
 )
 
@@ -5,10 +5,13 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"math"
 	"os"
 
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
 	. "github.com/FactomProject/factomd/receipts"
 	"github.com/FactomProject/factomd/state"
 	"github.com/FactomProject/factomd/util"
@@ -18,19 +21,29 @@ const level string = "level"
 const bolt string = "bolt"
 
 func main() {
+	var (
+		archive = flag.String("archive", "", "Write the receipts to an archive in this directory, rather than a file each")
+		chain   = flag.String("chain", "", "Only archive the entries of this chain")
+		start   = flag.Uint("start", 0, "First directory block height to archive")
+		end     = flag.Uint("end", math.MaxUint32, "Last directory block height to archive, defaults to the highest")
+		minimal = flag.Bool("minimal", false, "Archive minimal receipts")
+	)
+	flag.Parse()
+
 	fmt.Println("Usage:")
 	fmt.Println("ReceiptGenerator level/bolt [EntryID-To-Extract]")
+	fmt.Println("ReceiptGenerator -archive dir [-chain ChainID] [-start height] [-end height] [-minimal] level/bolt")
 	fmt.Println("Leave out the last one to export all entries")
-	if len(os.Args) < 1 {
+	if len(flag.Args()) < 1 {
 		fmt.Println("\nNot enough arguments passed")
 		os.Exit(1)
 	}
-	if len(os.Args) > 2 {
+	if len(flag.Args()) > 2 {
 		fmt.Println("\nToo many arguments passed")
 		os.Exit(1)
 	}
 
-	levelBolt := os.Args[1]
+	levelBolt := flag.Args()[0]
 
 	if levelBolt != level && levelBolt != bolt {
 		fmt.Println("\nFirst argument should be `level` or `bolt`")
@@ -38,8 +51,17 @@ func main() {
 	}
 
 	entryID := ""
-	if len(os.Args) == 3 {
-		entryID = os.Args[2]
+	if len(flag.Args()) == 2 {
+		entryID = flag.Args()[1]
+	}
+
+	var chainID interfaces.IHash
+	if *chain != "" {
+		h, err := primitives.NewShaHashFromStr(*chain)
+		if err != nil {
+			panic(err)
+		}
+		chainID = h
 	}
 
 	state := new(state.State)
@@ -59,7 +81,21 @@ func main() {
 	dbo := state.GetAndLockDB().(interfaces.DBOverlay)
 	defer state.UnlockDB()
 
-	if entryID != "" {
+	if *archive != "" {
+		last := uint32(*end)
+		head, err := dbo.FetchDBlockHead()
+		if err != nil {
+			panic(err)
+		}
+		if head != nil && head.GetDatabaseHeight() < last {
+			last = head.GetDatabaseHeight()
+		}
+		count, err := ExportReceiptArchive(dbo, *archive, chainID, uint32(*start), last, *minimal)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Archived %v receipts in %v\n", count, *archive)
+	} else if entryID != "" {
 		err := ExportEntryReceipt(entryID, dbo)
 		if err != nil {
 			panic(err)
@@ -0,0 +1,90 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT license
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts/verifier"
+)
+
+func main() {
+	var (
+		header     = flag.String("header", "", "File holding the hex of the directory block, or its header")
+		aBlock     = flag.String("ablock", "", "File holding the hex of the admin block of the next directory block")
+		fedKeys    = flag.String("fedkeys", "", "Comma separated signing keys of the federated servers")
+		anchorFile = flag.String("anchor", "", "File holding the hex of the anchor chain entry")
+		anchorKeys = flag.String("anchorkeys", "", "Comma separated anchor signing keys, defaults to Factom's")
+	)
+	flag.Parse()
+
+	if len(flag.Args()) != 1 {
+		fmt.Println("Usage:")
+		fmt.Println("ReceiptVerifier [-header file] [-ablock file -fedkeys keys] [-anchor file [-anchorkeys keys]] ReceiptFile")
+		fmt.Println("Verifies a receipt without a node or a database")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	receipt, err := ioutil.ReadFile(flag.Args()[0])
+	if err != nil {
+		panic(err)
+	}
+
+	evidence := new(verifier.Evidence)
+	evidence.DirectoryBlockHeader = readHexFile(*header)
+	evidence.AdminBlock = readHexFile(*aBlock)
+	evidence.FederatedServerKeys = readHexList(*fedKeys)
+	evidence.AnchorEntry = readHexFile(*anchorFile)
+	evidence.AnchorKeys = readHexList(*anchorKeys)
+
+	result := verifier.VerifyString(string(receipt), evidence)
+	str, err := primitives.EncodeJSONString(result)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(str)
+
+	if result.Valid == false {
+		os.Exit(1)
+	}
+}
+
+func readHexFile(file string) []byte {
+	if file == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		panic(err)
+	}
+	b, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		panic(fmt.Sprintf("%v: %v", file, err))
+	}
+	return b
+}
+
+func readHexList(list string) [][]byte {
+	var answer [][]byte
+	for _, v := range strings.Split(list, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		b, err := hex.DecodeString(v)
+		if err != nil {
+			panic(fmt.Sprintf("%v: %v", v, err))
+		}
+		answer = append(answer, b)
+	}
+	return answer
+}
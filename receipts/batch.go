@@ -0,0 +1,313 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package receipts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/FactomProject/factomd/common/directoryBlock/dbInfo"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Files of a receipt archive.  The archive holds one JSON receipt per line,
+// and the index one "entryhash offset length" line per receipt.
+const (
+	ArchiveFile      = "receipts.jsonl"
+	ArchiveIndexFile = "receipts.index"
+)
+
+// CreateReceiptsForRange creates the receipt of every entry in directory
+// blocks start to end inclusive, and passes each to fn.  If chainID is not
+// nil only the entries of that chain are included.  The Merkle tree of each
+// directory and entry block is built once and shared by all the receipts
+// under it, rather than once per entry as CreateFullReceipt does.
+func CreateReceiptsForRange(dbo interfaces.DBOverlaySimple, chainID interfaces.IHash, start, end uint32, fn func(*Receipt) error) error {
+	for height := start; height <= end; height++ {
+		dBlock, err := dbo.FetchDBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		if dBlock == nil {
+			return fmt.Errorf("DBlock %v not found", height)
+		}
+		err = createDirectoryBlockReceipts(dbo, dBlock, chainID, fn)
+		if err != nil {
+			return err
+		}
+		if height == end { // end may be the largest uint32
+			break
+		}
+	}
+	return nil
+}
+
+func createDirectoryBlockReceipts(dbo interfaces.DBOverlaySimple, dBlock interfaces.IDirectoryBlock, chainID interfaces.IHash, fn func(*Receipt) error) error {
+	dHashes := dBlock.GetEntryHashesForBranch()
+	dTree := primitives.BuildMerkleTreeStore(dHashes)
+
+	headerHash, err := dBlock.HeaderHash()
+	if err != nil {
+		return err
+	}
+	dBlockNode := new(primitives.MerkleNode)
+	dBlockNode.Left = headerHash.(*primitives.Hash)
+	dBlockNode.Right = dBlock.BodyKeyMR().(*primitives.Hash)
+	dBlockNode.Top = dBlock.DatabasePrimaryIndex().(*primitives.Hash)
+
+	template := new(Receipt)
+	template.DirectoryBlockKeyMR = dBlockNode.Top
+	dirBlockInfo, err := dbo.FetchDirBlockInfoByKeyMR(dBlockNode.Top)
+	if err != nil {
+		return err
+	}
+	if dirBlockInfo != nil {
+		dbi := dirBlockInfo.(*dbInfo.DirBlockInfo)
+		template.BitcoinTransactionHash = dbi.BTCTxHash.(*primitives.Hash)
+		template.BitcoinBlockHash = dbi.BTCBlockHash.(*primitives.Hash)
+	}
+
+	for _, dbEntry := range dBlock.GetEBlockDBEntries() {
+		if chainID != nil && dbEntry.GetChainID().IsSameAs(chainID) == false {
+			continue
+		}
+		index := -1
+		for i, h := range dHashes {
+			if h.IsSameAs(dbEntry.GetKeyMR()) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return fmt.Errorf("EBlock %v not found in DBlock %v", dbEntry.GetKeyMR(), dBlockNode.Top)
+		}
+		dBranch := primitives.BuildMerkleBranchFromStore(dTree, len(dHashes), index, true)
+		dBranch = append(dBranch, dBlockNode)
+
+		eBlock, err := dbo.FetchEBlock(dbEntry.GetKeyMR())
+		if err != nil {
+			return err
+		}
+		if eBlock == nil {
+			return fmt.Errorf("EBlock %v not found", dbEntry.GetKeyMR())
+		}
+		err = createEntryBlockReceipts(eBlock, template, dBranch, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func createEntryBlockReceipts(eBlock interfaces.IEntryBlock, template *Receipt, dBranch []*primitives.MerkleNode, fn func(*Receipt) error) error {
+	eHashes := eBlock.GetEntryHashes()
+	eTree := primitives.BuildMerkleTreeStore(eHashes)
+
+	headerHash, err := eBlock.HeaderHash()
+	if err != nil {
+		return err
+	}
+	eBlockNode := new(primitives.MerkleNode)
+	eBlockNode.Left = headerHash.(*primitives.Hash)
+	eBlockNode.Right = eBlock.BodyKeyMR().(*primitives.Hash)
+	eBlockNode.Top = eBlock.DatabasePrimaryIndex().(*primitives.Hash)
+
+	done := map[[32]byte]bool{}
+	for i, h := range eHashes {
+		// An entry repeated in a block gets the branch of its first copy,
+		// as with CreateReceipt
+		if h.IsMinuteMarker() || done[h.Fixed()] {
+			continue
+		}
+		done[h.Fixed()] = true
+
+		receipt := new(Receipt)
+		*receipt = *template
+		receipt.Entry = new(JSON)
+		receipt.Entry.EntryHash = h.String()
+		receipt.EntryBlockKeyMR = eBlockNode.Top
+
+		branch := primitives.BuildMerkleBranchFromStore(eTree, len(eHashes), i, true)
+		branch = append(branch, eBlockNode)
+		branch = append(branch, dBranch...)
+		// The block nodes are shared, so give each receipt its own copy
+		// in case it is trimmed
+		receipt.MerkleBranch = make([]*primitives.MerkleNode, len(branch))
+		for j, node := range branch {
+			n := *node
+			receipt.MerkleBranch[j] = &n
+		}
+
+		err = fn(receipt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ArchiveWriter writes receipts to a receipt archive.
+type ArchiveWriter struct {
+	Minimal bool // Trim receipts before they are written
+	Count   int
+
+	data     *os.File
+	index    *os.File
+	dataBuf  *bufio.Writer
+	indexBuf *bufio.Writer
+	offset   int64
+}
+
+// NewArchiveWriter creates a receipt archive in dir, replacing any that is
+// there.
+func NewArchiveWriter(dir string) (*ArchiveWriter, error) {
+	err := os.MkdirAll(dir, 0777)
+	if err != nil {
+		return nil, err
+	}
+	w := new(ArchiveWriter)
+	w.data, err = os.Create(filepath.Join(dir, ArchiveFile))
+	if err != nil {
+		return nil, err
+	}
+	w.index, err = os.Create(filepath.Join(dir, ArchiveIndexFile))
+	if err != nil {
+		w.data.Close()
+		return nil, err
+	}
+	w.dataBuf = bufio.NewWriter(w.data)
+	w.indexBuf = bufio.NewWriter(w.index)
+	return w, nil
+}
+
+// Add writes an entry receipt to the archive.
+func (w *ArchiveWriter) Add(receipt *Receipt) error {
+	if receipt.Entry == nil {
+		return fmt.Errorf("Only entry receipts can be archived")
+	}
+	if w.Minimal {
+		receipt.TrimReceipt()
+	}
+	data, err := receipt.JSONByte()
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.dataBuf.Write(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w.indexBuf, "%v %v %v\n", receipt.Entry.EntryHash, w.offset, len(data))
+	if err != nil {
+		return err
+	}
+	w.offset += int64(len(data))
+	w.Count++
+	return nil
+}
+
+// Close flushes and closes the archive.
+func (w *ArchiveWriter) Close() error {
+	err := w.dataBuf.Flush()
+	if err == nil {
+		err = w.indexBuf.Flush()
+	}
+	if err2 := w.data.Close(); err == nil {
+		err = err2
+	}
+	if err2 := w.index.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// ExportReceiptArchive writes the receipts of directory blocks start to end,
+// of one chain or of all chains if chainID is nil, to an archive in dir.  It
+// returns the number of receipts written.
+func ExportReceiptArchive(dbo interfaces.DBOverlaySimple, dir string, chainID interfaces.IHash, start, end uint32, minimal bool) (int, error) {
+	w, err := NewArchiveWriter(dir)
+	if err != nil {
+		return 0, err
+	}
+	w.Minimal = minimal
+	err = CreateReceiptsForRange(dbo, chainID, start, end, w.Add)
+	if err2 := w.Close(); err == nil {
+		err = err2
+	}
+	return w.Count, err
+}
+
+// Archive reads receipts from a receipt archive.
+type Archive struct {
+	data  *os.File
+	index map[string]archiveIndex
+}
+
+type archiveIndex struct {
+	offset int64
+	length int
+}
+
+// OpenArchive reads the index of the archive in dir.  An entry hash listed
+// more than once, from entries repeated across blocks, finds its first
+// receipt.
+func OpenArchive(dir string) (*Archive, error) {
+	index, err := os.Open(filepath.Join(dir, ArchiveIndexFile))
+	if err != nil {
+		return nil, err
+	}
+	defer index.Close()
+
+	a := new(Archive)
+	a.index = map[string]archiveIndex{}
+	scanner := bufio.NewScanner(index)
+	for scanner.Scan() {
+		var hash string
+		var i archiveIndex
+		_, err = fmt.Sscanf(scanner.Text(), "%s %d %d", &hash, &i.offset, &i.length)
+		if err != nil {
+			return nil, fmt.Errorf("Bad index line %q: %v", scanner.Text(), err)
+		}
+		if _, ok := a.index[hash]; !ok {
+			a.index[hash] = i
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	a.data, err = os.Open(filepath.Join(dir, ArchiveFile))
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Len is the number of entries in the archive.
+func (a *Archive) Len() int {
+	return len(a.index)
+}
+
+// Get returns the receipt of an entry, or nil if it is not in the archive.
+func (a *Archive) Get(entryHash string) (*Receipt, error) {
+	i, ok := a.index[entryHash]
+	if !ok {
+		return nil, nil
+	}
+	data := make([]byte, i.length)
+	_, err := a.data.ReadAt(data, i.offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return DecodeReceiptString(string(data))
+}
+
+func (a *Archive) Close() error {
+	return a.data.Close()
+}
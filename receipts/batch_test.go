@@ -0,0 +1,118 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package receipts_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/receipts"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func TestCreateReceiptsForRange(t *testing.T) {
+	dbo := CreateAndPopulateTestDatabaseOverlay()
+	head, err := dbo.FetchDBlockHead()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	count := 0
+	err = CreateReceiptsForRange(dbo, nil, 0, head.GetDatabaseHeight(), func(receipt *Receipt) error {
+		count++
+		if err := receipt.Validate(); err != nil {
+			t.Errorf("%v", err)
+		}
+		hash, err := primitives.NewShaHashFromStr(receipt.Entry.EntryHash)
+		if err != nil {
+			return err
+		}
+		expected, err := CreateFullReceipt(dbo, hash)
+		if err != nil {
+			return err
+		}
+		// An entry repeated in another block is only recorded in one
+		if expected.EntryBlockKeyMR.IsSameAs(receipt.EntryBlockKeyMR) && receipt.IsSameAs(expected) == false {
+			t.Errorf("Batch receipt differs from CreateFullReceipt for %v", receipt.Entry.EntryHash)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if count == 0 {
+		t.Errorf("No receipts created")
+	}
+
+	// One chain
+	blocks := CreateFullTestBlockSet()
+	chainID := blocks[0].EBlock.GetChainID()
+	err = CreateReceiptsForRange(dbo, chainID, 0, head.GetDatabaseHeight(), func(receipt *Receipt) error {
+		eBlock, err := dbo.FetchEBlock(receipt.EntryBlockKeyMR)
+		if err != nil {
+			return err
+		}
+		if eBlock.GetChainID().IsSameAs(chainID) == false {
+			t.Errorf("Receipt from chain %v", eBlock.GetChainID())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestReceiptArchive(t *testing.T) {
+	dbo := CreateAndPopulateTestDatabaseOverlay()
+	head, err := dbo.FetchDBlockHead()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	dir, err := ioutil.TempDir("", "receipts")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, minimal := range []bool{false, true} {
+		count, err := ExportReceiptArchive(dbo, dir, nil, 0, head.GetDatabaseHeight(), minimal)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		a, err := OpenArchive(dir)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if count == 0 || a.Len() == 0 || a.Len() > count {
+			t.Errorf("Archived %v receipts, index has %v", count, a.Len())
+		}
+
+		for _, block := range CreateFullTestBlockSet()[:BlockCount-2] {
+			for _, entry := range block.Entries {
+				receipt, err := a.Get(entry.GetHash().String())
+				if err != nil {
+					t.Fatalf("%v", err)
+				}
+				if receipt == nil {
+					t.Fatalf("Entry %v not in archive", entry.GetHash())
+				}
+				if err = receipt.Validate(); err != nil {
+					t.Errorf("%v", err)
+				}
+				if minimal && receipt.MerkleBranch[0].Top != nil {
+					t.Errorf("Expected a minimal receipt")
+				}
+			}
+		}
+
+		receipt, err := a.Get("0000000000000000000000000000000000000000000000000000000000000001")
+		if err != nil || receipt != nil {
+			t.Errorf("Expected no receipt for an unknown entry")
+		}
+		a.Close()
+	}
+}
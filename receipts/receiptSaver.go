@@ -66,20 +66,15 @@ func ExportEntryReceipt(entryID string, dbo interfaces.DBOverlaySimple) error {
 	return Save(receipt)
 }
 
+// ExportAllEntryReceipts saves the receipt of every entry, one file each.
+// ExportReceiptArchive writes the same receipts to a single archive.
 func ExportAllEntryReceipts(dbo interfaces.DBOverlay) error {
-	entryIDs, err := dbo.FetchAllEntryIDs()
+	head, err := dbo.FetchDBlockHead()
 	if err != nil {
 		return err
 	}
-	for i, entryID := range entryIDs {
-		err = ExportEntryReceipt(entryID.String(), dbo)
-		if err != nil {
-			if err.Error() != "dirBlockInfo not found" {
-				return err
-			} else {
-				fmt.Printf("dirBlockInfo not found for entry %v/%v - %v\n", i, len(entryIDs), entryID)
-			}
-		}
+	if head == nil {
+		return nil
 	}
-	return nil
+	return CreateReceiptsForRange(dbo, nil, 0, head.GetDatabaseHeight(), Save)
 }
@@ -5,28 +5,125 @@
 package receipts
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
 	"github.com/FactomProject/factomd/common/directoryBlock/dbInfo"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 )
 
+// Receipt types.  Entry receipts leave the type empty.
+const (
+	ReceiptTypeEntry       = "entry"
+	ReceiptTypeFactoid     = "factoid"
+	ReceiptTypeEntryCredit = "entrycredit"
+)
+
 type Receipt struct {
+	Type                   string                   `json:"type,omitempty"`
 	Entry                  *JSON                    `json:"entry,omitempty"`
+	Transaction            *JSON                    `json:"transaction,omitempty"` // Factoid transaction or EC commit, EntryHash is its hash in the block
 	MerkleBranch           []*primitives.MerkleNode `json:"merklebranch,omitempty"`
 	EntryBlockKeyMR        *primitives.Hash         `json:"entryblockkeymr,omitempty"`
+	FactoidBlockKeyMR      *primitives.Hash         `json:"factoidblockkeymr,omitempty"`
+	EntryCreditBlockKeyMR  *primitives.Hash         `json:"entrycreditblockkeymr,omitempty"`
+	EntryCreditBlock       string                   `json:"entrycreditblock,omitempty"` // ECBlocks have no Merkle tree, so the whole block is the proof
 	DirectoryBlockKeyMR    *primitives.Hash         `json:"directoryblockkeymr,omitempty"`
 	BitcoinTransactionHash *primitives.Hash         `json:"bitcointransactionhash,omitempty"`
 	BitcoinBlockHash       *primitives.Hash         `json:"bitcoinblockhash,omitempty"`
 }
 
+// start returns the hash the Merkle branch starts from, and the KeyMR of
+// the block that must be found on the way to the directory block.
+func (e *Receipt) start() (interfaces.IHash, interfaces.IHash, error) {
+	switch e.Type {
+	case "", ReceiptTypeEntry:
+		if e.Entry == nil {
+			return nil, nil, fmt.Errorf("Receipt has no entry")
+		}
+		if e.EntryBlockKeyMR == nil {
+			return nil, nil, fmt.Errorf("Receipt has no EntryBlockKeyMR")
+		}
+		entryHash, err := primitives.NewShaHashFromStr(e.Entry.EntryHash)
+		return entryHash, e.EntryBlockKeyMR, err
+	case ReceiptTypeFactoid:
+		if e.Transaction == nil {
+			return nil, nil, fmt.Errorf("Receipt has no transaction")
+		}
+		if e.FactoidBlockKeyMR == nil {
+			return nil, nil, fmt.Errorf("Receipt has no FactoidBlockKeyMR")
+		}
+		txHash, err := primitives.NewShaHashFromStr(e.Transaction.EntryHash)
+		return txHash, e.FactoidBlockKeyMR, err
+	case ReceiptTypeEntryCredit:
+		if e.Transaction == nil {
+			return nil, nil, fmt.Errorf("Receipt has no transaction")
+		}
+		if e.EntryCreditBlockKeyMR == nil {
+			return nil, nil, fmt.Errorf("Receipt has no EntryCreditBlockKeyMR")
+		}
+		// The branch starts at the ECBlock, which is checked separately
+		return e.EntryCreditBlockKeyMR, e.EntryCreditBlockKeyMR, nil
+	}
+	return nil, nil, fmt.Errorf("Unknown receipt type %s", e.Type)
+}
+
+// validateTransaction checks the raw transaction against its hash, and for
+// EC commits that the ECBlock holds the commit and hashes to its KeyMR.
+func (e *Receipt) validateTransaction() error {
+	if e.Transaction == nil {
+		return nil
+	}
+	txHash, err := primitives.NewShaHashFromStr(e.Transaction.EntryHash)
+	if err != nil {
+		return err
+	}
+	if e.Transaction.Raw != "" {
+		raw, err := hex.DecodeString(e.Transaction.Raw)
+		if err != nil {
+			return err
+		}
+		if primitives.Sha(raw).IsSameAs(txHash) == false {
+			return fmt.Errorf("Transaction does not hash to %v", txHash)
+		}
+	}
+	if e.Type != ReceiptTypeEntryCredit {
+		return nil
+	}
+
+	raw, err := hex.DecodeString(e.EntryCreditBlock)
+	if err != nil {
+		return err
+	}
+	ecBlock, err := entryCreditBlock.UnmarshalECBlock(raw)
+	if err != nil {
+		return err
+	}
+	// HeaderHash rebuilds the header from the body, so this covers the body too
+	keyMR, err := ecBlock.HeaderHash()
+	if err != nil {
+		return err
+	}
+	if keyMR.IsSameAs(e.EntryCreditBlockKeyMR) == false {
+		return fmt.Errorf("EntryCreditBlock does not hash to EntryCreditBlockKeyMR")
+	}
+	if ecBlock.GetEntryByHash(txHash) == nil {
+		return fmt.Errorf("Transaction %v not found in EntryCreditBlock", txHash)
+	}
+	return nil
+}
+
 func (e *Receipt) TrimReceipt() {
 	if e == nil {
 		return
 	}
-	entry, _ := primitives.NewShaHashFromStr(e.Entry.EntryHash)
+	entry, _, err := e.start()
+	if err != nil {
+		return
+	}
 	for i := range e.MerkleBranch {
 		if entry.IsSameAs(e.MerkleBranch[i].Left) {
 			e.MerkleBranch[i].Left = nil
@@ -44,21 +141,19 @@ func (e *Receipt) Validate() error {
 	if e == nil {
 		return fmt.Errorf("No receipt provided")
 	}
-	if e.Entry == nil {
-		return fmt.Errorf("Receipt has no entry")
-	}
 	if e.MerkleBranch == nil {
 		return fmt.Errorf("Receipt has no MerkleBranch")
 	}
-	if e.EntryBlockKeyMR == nil {
-		return fmt.Errorf("Receipt has no EntryBlockKeyMR")
-	}
 	if e.DirectoryBlockKeyMR == nil {
 		return fmt.Errorf("Receipt has no DirectoryBlockKeyMR")
 	}
-	entryHash, err := primitives.NewShaHashFromStr(e.Entry.EntryHash)
+	entryHash, blockKeyMR, err := e.start()
 	//TODO: validate entry hashes into EntryHash
 
+	if err != nil {
+		return err
+	}
+	err = e.validateTransaction()
 	if err != nil {
 		return err
 	}
@@ -66,7 +161,8 @@ func (e *Receipt) Validate() error {
 	var right interfaces.IHash
 	var currentEntry interfaces.IHash
 	currentEntry = entryHash
-	eBlockFound := false
+	// An ECBlock receipt starts at the block itself
+	blockFound := entryHash.IsSameAs(blockKeyMR)
 	dBlockFound := false
 	for i, node := range e.MerkleBranch {
 		if node.Left == nil {
@@ -83,7 +179,7 @@ func (e *Receipt) Validate() error {
 				right = node.Right
 			}
 		}
-		if left.IsSameAs(currentEntry) == false && left.IsSameAs(currentEntry) {
+		if left.IsSameAs(currentEntry) == false && right.IsSameAs(currentEntry) == false {
 			return fmt.Errorf("Entry %v not found in node %v/%v", currentEntry, i, len(e.MerkleBranch))
 		}
 		top := primitives.HashMerkleBranches(left, right)
@@ -92,8 +188,8 @@ func (e *Receipt) Validate() error {
 				return fmt.Errorf("Derived top %v is not the same as saved top in node %v/%v", top, i, len(e.MerkleBranch))
 			}
 		}
-		if top.IsSameAs(e.EntryBlockKeyMR) == true {
-			eBlockFound = true
+		if top.IsSameAs(blockKeyMR) == true {
+			blockFound = true
 		}
 		if top.IsSameAs(e.DirectoryBlockKeyMR) == true {
 			dBlockFound = true
@@ -101,8 +197,13 @@ func (e *Receipt) Validate() error {
 		currentEntry = top
 	}
 
-	if eBlockFound == false {
-		return fmt.Errorf("EntryBlockKeyMR not found in branch")
+	if blockFound == false {
+		switch e.Type {
+		case ReceiptTypeFactoid:
+			return fmt.Errorf("FactoidBlockKeyMR not found in branch")
+		default:
+			return fmt.Errorf("EntryBlockKeyMR not found in branch")
+		}
 	}
 
 	if dBlockFound == false {
@@ -113,6 +214,30 @@ func (e *Receipt) Validate() error {
 }
 
 func (e *Receipt) IsSameAs(r *Receipt) bool {
+	if e.Type != r.Type {
+		return false
+	}
+
+	if e.Transaction == nil {
+		if r.Transaction != nil {
+			return false
+		}
+	} else {
+		if e.Transaction.IsSameAs(r.Transaction) == false {
+			return false
+		}
+	}
+
+	if hashesEqual(e.FactoidBlockKeyMR, r.FactoidBlockKeyMR) == false {
+		return false
+	}
+	if hashesEqual(e.EntryCreditBlockKeyMR, r.EntryCreditBlockKeyMR) == false {
+		return false
+	}
+	if e.EntryCreditBlock != r.EntryCreditBlock {
+		return false
+	}
+
 	if e.Entry == nil {
 		if r.Entry != nil {
 			return false
@@ -205,6 +330,13 @@ func (e *Receipt) IsSameAs(r *Receipt) bool {
 	return true
 }
 
+func hashesEqual(a, b *primitives.Hash) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.IsSameAs(b)
+}
+
 func (e *Receipt) JSONByte() ([]byte, error) {
 	return primitives.EncodeJSON(e)
 }
@@ -334,43 +466,191 @@ func CreateReceipt(dbo interfaces.DBOverlaySimple, entryID interfaces.IHash) (*R
 
 	//DBlock
 
-	hash, err = dbo.FetchIncludedIn(hash)
+	err = addDirectoryBlockBranch(dbo, receipt, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// CreateFactoidReceipt proves a factoid transaction, given by its TxID or
+// full hash, is in a factoid block, and that block in a directory block.
+func CreateFactoidReceipt(dbo interfaces.DBOverlaySimple, txID interfaces.IHash) (*Receipt, error) {
+	receipt := new(Receipt)
+	receipt.Type = ReceiptTypeFactoid
+
+	//FBlock
+
+	hash, err := dbo.FetchIncludedIn(txID)
 	if err != nil {
 		return nil, err
 	}
 
 	if hash == nil {
-		return nil, fmt.Errorf("Block containing EBlock not found")
+		return nil, fmt.Errorf("Block containing transaction not found")
 	}
 
-	dBlock, err := dbo.FetchDBlock(hash)
+	fBlock, err := dbo.FetchFBlock(hash)
 	if err != nil {
 		return nil, err
 	}
 
-	if dBlock == nil {
-		return nil, fmt.Errorf("DBlock not found")
+	if fBlock == nil {
+		return nil, fmt.Errorf("FBlock not found")
+	}
+
+	tx := fBlock.GetTransactionByHash(txID)
+	if tx == nil {
+		return nil, fmt.Errorf("Transaction not found in FBlock")
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
 	}
+	receipt.Transaction = new(JSON)
+	receipt.Transaction.Raw = hex.EncodeToString(raw)
+	receipt.Transaction.EntryHash = tx.GetHash().String()
 
-	//str, _ = dBlock.JSONString()
-	//fmt.Printf("dBlock - %v\n\n", str)
+	hash = fBlock.DatabasePrimaryIndex()
+	receipt.FactoidBlockKeyMR = hash.(*primitives.Hash)
 
-	entries = dBlock.GetEntryHashesForBranch()
-	//fmt.Printf("dBlock entries - %v\n\n", entries)
+	branch := primitives.BuildMerkleBranchForEntryHash(fBlock.GetBodyHashes(), tx.GetHash(), true)
+	header, err := fBlock.MarshalHeader()
+	if err != nil {
+		return nil, err
+	}
+	blockNode := new(primitives.MerkleNode)
+	blockNode.Left = primitives.Sha(header).(*primitives.Hash)
+	blockNode.Right = fBlock.GetBodyMR().(*primitives.Hash)
+	blockNode.Top = hash.(*primitives.Hash)
+	branch = append(branch, blockNode)
+	receipt.MerkleBranch = append(receipt.MerkleBranch, branch...)
+
+	//DBlock
+
+	err = addDirectoryBlockBranch(dbo, receipt, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// CreateEntryCreditReceipt proves an EC commit, given by its TxID or full
+// hash, is in an entry credit block, and that block in a directory block.
+// ECBlocks are not Merkle trees, so the receipt carries the whole block.
+func CreateEntryCreditReceipt(dbo interfaces.DBOverlaySimple, txID interfaces.IHash) (*Receipt, error) {
+	receipt := new(Receipt)
+	receipt.Type = ReceiptTypeEntryCredit
+
+	//ECBlock
+
+	hash, err := dbo.FetchIncludedIn(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if hash == nil {
+		return nil, fmt.Errorf("Block containing transaction not found")
+	}
+
+	ecBlock, err := dbo.FetchECBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if ecBlock == nil {
+		return nil, fmt.Errorf("ECBlock not found")
+	}
+
+	tx := ecBlock.GetEntryByHash(txID)
+	if tx == nil {
+		return nil, fmt.Errorf("Transaction not found in ECBlock")
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	receipt.Transaction = new(JSON)
+	receipt.Transaction.Raw = hex.EncodeToString(raw)
+	receipt.Transaction.EntryHash = tx.Hash().String()
+
+	raw, err = ecBlock.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	receipt.EntryCreditBlock = hex.EncodeToString(raw)
 
-	//merkleTree := primitives.BuildMerkleTreeStore(entries)
-	//fmt.Printf("dBlock merkleTree - %v\n\n", merkleTree)
+	hash = ecBlock.DatabasePrimaryIndex()
+	receipt.EntryCreditBlockKeyMR = hash.(*primitives.Hash)
 
-	branch = primitives.BuildMerkleBranchForEntryHash(entries, receipt.EntryBlockKeyMR, true)
-	blockNode = new(primitives.MerkleNode)
-	left, err = dBlock.HeaderHash()
+	//DBlock
+
+	err = addDirectoryBlockBranch(dbo, receipt, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// CreateReceiptOfType creates an entry, factoid or entrycredit receipt
+func CreateReceiptOfType(dbo interfaces.DBOverlaySimple, receiptType string, hash interfaces.IHash) (*Receipt, error) {
+	switch receiptType {
+	case "", ReceiptTypeEntry:
+		return CreateReceipt(dbo, hash)
+	case ReceiptTypeFactoid:
+		return CreateFactoidReceipt(dbo, hash)
+	case ReceiptTypeEntryCredit:
+		return CreateEntryCreditReceipt(dbo, hash)
+	}
+	return nil, fmt.Errorf("Unknown receipt type %s", receiptType)
+}
+
+func CreateMinimalReceiptOfType(dbo interfaces.DBOverlaySimple, receiptType string, hash interfaces.IHash) (*Receipt, error) {
+	receipt, err := CreateReceiptOfType(dbo, receiptType, hash)
 	if err != nil {
 		return nil, err
 	}
+
+	receipt.TrimReceipt()
+
+	return receipt, nil
+}
+
+// addDirectoryBlockBranch extends the receipt from the KeyMR of a block to
+// the directory block holding it, and its anchor if there is one.
+func addDirectoryBlockBranch(dbo interfaces.DBOverlaySimple, receipt *Receipt, blockKeyMR interfaces.IHash) error {
+	hash, err := dbo.FetchIncludedIn(blockKeyMR)
+	if err != nil {
+		return err
+	}
+
+	if hash == nil {
+		return fmt.Errorf("DBlock containing block %v not found", blockKeyMR)
+	}
+
+	dBlock, err := dbo.FetchDBlock(hash)
+	if err != nil {
+		return err
+	}
+
+	if dBlock == nil {
+		return fmt.Errorf("DBlock not found")
+	}
+
+	entries := dBlock.GetEntryHashesForBranch()
+
+	branch := primitives.BuildMerkleBranchForEntryHash(entries, blockKeyMR, true)
+	blockNode := new(primitives.MerkleNode)
+	left, err := dBlock.HeaderHash()
+	if err != nil {
+		return err
+	}
 	blockNode.Left = left.(*primitives.Hash)
 	blockNode.Right = dBlock.BodyKeyMR().(*primitives.Hash)
 	blockNode.Top = hash.(*primitives.Hash)
-	//fmt.Printf("dBlock blockNode - %v\n\n", blockNode)
 	branch = append(branch, blockNode)
 	receipt.MerkleBranch = append(receipt.MerkleBranch, branch...)
 
@@ -381,7 +661,7 @@ func CreateReceipt(dbo interfaces.DBOverlaySimple, entryID interfaces.IHash) (*R
 
 	dirBlockInfo, err := dbo.FetchDirBlockInfoByKeyMR(hash)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if dirBlockInfo != nil {
@@ -391,7 +671,7 @@ func CreateReceipt(dbo interfaces.DBOverlaySimple, entryID interfaces.IHash) (*R
 		receipt.BitcoinBlockHash = dbi.BTCBlockHash.(*primitives.Hash)
 	}
 
-	return receipt, nil
+	return nil
 }
 
 func VerifyFullReceipt(dbo interfaces.DBOverlaySimple, receiptStr string) error {
@@ -0,0 +1,263 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package verifier checks receipts without a node or a database.  The Merkle
+// branch is checked on its own; the directory block header, the federated
+// server signatures over it, and the anchor record are checked when they are
+// supplied.
+package verifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts"
+)
+
+// Evidence is the optional data a receipt is checked against.  Blocks and
+// entries are binary, as returned by the raw-data API.  Anything left empty
+// is not checked.
+type Evidence struct {
+	// The header of the directory block the receipt ends in.  A whole
+	// directory block will do, only its header is read.
+	DirectoryBlockHeader []byte
+	// The admin block of the next directory block, which holds the
+	// federated servers' signatures of DirectoryBlockHeader.
+	AdminBlock []byte
+	// The signing keys of the federated servers at that height.  A majority
+	// of them must have signed the header.
+	FederatedServerKeys [][]byte
+	// The anchor chain entry anchoring the directory block, and the keys the
+	// anchor record may be signed with.  AnchorKeys defaults to
+	// anchor.AnchorSigKeys.
+	AnchorEntry []byte
+	AnchorKeys  [][]byte
+}
+
+// Result lists every check run on a receipt.
+type Result struct {
+	interfaces.CheckList
+	Form string `json:"form,omitempty"` // full or minimal
+
+	DirectoryBlockHeight *uint32              `json:"directoryblockheight,omitempty"` // From the header, if supplied
+	Signatures           int                  `json:"signatures,omitempty"`           // Federated server signatures found
+	AnchorRecord         *anchor.AnchorRecord `json:"anchorrecord,omitempty"`
+}
+
+// VerifyString decodes a JSON receipt and verifies it.
+func VerifyString(receiptStr string, evidence *Evidence) *Result {
+	receipt, err := receipts.DecodeReceiptString(receiptStr)
+	if err != nil {
+		r := new(Result)
+		r.AddCheck("receipt", err)
+		return r
+	}
+	return Verify(receipt, evidence)
+}
+
+// Verify checks the receipt, and the evidence that is supplied.  evidence
+// may be nil.
+func Verify(receipt *receipts.Receipt, evidence *Evidence) *Result {
+	r := new(Result)
+	if evidence == nil {
+		evidence = new(Evidence)
+	}
+
+	err := receipt.Validate()
+	r.AddCheck("receipt", err)
+	if err != nil {
+		return r
+	}
+	r.AddCheck("form", checkForm(receipt, r))
+	if receipt.Entry != nil && receipt.Entry.Raw != "" {
+		r.AddCheck("entry", checkEntry(receipt.Entry))
+	}
+
+	var header []byte
+	if len(evidence.DirectoryBlockHeader) > 0 {
+		header, err = checkHeader(receipt, evidence.DirectoryBlockHeader, r)
+		r.AddCheck("directoryblock", err)
+	}
+	if len(evidence.AdminBlock) > 0 || len(evidence.FederatedServerKeys) > 0 {
+		r.AddCheck("signatures", checkSignatures(header, evidence, r))
+	}
+	if len(evidence.AnchorEntry) > 0 {
+		r.AddCheck("anchor", checkAnchor(receipt, evidence, r))
+	}
+
+	return r
+}
+
+// checkForm makes sure every node is full, as from CreateFullReceipt, or
+// every node is minimal, and that the branch ends in the directory block.
+func checkForm(receipt *receipts.Receipt, r *Result) error {
+	full, minimal := 0, 0
+	for i, node := range receipt.MerkleBranch {
+		switch {
+		case node.Left != nil && node.Right != nil && node.Top != nil:
+			full++
+		case (node.Left == nil) != (node.Right == nil) && node.Top == nil:
+			minimal++
+		default:
+			return fmt.Errorf("Node %v/%v is neither full nor minimal", i, len(receipt.MerkleBranch))
+		}
+	}
+	switch {
+	case len(receipt.MerkleBranch) == 0:
+		return fmt.Errorf("Receipt has an empty MerkleBranch")
+	case minimal == 0:
+		r.Form = "full"
+	case full == 0:
+		r.Form = "minimal"
+	default:
+		return fmt.Errorf("Receipt mixes %v full and %v minimal nodes", full, minimal)
+	}
+
+	// Validate only needs the KeyMR somewhere in the branch
+	last := receipt.MerkleBranch[len(receipt.MerkleBranch)-1]
+	if r.Form == "full" && last.Top.IsSameAs(receipt.DirectoryBlockKeyMR) == false {
+		return fmt.Errorf("MerkleBranch does not end in DirectoryBlockKeyMR")
+	}
+	return nil
+}
+
+// checkEntry makes sure the raw entry hashes to the entry hash
+func checkEntry(e *receipts.JSON) error {
+	raw, err := hex.DecodeString(e.Raw)
+	if err != nil {
+		return err
+	}
+	entry, err := entryBlock.UnmarshalEntry(raw)
+	if err != nil {
+		return err
+	}
+	if entry.GetHash().String() != e.EntryHash {
+		return fmt.Errorf("Entry hashes to %v, not %v", entry.GetHash(), e.EntryHash)
+	}
+	return nil
+}
+
+// checkHeader makes sure the header and its BodyMR hash to the receipt's
+// DirectoryBlockKeyMR, and returns the header as the federated servers
+// signed it.
+func checkHeader(receipt *receipts.Receipt, data []byte, r *Result) ([]byte, error) {
+	h := directoryBlock.NewDBlockHeader()
+	if _, err := h.UnmarshalBinaryData(data); err != nil {
+		return nil, err
+	}
+	header, err := h.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	height := h.GetDBHeight()
+	r.DirectoryBlockHeight = &height
+
+	keyMR := primitives.HashMerkleBranches(primitives.Sha(header), h.GetBodyMR())
+	if keyMR.IsSameAs(receipt.DirectoryBlockKeyMR) == false {
+		return nil, fmt.Errorf("Header at height %v hashes to KeyMR %v, not %v", height, keyMR, receipt.DirectoryBlockKeyMR)
+	}
+	return header, nil
+}
+
+// checkSignatures counts the DBSignature entries of the admin block that are
+// valid signatures of the header by distinct federated servers.
+func checkSignatures(header []byte, evidence *Evidence, r *Result) error {
+	if header == nil {
+		return fmt.Errorf("Signatures need a valid DirectoryBlockHeader")
+	}
+	if len(evidence.AdminBlock) == 0 {
+		return fmt.Errorf("No AdminBlock supplied")
+	}
+	if len(evidence.FederatedServerKeys) == 0 {
+		return fmt.Errorf("No FederatedServerKeys supplied")
+	}
+	for i, key := range evidence.FederatedServerKeys {
+		if len(key) != constants.ADDRESS_LENGTH {
+			return fmt.Errorf("Federated server key %v is %v bytes, expected %v", i, len(key), constants.ADDRESS_LENGTH)
+		}
+	}
+
+	aBlock, err := adminBlock.UnmarshalABlock(evidence.AdminBlock)
+	if err != nil {
+		return err
+	}
+	if aBlock.GetDBHeight() != *r.DirectoryBlockHeight+1 {
+		return fmt.Errorf("AdminBlock is at height %v, the signatures of height %v are at %v",
+			aBlock.GetDBHeight(), *r.DirectoryBlockHeight, *r.DirectoryBlockHeight+1)
+	}
+
+	signed := make([]bool, len(evidence.FederatedServerKeys))
+	for _, entry := range aBlock.GetABEntries() {
+		if entry.Type() != constants.TYPE_DB_SIGNATURE {
+			continue
+		}
+		dbs := entry.(*adminBlock.DBSignatureEntry)
+		if dbs.PrevDBSig.Verify(header) == false {
+			continue
+		}
+		for i, key := range evidence.FederatedServerKeys {
+			if bytes.Equal(dbs.PrevDBSig.GetKey(), key) && signed[i] == false {
+				signed[i] = true
+				r.Signatures++
+			}
+		}
+	}
+	if r.Signatures*2 <= len(evidence.FederatedServerKeys) {
+		return fmt.Errorf("Header signed by %v of %v federated servers, a majority is needed",
+			r.Signatures, len(evidence.FederatedServerKeys))
+	}
+	return nil
+}
+
+// checkAnchor validates the anchor record's signature, and that it anchors
+// the receipt's directory block.
+func checkAnchor(receipt *receipts.Receipt, evidence *Evidence, r *Result) error {
+	entry, err := entryBlock.UnmarshalEntry(evidence.AnchorEntry)
+	if err != nil {
+		return err
+	}
+	var keys []interfaces.Verifier
+	for i, key := range evidence.AnchorKeys {
+		if len(key) != constants.ADDRESS_LENGTH {
+			return fmt.Errorf("Anchor key %v is %v bytes, expected %v", i, len(key), constants.ADDRESS_LENGTH)
+		}
+		pub := new(primitives.PublicKey)
+		copy(pub[:], key)
+		keys = append(keys, pub)
+	}
+	if len(keys) == 0 {
+		for _, v := range anchor.AnchorSigKeys {
+			pub := new(primitives.PublicKey)
+			if err := pub.UnmarshalText([]byte(v)); err != nil {
+				return err
+			}
+			keys = append(keys, pub)
+		}
+	}
+
+	ar, valid, err := anchor.UnmarshalAndValidateAnchorRecordV2(entry.GetContent(), entry.ExternalIDs(), keys)
+	if err != nil {
+		return err
+	}
+	if valid == false {
+		return fmt.Errorf("Anchor record is not signed by an anchor key")
+	}
+	r.AnchorRecord = ar
+
+	if ar.KeyMR != receipt.DirectoryBlockKeyMR.String() {
+		return fmt.Errorf("Anchor record is for KeyMR %v, not %v", ar.KeyMR, receipt.DirectoryBlockKeyMR)
+	}
+	if r.DirectoryBlockHeight != nil && ar.DBHeight != *r.DirectoryBlockHeight {
+		return fmt.Errorf("Anchor record is for height %v, the header is at %v", ar.DBHeight, *r.DirectoryBlockHeight)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package verifier_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/receipts"
+	. "github.com/FactomProject/factomd/receipts/verifier"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func findCheck(t *testing.T, r *Result, name string) interfaces.Check {
+	c, ok := r.Find(name)
+	if !ok {
+		t.Fatalf("No %s check in %v", name, r.Checks)
+	}
+	return c
+}
+
+// testReceipt returns a full receipt of the first test entry, and the
+// directory block it ends in.
+func testReceipt(t *testing.T) (*receipts.Receipt, interfaces.IDirectoryBlock) {
+	dbo := testHelper.CreateAndPopulateTestDatabaseOverlay()
+	entry := testHelper.CreateFullTestBlockSet()[0].Entries[0]
+	receipt, err := receipts.CreateFullReceipt(dbo, entry.DatabasePrimaryIndex())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	dBlock, err := dbo.FetchDBlock(receipt.DirectoryBlockKeyMR)
+	if err != nil || dBlock == nil {
+		t.Fatalf("DBlock not found %v", err)
+	}
+	return receipt, dBlock
+}
+
+func TestVerifyReceipt(t *testing.T) {
+	receipt, _ := testReceipt(t)
+
+	r := Verify(receipt, nil)
+	if !r.Valid || r.Form != "full" {
+		t.Errorf("Full receipt failed %v", r.Reasons)
+	}
+
+	receipt.TrimReceipt()
+	str, _ := receipt.JSONString()
+	r = VerifyString(str, nil)
+	if !r.Valid || r.Form != "minimal" {
+		t.Errorf("Minimal receipt failed %v", r.Reasons)
+	}
+
+	// A node that does not hold the hash below it
+	receipt, _ = testReceipt(t)
+	receipt.MerkleBranch[0].Left = primitives.RandomHash().(*primitives.Hash)
+	receipt.MerkleBranch[0].Right = primitives.RandomHash().(*primitives.Hash)
+	receipt.MerkleBranch[0].Top = nil
+	if r = Verify(receipt, nil); r.Valid {
+		t.Errorf("Expected a broken branch to fail")
+	}
+
+	// A genuine branch claimed for another entry
+	receipt, _ = testReceipt(t)
+	receipt.Entry.EntryHash = primitives.RandomHash().String()
+	if r = Verify(receipt, nil); r.Valid {
+		t.Errorf("Expected an entry outside the branch to fail")
+	}
+}
+
+func TestVerifyDirectoryBlock(t *testing.T) {
+	receipt, dBlock := testReceipt(t)
+	data, err := dBlock.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	header, err := dBlock.GetHeader().MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// A whole block and its header both work
+	for _, d := range [][]byte{data, header} {
+		r := Verify(receipt, &Evidence{DirectoryBlockHeader: d})
+		if c := findCheck(t, r, "directoryblock"); !c.Passed {
+			t.Errorf("Header failed: %s", c.Reason)
+		}
+		if r.DirectoryBlockHeight == nil || *r.DirectoryBlockHeight != dBlock.GetDatabaseHeight() {
+			t.Errorf("Bad height %v", r.DirectoryBlockHeight)
+		}
+	}
+
+	// Another block's header
+	other := dBlock.GetHeader()
+	other.SetDBHeight(other.GetDBHeight() + 1)
+	header, _ = other.MarshalBinary()
+	r := Verify(receipt, &Evidence{DirectoryBlockHeader: header})
+	if c := findCheck(t, r, "directoryblock"); c.Passed {
+		t.Errorf("Expected the wrong header to fail")
+	}
+}
+
+func TestVerifySignatures(t *testing.T) {
+	receipt, dBlock := testReceipt(t)
+	header, err := dBlock.GetHeader().MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	aBlock := adminBlock.NewAdminBlock(nil)
+	aBlock.GetHeader().SetDBHeight(dBlock.GetDatabaseHeight() + 1)
+	for i := uint64(0); i < 2; i++ {
+		priv := testHelper.NewPrimitivesPrivateKey(i)
+		if err := aBlock.AddDBSig(primitives.Sha(priv.Pub[:]), priv.Sign(header)); err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+	ab, err := aBlock.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	keys := func(n ...uint64) [][]byte {
+		var answer [][]byte
+		for _, i := range n {
+			answer = append(answer, testHelper.NewPrimitivesPrivateKey(i).Pub[:])
+		}
+		return answer
+	}
+
+	// 2 of 3 is a majority, 2 of 4 is not
+	r := Verify(receipt, &Evidence{DirectoryBlockHeader: header, AdminBlock: ab, FederatedServerKeys: keys(0, 1, 2)})
+	if c := findCheck(t, r, "signatures"); !c.Passed || r.Signatures != 2 {
+		t.Errorf("Signatures failed: %s", c.Reason)
+	}
+	r = Verify(receipt, &Evidence{DirectoryBlockHeader: header, AdminBlock: ab, FederatedServerKeys: keys(0, 1, 2, 3)})
+	if c := findCheck(t, r, "signatures"); c.Passed {
+		t.Errorf("Expected 2 of 4 signatures to fail")
+	}
+
+	// Signatures mean nothing without the header they sign
+	r = Verify(receipt, &Evidence{AdminBlock: ab, FederatedServerKeys: keys(0, 1, 2)})
+	if c := findCheck(t, r, "signatures"); c.Passed {
+		t.Errorf("Expected signatures without a header to fail")
+	}
+}
+
+func TestVerifyAnchor(t *testing.T) {
+	receipt, dBlock := testReceipt(t)
+	priv := testHelper.NewPrimitivesPrivateKey(0)
+
+	anchorEntry := func(ar *anchor.AnchorRecord) []byte {
+		data, sig, err := ar.MarshalAndSignV2(priv)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		entry := entryBlock.NewEntry()
+		entry.ChainID = primitives.Sha([]byte("anchor"))
+		entry.Content = primitives.ByteSlice{Bytes: data}
+		entry.ExtIDs = []primitives.ByteSlice{{Bytes: sig}}
+		b, err := entry.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		return b
+	}
+
+	// Anchor records say where the block was anchored
+	ar := anchor.CreateAnchorRecordFromDBlock(dBlock)
+	ar.Bitcoin = new(anchor.BitcoinStruct)
+	ar.Bitcoin.Address = "1HLoD9E4SDFFPDiYfNYnkBLQ85Y51J3Zb1"
+	ar.Bitcoin.TXID = "9b0fc92260312ce44e74ef369f5c66bbb85848f2eddd5a7a1cde251e54ccfdd5"
+	ar.Bitcoin.BlockHeight = 345678
+	ar.Bitcoin.BlockHash = "00000000000000000cc14eacfc7057300aea87bed6fee904fd8e1c1f3dc008d4"
+	ar.Bitcoin.Offset = 87
+	e := &Evidence{AnchorEntry: anchorEntry(ar), AnchorKeys: [][]byte{priv.Pub[:]}}
+	r := Verify(receipt, e)
+	if c := findCheck(t, r, "anchor"); !c.Passed || r.AnchorRecord == nil {
+		t.Errorf("Anchor failed: %s", c.Reason)
+	}
+
+	// Not signed by Factom's keys
+	e.AnchorKeys = nil
+	if c := findCheck(t, Verify(receipt, e), "anchor"); c.Passed {
+		t.Errorf("Expected the default keys to reject the test key")
+	}
+
+	// Anchoring another block
+	ar.KeyMR = primitives.RandomHash().String()
+	e = &Evidence{AnchorEntry: anchorEntry(ar), AnchorKeys: [][]byte{priv.Pub[:]}}
+	if c := findCheck(t, Verify(receipt, e), "anchor"); c.Passed {
+		t.Errorf("Expected an anchor of another block to fail")
+	}
+}
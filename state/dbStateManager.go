@@ -23,6 +23,7 @@ import (
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/database/databaseOverlay"
 	"github.com/FactomProject/factomd/log"
+	"github.com/FactomProject/factomd/tracing"
 )
 
 var _ = hex.EncodeToString
@@ -1017,6 +1018,10 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 		return
 	}
 
+	span := tracing.Start("state.ProcessBlocks")
+	span.SetAttribute("dbheight", dbht)
+	defer span.End()
+
 	var out bytes.Buffer
 	out.WriteString("=== AdminBlock.UpdateState() Start ===\n")
 	prt := func(lable string, pl *ProcessList) {
@@ -1042,14 +1047,18 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	// ***** Apply the AdminBlock chainges to the next DBState
 	//
 	//list.State.AddStatus(fmt.Sprintf("PROCESSBLOCKS:  Processing Admin Block at dbht: %d", d.AdminBlock.GetDBHeight()))
+	step := span.Child("AdminBlock.UpdateState")
 	err := d.AdminBlock.UpdateState(list.State)
 	if err != nil {
 		panic(err)
 	}
+	step.End()
+	step = span.Child("EntryCreditBlock.UpdateState")
 	err = d.EntryCreditBlock.UpdateState(list.State)
 	if err != nil {
 		panic(err)
 	}
+	step.End()
 
 	prt("pl 2st", pl)
 	prt("pln 2st", pln)
@@ -1079,14 +1088,18 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	// Process the Factoid End of Block
 	fs := list.State.GetFactoidState()
 	fs.(*FactoidState).DBHeight = dbht
+	step = span.Child("FactoidState.AddTransactionBlock")
 	err = fs.AddTransactionBlock(d.FactoidBlock)
 	if err != nil {
 		panic(err)
 	}
+	step.End()
+	step = span.Child("FactoidState.AddECBlock")
 	err = fs.AddECBlock(d.EntryCreditBlock)
 	if err != nil {
 		panic(err)
 	}
+	step.End()
 
 	list.State.Balancehash = fs.GetBalanceHash(false)
 
@@ -1103,7 +1116,9 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 		list.State.FactoshisPerEC = d.FactoidBlock.GetExchRate()
 	}
 
+	step = span.Child("FactoidState.ProcessEndOfBlock")
 	fs.ProcessEndOfBlock(list.State)
+	step.End()
 
 	// Promote the currently scheduled next FER
 
@@ -1123,7 +1138,9 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	///////////////////////////////
 	// Cleanup Tasks
 	///////////////////////////////
+	step = span.Child("Commits.Cleanup")
 	list.State.Commits.Cleanup(list.State)
+	step.End()
 
 	// s := list.State
 	// // Time out commits every now and again.
@@ -1233,26 +1250,39 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 	// Past this point, we cannot Return without recording the transactions in the dbstate.  This is because we
 	// have marked them all as saved to disk!  So we gotta save them to disk.  Or panic trying.
 
+	span := tracing.Start("state.SaveDBStateToDB")
+	span.SetAttribute("dbheight", dbheight)
+	defer span.End()
+	db := databaseOverlay.TraceDB(list.State.DB, span) // Its database calls are children of the span
+
 	// Only trim when we are really saving.
 	v := dbheight + int(list.State.IdentityChainID.Bytes()[4])
 	if v%4 == 0 {
-		list.State.DB.Trim()
+		step := span.Child("DB.Trim")
+		db.Trim()
+		step.End()
 	}
 
 	// Save
-	list.State.DB.StartMultiBatch()
+	db.StartMultiBatch()
 
-	if err := list.State.DB.ProcessABlockMultiBatch(d.AdminBlock); err != nil {
+	step := span.Child("ProcessABlockMultiBatch")
+	if err := db.ProcessABlockMultiBatch(d.AdminBlock); err != nil {
 		panic(err.Error())
 	}
+	step.End()
 
-	if err := list.State.DB.ProcessFBlockMultiBatch(d.FactoidBlock); err != nil {
+	step = span.Child("ProcessFBlockMultiBatch")
+	if err := db.ProcessFBlockMultiBatch(d.FactoidBlock); err != nil {
 		panic(err.Error())
 	}
+	step.End()
 
-	if err := list.State.DB.ProcessECBlockMultiBatch(d.EntryCreditBlock, false); err != nil {
+	step = span.Child("ProcessECBlockMultiBatch")
+	if err := db.ProcessECBlockMultiBatch(d.EntryCreditBlock, false); err != nil {
 		panic(err.Error())
 	}
+	step.End()
 
 	pl := list.State.ProcessLists.Get(uint32(dbheight))
 
@@ -1281,6 +1311,10 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		}
 	}
 
+	step = span.Child("ProcessEBlocks")
+	step.SetAttribute("dbstate.eblocks", len(d.EntryBlocks))
+	step.SetAttribute("dbstate.entries", len(d.Entries))
+
 	// Info from DBState
 	if len(d.EntryBlocks) > 0 {
 		for _, eb := range d.EntryBlocks {
@@ -1290,7 +1324,7 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 			}
 			// If it's in the DBlock
 			if _, ok := allowedEBlocks[keymr.Fixed()]; ok {
-				if err := list.State.DB.ProcessEBlockMultiBatch(eb, true); err != nil {
+				if err := db.ProcessEBlockMultiBatch(eb, true); err != nil {
 					panic(err.Error())
 				}
 			} else {
@@ -1300,7 +1334,7 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		for _, e := range d.Entries {
 			// If it's in the DBlock
 			if _, ok := allowedEntries[e.GetHash().Fixed()]; ok {
-				if err := list.State.DB.InsertEntryMultiBatch(e); err != nil {
+				if err := db.InsertEntryMultiBatch(e); err != nil {
 					panic(err.Error())
 				}
 			} else {
@@ -1317,13 +1351,13 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 				continue
 			}
 			if _, ok := allowedEBlocks[keymr.Fixed()]; ok {
-				if err := list.State.DB.ProcessEBlockMultiBatch(eb, true); err != nil {
+				if err := db.ProcessEBlockMultiBatch(eb, true); err != nil {
 					panic(err.Error())
 				}
 
 				for _, e := range eb.GetBody().GetEBEntries() {
 					if _, ok := allowedEntries[e.Fixed()]; ok {
-						if err := list.State.DB.InsertEntryMultiBatch(pl.GetNewEntry(e.Fixed())); err != nil {
+						if err := db.InsertEntryMultiBatch(pl.GetNewEntry(e.Fixed())); err != nil {
 							panic(err.Error())
 						}
 					} else {
@@ -1337,17 +1371,23 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		pl.NewEBlocks = make(map[[32]byte]interfaces.IEntryBlock)
 		pl.NewEntries = make(map[[32]byte]interfaces.IEntry)
 	}
+	step.End()
 
 	d.EntryBlocks = make([]interfaces.IEntryBlock, 0)
 	d.Entries = make([]interfaces.IEBEntry, 0)
 
-	if err := list.State.DB.ProcessDBlockMultiBatch(d.DirectoryBlock); err != nil {
+	step = span.Child("ProcessDBlockMultiBatch")
+	if err := db.ProcessDBlockMultiBatch(d.DirectoryBlock); err != nil {
 		panic(err.Error())
 	}
+	step.End()
 
-	if err := list.State.DB.ExecuteMultiBatch(); err != nil {
+	step = span.Child("ExecuteMultiBatch")
+	if err := db.ExecuteMultiBatch(); err != nil {
 		panic(err.Error())
 	}
+	step.End()
+	list.State.MsgTrace.FinishProcessList(pl)
 
 	// Not activated.  Set to true if you want extra checking of the data saved to the database.
 	if false {
@@ -1398,6 +1438,12 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 	d.ReadyToSave = false
 	d.Saved = true
 
+	if d.IsNew {
+		if ns, ok := sinceMinuteStart(list.State); ok {
+			MinuteToBlockSaveTime.Observe(ns)
+		}
+	}
+
 	return
 }
 
@@ -47,7 +47,7 @@ func (s *State) MakeMissingEntryRequests() {
 
 	MissingEntryMap := make(map[[32]byte]*MissingEntry)
 
-	for {
+	for s.Running() {
 		now := time.Now()
 
 		newrequest := 0
@@ -187,7 +187,7 @@ func (s *State) GoSyncEntries() {
 
 	found := 0
 
-	for {
+	for s.Running() {
 
 		ESMissing.Set(float64(len(missingMap)))
 		ESMissingQueue.Set(float64(len(s.MissingEntries)))
@@ -13,7 +13,6 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sort"
-	"time"
 
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
@@ -272,8 +271,8 @@ func (fs *FactoidState) UpdateECTransaction(rt bool, trans interfaces.IECBlockEn
 		}
 		fs.State.PutE(rt, t.ECPubKey.Fixed(), v)
 		fs.State.NumTransactions++
-		fs.State.Replay.IsTSValid(constants.INTERNAL_REPLAY, t.GetSigHash(), t.GetTimestamp())
-		fs.State.Replay.IsTSValid(constants.NETWORK_REPLAY, t.GetSigHash(), t.GetTimestamp())
+		fs.State.Replay.IsTSValid_(constants.INTERNAL_REPLAY, t.GetSigHash().Fixed(), t.GetTimestamp(), fs.State.GetTimestamp())
+		fs.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, t.GetSigHash().Fixed(), t.GetTimestamp(), fs.State.GetTimestamp())
 	case entryCreditBlock.ECIDEntryCommit:
 		t := trans.(*entryCreditBlock.CommitEntry)
 		v := fs.State.GetE(rt, t.ECPubKey.Fixed()) - int64(t.Credits)
@@ -282,8 +281,8 @@ func (fs *FactoidState) UpdateECTransaction(rt bool, trans interfaces.IECBlockEn
 		}
 		fs.State.PutE(rt, t.ECPubKey.Fixed(), v)
 		fs.State.NumTransactions++
-		fs.State.Replay.IsTSValid(constants.INTERNAL_REPLAY, t.GetSigHash(), t.GetTimestamp())
-		fs.State.Replay.IsTSValid(constants.NETWORK_REPLAY, t.GetSigHash(), t.GetTimestamp())
+		fs.State.Replay.IsTSValid_(constants.INTERNAL_REPLAY, t.GetSigHash().Fixed(), t.GetTimestamp(), fs.State.GetTimestamp())
+		fs.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, t.GetSigHash().Fixed(), t.GetTimestamp(), fs.State.GetTimestamp())
 	default:
 		return fmt.Errorf("Unknown EC Transaction")
 	}
@@ -311,8 +310,8 @@ func (fs *FactoidState) UpdateTransaction(rt bool, trans interfaces.ITransaction
 		fs.State.PutF(rt, adr, v)
 	}
 	// Then log that the transaction has been seen and processed.
-	fs.State.Replay.IsTSValid(constants.INTERNAL_REPLAY, trans.GetSigHash(), trans.GetTimestamp())
-	fs.State.Replay.IsTSValid(constants.NETWORK_REPLAY, trans.GetSigHash(), trans.GetTimestamp())
+	fs.State.Replay.IsTSValid_(constants.INTERNAL_REPLAY, trans.GetSigHash().Fixed(), trans.GetTimestamp(), fs.State.GetTimestamp())
+	fs.State.Replay.IsTSValid_(constants.NETWORK_REPLAY, trans.GetSigHash().Fixed(), trans.GetTimestamp(), fs.State.GetTimestamp())
 
 	for _, output := range trans.GetOutputs() {
 		adr := output.GetAddress().Fixed()
@@ -363,13 +362,48 @@ func (fs *FactoidState) ProcessEndOfBlock(state interfaces.IState) {
 	}
 	fs.UpdateTransaction(true, t)
 
+	now := fs.State.GetClock().Now().UnixNano()
+	fs.State.MetricsHistory.AddBlock(fs.DBHeight, fs.State.CurrentBlockStartTime, now)
 	fs.DBHeight++
-	fs.State.CurrentBlockStartTime = time.Now().UnixNano()
+	fs.State.CurrentBlockStartTime = now
+}
+
+// ValidateRCDActivation rejects multisig (RCD type 2) inputs until the
+// network's activation height
+func (fs *FactoidState) ValidateRCDActivation(trans interfaces.ITransaction) error {
+	height := RCD2ActivationHeight(fs.State.GetNetworkID())
+	if fs.DBHeight >= height {
+		return nil
+	}
+	for _, rcd := range trans.GetRCDs() {
+		if _, ok := rcd.(*factoid.RCD_2); ok {
+			return fmt.Errorf("Multisig inputs are not active until block %d", height)
+		}
+	}
+	return nil
+}
+
+// RCD2ActivationHeight returns the height a network accepts multisig inputs
+// from
+func RCD2ActivationHeight(networkID uint32) uint32 {
+	switch networkID {
+	case constants.MAIN_NETWORK_ID:
+		return constants.RCD_2_ACTIVATION_HEIGHT_MAIN
+	case constants.TEST_NETWORK_ID:
+		return constants.RCD_2_ACTIVATION_HEIGHT_TEST
+	case constants.LOCAL_NETWORK_ID:
+		return constants.RCD_2_ACTIVATION_HEIGHT_LOCAL
+	}
+	return constants.RCD_2_ACTIVATION_HEIGHT_CUSTOM
 }
 
 // Returns an error message about what is wrong with the transaction if it is
 // invalid, otherwise you are good to go.
 func (fs *FactoidState) Validate(index int, trans interfaces.ITransaction) error {
+	if err := fs.ValidateRCDActivation(trans); err != nil {
+		return err
+	}
+
 	var sums = make(map[[32]byte]uint64, 10)  // Look at the sum of an address's inputs
 	for _, input := range trans.GetInputs() { //    to a transaction.
 		bal, err := factoid.ValidateAmounts(sums[input.GetAddress().Fixed()], input.GetAmount())
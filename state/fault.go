@@ -8,7 +8,6 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand"
-	"time"
 
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
@@ -84,7 +83,7 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 		return
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.GetClock().Now().Unix()
 	vm := pl.VMs[vmIndex]
 
 	if vm.WhenFaulted == 0 {
@@ -153,7 +152,7 @@ func NegotiationCheck(pl *ProcessList) {
 		return
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.GetClock().Now().Unix()
 	if now-prevVM.WhenFaulted < int64(pl.State.FaultTimeout) {
 		//It hasn't been long enough; wait a little longer
 		//before starting negotiation
@@ -178,7 +177,7 @@ func NegotiationCheck(pl *ProcessList) {
 func FaultCheck(pl *ProcessList) {
 	NegotiationCheck(pl)
 
-	now := time.Now().Unix()
+	now := pl.State.GetClock().Now().Unix()
 
 	currentFault := pl.CurrentFault()
 	if currentFault.IsNil() {
@@ -298,7 +297,7 @@ func CraftFullFault(pl *ProcessList, vmIndex int, height int) *messages.FullServ
 		prevFF = pl.System.List[pl.System.Height-1].(*messages.FullServerFault)
 	}
 
-	now := time.Now().Unix()
+	now := pl.State.GetClock().Now().Unix()
 
 	if faultState.IsNil() || (now-faultState.GetTimestamp().GetTimeSeconds() > int64(pl.State.FaultTimeout)) && !(faultState.HasEnoughSigs(pl.State) && faultState.GetPledgeDone()) {
 		sf = CraftFault(pl, vmIndex, height)
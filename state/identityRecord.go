@@ -0,0 +1,228 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// GetIdentityRecord returns everything this node knows of the identity, or nil if it knows
+// nothing of it
+func (s *State) GetIdentityRecord(chainID interfaces.IHash) *interfaces.IdentityRecord {
+	var r *interfaces.IdentityRecord
+	if i := s.isIdentityChain(chainID); i != -1 && i < len(s.Identities) {
+		id := s.Identities[i]
+		r = new(interfaces.IdentityRecord)
+		r.ChainID = chainID.String()
+		r.Status = identityStatusString(id.Status)
+		r.IdentityRegistered = id.IdentityRegistered
+		r.IdentityCreated = id.IdentityCreated
+		r.ManagementChainID = hashString(id.ManagementChainID)
+		r.ManagementRegistered = id.ManagementRegistered
+		r.ManagementCreated = id.ManagementCreated
+		r.MatryoshkaHash = hashString(id.MatryoshkaHash)
+		r.Keys = []string{hashString(id.Key1), hashString(id.Key2), hashString(id.Key3), hashString(id.Key4)}
+		r.SigningKey = hashString(id.SigningKey)
+		r.AnchorKeys = anchorKeyRecords(id.AnchorKeys)
+	}
+
+	if auth, _ := s.GetAuthority(chainID); auth != nil {
+		if r == nil {
+			r = new(interfaces.IdentityRecord)
+			r.ChainID = chainID.String()
+			r.Status = identityStatusString(auth.Status)
+			r.Keys = []string{}
+			r.AnchorKeys = []interfaces.AnchorKeyRecord{}
+		}
+		a := new(interfaces.AuthorityRecord)
+		a.ChainID = chainID.String()
+		a.Status = identityStatusString(auth.Status)
+		a.ManagementChainID = hashString(auth.ManagementChainID)
+		a.MatryoshkaHash = hashString(auth.MatryoshkaHash)
+		a.SigningKey = auth.SigningKey.String()
+		a.AnchorKeys = anchorKeyRecords(auth.AnchorKeys)
+		a.KeyHistory = []interfaces.HistoricKeyRecord{}
+		for _, k := range auth.KeyHistory {
+			a.KeyHistory = append(a.KeyHistory, interfaces.HistoricKeyRecord{ActiveDBHeight: k.ActiveDBHeight, SigningKey: k.SigningKey.String()})
+		}
+		r.Authority = a
+	}
+	return r
+}
+
+// GetAuthoritySet returns the federated and audit servers as the admin blocks up to and including
+// dbheight left them. With a chain ID it also returns the changes to that authority.
+func (s *State) GetAuthoritySet(dbheight uint32, chainID interfaces.IHash) (*interfaces.AuthoritySet, error) {
+	if top := s.GetHighestSavedBlk(); dbheight > top {
+		return nil, fmt.Errorf("Block %d is not saved yet, the highest saved block is %d", dbheight, top)
+	}
+	changes, err := s.authorityHistory.read(s.DB, dbheight)
+	if err != nil {
+		return nil, err
+	}
+	return authoritySetOf(dbheight, changes, chainID), nil
+}
+
+// authorityHistory is every change the admin blocks make to the authority set, read once and
+// extended as blocks are saved
+type authorityHistory struct {
+	mutex   sync.Mutex
+	next    uint32 // The next admin block to read
+	changes []interfaces.AuthorityChange
+}
+
+// read returns the changes up to and including dbheight
+func (h *authorityHistory) read(db interfaces.DBOverlaySimple, dbheight uint32) ([]interfaces.AuthorityChange, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for ; h.next <= dbheight; h.next++ {
+		ablock, err := db.FetchABlockByHeight(h.next)
+		if err != nil {
+			return nil, err
+		}
+		if ablock == nil {
+			return nil, fmt.Errorf("Admin block %d is missing", h.next)
+		}
+		for _, entry := range ablock.GetABEntries() {
+			if c := authorityChangeOf(h.next, entry); c != nil {
+				h.changes = append(h.changes, *c)
+			}
+		}
+	}
+
+	i := sort.Search(len(h.changes), func(i int) bool { return h.changes[i].DBHeight > dbheight })
+	return h.changes[:i:i], nil
+}
+
+// authorityChangeOf returns the change an admin block entry makes, or nil if it makes none
+func authorityChangeOf(dbheight uint32, entry interfaces.IABEntry) *interfaces.AuthorityChange {
+	c := &interfaces.AuthorityChange{DBHeight: dbheight}
+	switch e := entry.(type) {
+	case *adminBlock.AddFederatedServer:
+		c.Change, c.ChainID, c.ActiveDBHeight = interfaces.AuthorityAddFederated, e.IdentityChainID.String(), e.DBHeight
+	case *adminBlock.AddAuditServer:
+		c.Change, c.ChainID, c.ActiveDBHeight = interfaces.AuthorityAddAudit, e.IdentityChainID.String(), e.DBHeight
+	case *adminBlock.RemoveFederatedServer:
+		c.Change, c.ChainID, c.ActiveDBHeight = interfaces.AuthorityRemove, e.IdentityChainID.String(), e.DBHeight
+	case *adminBlock.AddFederatedServerSigningKey:
+		c.Change, c.ChainID, c.ActiveDBHeight = interfaces.AuthoritySigningKey, e.IdentityChainID.String(), e.DBHeight
+		c.Value = e.PublicKey.String()
+	case *adminBlock.AddFederatedServerBitcoinAnchorKey:
+		c.Change, c.ChainID = interfaces.AuthorityAnchorKey, e.IdentityChainID.String()
+		c.AnchorKey = &interfaces.AnchorKeyRecord{BlockChain: "BTC", Level: e.KeyPriority, KeyType: e.KeyType, Key: hex.EncodeToString(e.ECDSAPublicKey[:])}
+		c.Value = c.AnchorKey.Key
+	case *adminBlock.AddReplaceMatryoshkaHash:
+		c.Change, c.ChainID = interfaces.AuthorityMatryoshka, e.IdentityChainID.String()
+		c.Value = hashString(e.MHash)
+	case *adminBlock.ServerFault:
+		c.Change, c.ChainID, c.ActiveDBHeight = interfaces.AuthorityServerFault, e.ServerID.String(), e.DBHeight
+		c.Value = e.AuditServerID.String()
+	default:
+		return nil
+	}
+	return c
+}
+
+// authoritySetOf applies the changes the way UpdateAuthorityFromABEntry does
+func authoritySetOf(dbheight uint32, changes []interfaces.AuthorityChange, chainID interfaces.IHash) *interfaces.AuthoritySet {
+	auths := make(map[string]*interfaces.AuthorityRecord)
+	get := func(id string) *interfaces.AuthorityRecord {
+		a, ok := auths[id]
+		if !ok {
+			a = &interfaces.AuthorityRecord{ChainID: id, Status: identityStatusString(constants.IDENTITY_UNASSIGNED)}
+			a.AnchorKeys = []interfaces.AnchorKeyRecord{}
+			a.KeyHistory = []interfaces.HistoricKeyRecord{}
+			auths[id] = a
+		}
+		return a
+	}
+
+	set := new(interfaces.AuthoritySet)
+	set.DBHeight = dbheight
+	for _, c := range changes {
+		switch c.Change {
+		case interfaces.AuthorityAddFederated:
+			get(c.ChainID).Status = identityStatusString(constants.IDENTITY_FEDERATED_SERVER)
+		case interfaces.AuthorityAddAudit:
+			get(c.ChainID).Status = identityStatusString(constants.IDENTITY_AUDIT_SERVER)
+		case interfaces.AuthorityRemove:
+			delete(auths, c.ChainID)
+		case interfaces.AuthoritySigningKey:
+			a := get(c.ChainID)
+			if a.SigningKey != "" {
+				a.KeyHistory = append(a.KeyHistory, interfaces.HistoricKeyRecord{ActiveDBHeight: c.ActiveDBHeight, SigningKey: a.SigningKey})
+			}
+			a.SigningKey = c.Value
+		case interfaces.AuthorityAnchorKey:
+			a := get(c.ChainID)
+			a.AnchorKeys = append(a.AnchorKeys, *c.AnchorKey)
+		case interfaces.AuthorityMatryoshka:
+			get(c.ChainID).MatryoshkaHash = c.Value
+		}
+		if chainID != nil && c.ChainID == chainID.String() {
+			set.Changes = append(set.Changes, c)
+		}
+	}
+
+	set.Federated = []*interfaces.AuthorityRecord{}
+	set.Audit = []*interfaces.AuthorityRecord{}
+	for _, a := range auths {
+		switch a.Status {
+		case identityStatusString(constants.IDENTITY_FEDERATED_SERVER):
+			set.Federated = append(set.Federated, a)
+		case identityStatusString(constants.IDENTITY_AUDIT_SERVER):
+			set.Audit = append(set.Audit, a)
+		}
+	}
+	sort.Slice(set.Federated, func(i, j int) bool { return set.Federated[i].ChainID < set.Federated[j].ChainID })
+	sort.Slice(set.Audit, func(i, j int) bool { return set.Audit[i].ChainID < set.Audit[j].ChainID })
+	return set
+}
+
+func identityStatusString(status uint8) string {
+	switch status {
+	case constants.IDENTITY_UNASSIGNED:
+		return "none"
+	case constants.IDENTITY_FEDERATED_SERVER:
+		return "federated"
+	case constants.IDENTITY_AUDIT_SERVER:
+		return "audit"
+	case constants.IDENTITY_FULL:
+		return "full"
+	case constants.IDENTITY_PENDING_FEDERATED_SERVER:
+		return "pending-federated"
+	case constants.IDENTITY_PENDING_AUDIT_SERVER:
+		return "pending-audit"
+	case constants.IDENTITY_PENDING_FULL:
+		return "pending-full"
+	case constants.IDENTITY_SKELETON:
+		return "skeleton"
+	}
+	return fmt.Sprintf("unknown(%d)", status)
+}
+
+func anchorKeyRecords(keys []AnchorSigningKey) []interfaces.AnchorKeyRecord {
+	list := []interfaces.AnchorKeyRecord{}
+	for _, k := range keys {
+		list = append(list, interfaces.AnchorKeyRecord{BlockChain: k.BlockChain, Level: k.KeyLevel, KeyType: k.KeyType, Key: hex.EncodeToString(k.SigningKey[:])})
+	}
+	return list
+}
+
+// hashString is "" for a key or hash not set yet
+func hashString(h interfaces.IHash) string {
+	if h == nil || h.IsZero() {
+		return ""
+	}
+	return h.String()
+}
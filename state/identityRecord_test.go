@@ -0,0 +1,68 @@
+package state_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestGetAuthoritySet(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	fed, _ := primitives.HexToHash("38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9")
+	key := "cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a"
+
+	for _, height := range []uint32{0, s.GetHighestSavedBlk()} {
+		set, err := s.GetAuthoritySet(height, fed)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if set.DBHeight != height || len(set.Federated) != 1 || len(set.Audit) != 0 {
+			t.Fatalf("Bad authority set at %v: %+v", height, set)
+		}
+		if a := set.Federated[0]; a.ChainID != fed.String() || a.SigningKey != key || len(a.KeyHistory) != 0 {
+			t.Errorf("Bad authority %+v", a)
+		}
+		if len(set.Changes) != 2 || set.Changes[0].Change != interfaces.AuthorityAddFederated ||
+			set.Changes[1].Change != interfaces.AuthoritySigningKey || set.Changes[1].Value != key {
+			t.Errorf("Bad changes %+v", set.Changes)
+		}
+	}
+
+	set, err := s.GetAuthoritySet(0, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(set.Changes) != 0 {
+		t.Errorf("Expected no changes without a chain ID, got %+v", set.Changes)
+	}
+
+	if _, err := s.GetAuthoritySet(s.GetHighestSavedBlk()+1, nil); err == nil {
+		t.Errorf("Expected an error above the highest saved block")
+	}
+}
+
+func TestGetIdentityRecord(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	id := primitives.NewHash([]byte("an authority chain, of 32 bytes."))
+	if r := s.GetIdentityRecord(id); r != nil {
+		t.Fatalf("Expected nothing of an unknown identity, got %+v", r)
+	}
+
+	old := primitives.PubKeyFromString("cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a")
+	auth := new(Authority)
+	auth.AuthorityChainID = id
+	auth.SigningKey = primitives.PubKeyFromString("0000000000000000000000000000000000000000000000000000000000000001")
+	auth.KeyHistory = []HistoricKey{{ActiveDBHeight: 5, SigningKey: old}}
+	s.Authorities = append(s.Authorities, auth)
+
+	r := s.GetIdentityRecord(id)
+	if r == nil || r.ChainID != id.String() || r.Authority == nil {
+		t.Fatalf("Bad identity %+v", r)
+	}
+	if h := r.Authority.KeyHistory; len(h) != 1 || h[0].ActiveDBHeight != 5 || h[0].SigningKey != old.String() {
+		t.Errorf("Bad key history %+v", h)
+	}
+}
@@ -1,6 +1,8 @@
 package state
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -261,6 +263,43 @@ var (
 		Name: "factomd_state_execute_msg_time",
 		Help: "Time spent in executeMsg",
 	})
+
+	// Consensus
+	ProcessListVMHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "factomd_state_consensus_vm_height",
+		Help: "Height of processed messages in each VM of the current process list",
+	}, []string{"vm"})
+	ProcessListVMLastAck = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "factomd_state_consensus_vm_last_ack_unix",
+		Help: "Unix time (seconds) of the last ack added to each VM",
+	}, []string{"vm"})
+	EOMArrivalLatency = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Name: "factomd_state_consensus_eom_latency_ns",
+		Help: "Time from the start of a minute until each VM's EOM is processed",
+	}, []string{"minute"})
+	DBSigArrivalLatency = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Name: "factomd_state_consensus_dbsig_latency_ns",
+		Help: "Time from the start of a block until each VM's DBSig is processed",
+	}, []string{"vm"})
+	FaultNegotiationsStarted = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_consensus_fault_negotiations_started",
+		Help: "Tally of fault negotiations added to the System list",
+	})
+	FaultNegotiationsCompleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_consensus_fault_negotiations_completed",
+		Help: "Tally of full faults processed from the System list, whether or not a server was replaced",
+	})
+	MinuteToBlockSaveTime = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_state_consensus_minute_to_save_ns",
+		Help: "Time from the start of the minute until the block built by this node is saved",
+	})
+
+	// Message tracing
+	MsgTraceStageLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "factomd_state_msgtrace_stage_latency_seconds",
+		Help:    "Time from when a message is first seen until it reaches each stage of the node",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 18),
+	}, []string{"type", "stage"})
 )
 
 var registered bool = false
@@ -353,4 +392,30 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(TotalEmptyLoopTime)
 	prometheus.MustRegister(TotalAckLoopTime)
 	prometheus.MustRegister(TotalExecuteMsgTime)
+
+	// Consensus
+	prometheus.MustRegister(ProcessListVMHeight)
+	prometheus.MustRegister(ProcessListVMLastAck)
+	prometheus.MustRegister(EOMArrivalLatency)
+	prometheus.MustRegister(DBSigArrivalLatency)
+	prometheus.MustRegister(FaultNegotiationsStarted)
+	prometheus.MustRegister(FaultNegotiationsCompleted)
+	prometheus.MustRegister(MinuteToBlockSaveTime)
+
+	// Message tracing
+	prometheus.MustRegister(MsgTraceStageLatency)
+}
+
+// vmLabel is the label value used for per-VM consensus metrics
+func vmLabel(vmIndex int) string {
+	return strconv.Itoa(vmIndex)
+}
+
+// sinceMinuteStart returns the nanoseconds elapsed since the current minute started.  While
+// syncing there is no minute start time, so ok is false.
+func sinceMinuteStart(s *State) (ns float64, ok bool) {
+	if s.CurrentMinuteStartTime == 0 {
+		return 0, false
+	}
+	return float64(s.GetClock().Now().UnixNano() - s.CurrentMinuteStartTime), true
 }
@@ -0,0 +1,130 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// metricValue returns the value of a gauge or counter, or the count of a
+// summary
+func metricValue(t *testing.T, m interface{}) float64 {
+	d := new(dto.Metric)
+	if err := m.(prometheus.Metric).Write(d); err != nil {
+		t.Fatalf("%v", err)
+	}
+	switch {
+	case d.Gauge != nil:
+		return d.Gauge.GetValue()
+	case d.Counter != nil:
+		return d.Counter.GetValue()
+	case d.Summary != nil:
+		return float64(d.Summary.GetSampleCount())
+	}
+	t.Fatalf("Unknown metric %v", d)
+	return 0
+}
+
+func TestInstrumentation(t *testing.T) {
+	RegisterPrometheus()
+	RegisterPrometheus()
+
+	// Other tests may have moved the counters and summaries, so they are
+	// checked by how much they move
+	started := metricValue(t, FaultNegotiationsStarted)
+	completed := metricValue(t, FaultNegotiationsCompleted)
+	eoms := metricValue(t, EOMArrivalLatency.WithLabelValues("3"))
+	dbsigs := metricValue(t, DBSigArrivalLatency.WithLabelValues("0"))
+	saves := metricValue(t, MinuteToBlockSaveTime)
+	ProcessListVMHeight.WithLabelValues("0").Set(-1)
+
+	s := testHelper.CreateEmptyTestState()
+	clock := primitives.NewVirtualClock(time.Unix(1500000000, 0))
+	s.Clock = clock
+	s.CurrentMinuteStartTime = clock.Now().UnixNano()
+	clock.Advance(time.Second)
+
+	// Saving a new block
+	dblk, ablk, fblk, ecblk := GenerateGenesisBlocks(s.GetNetworkID())
+	d := s.DBStates.NewDBState(true, dblk, ablk, fblk, ecblk, nil, nil)
+	d.Signed = true
+	d.ReadyToSave = true
+	if !s.DBStates.SaveDBStateToDB(d) {
+		t.Fatalf("The block was not saved")
+	}
+
+	// Its signature by the one federated server
+	leader := s.GetNetworkBootStrapIdentity()
+	auth := s.Authorities[s.AddAuthorityFromChainID(leader)]
+	auth.SigningKey = *s.GetServerPublicKey()
+	s.LeaderPL = s.ProcessLists.Get(1)
+
+	dbs := new(messages.DirectoryBlockSignature)
+	dbs.DirectoryBlockHeader = dblk.GetHeader()
+	dbs.ServerIdentityChainID = leader
+	dbs.DBHeight = 1
+	dbs.Timestamp = s.GetTimestamp()
+	dbs.SetVMIndex(0)
+	if err := dbs.Sign(s); err != nil {
+		t.Fatalf("%v", err)
+	}
+	s.ProcessDBSig(1, dbs)
+	if !s.ProcessDBSig(1, dbs) {
+		t.Fatalf("The DBSig was not processed")
+	}
+
+	// The first EOM starts the minute's end, then each is timed
+	eom := new(messages.EOM)
+	eom.DBHeight = 1
+	eom.Minute = 3
+	eom.Timestamp = s.GetTimestamp()
+	eom.ChainID = leader
+	eom.SetVMIndex(0)
+	s.ProcessEOM(1, eom)
+	s.ProcessEOM(1, eom)
+
+	// A fault on the system list, cleared as the leader is not faulted
+	pl := s.ProcessLists.Get(1)
+	sf := messages.NewServerFault(leader, primitives.NewZeroHash(), 0, 1, 0, 0, s.GetTimestamp())
+	fault := messages.NewFullServerFault(nil, sf, nil, 0)
+	fault.ClearFault = true
+	if !pl.AddToSystemList(fault) {
+		t.Fatalf("The fault was not added")
+	}
+	pl.Process(s)
+
+	// An acknowledged message
+	ack := new(messages.Ack)
+	ack.DBHeight = 1
+	ack.Timestamp = s.GetTimestamp()
+	ack.SaltNumber = s.GetSalt(ack.Timestamp)
+	ack.MessageHash = eom.GetMsgHash()
+	ack.LeaderChainID = s.IdentityChainID
+	ack.SerialHash = primitives.NewZeroHash()
+	ack.SetVMIndex(0)
+	pl.AddToProcessList(ack, eom)
+
+	for _, c := range []struct {
+		name     string
+		got, exp float64
+	}{
+		{"vm height", metricValue(t, ProcessListVMHeight.WithLabelValues("0")), 0},
+		{"vm last ack", metricValue(t, ProcessListVMLastAck.WithLabelValues("0")), 1500000001},
+		{"eom latency", metricValue(t, EOMArrivalLatency.WithLabelValues("3")), eoms + 1},
+		{"dbsig latency", metricValue(t, DBSigArrivalLatency.WithLabelValues("0")), dbsigs + 1},
+		{"faults started", metricValue(t, FaultNegotiationsStarted), started + 1},
+		{"faults completed", metricValue(t, FaultNegotiationsCompleted), completed + 1},
+		{"minute to save", metricValue(t, MinuteToBlockSaveTime), saves + 1},
+	} {
+		if c.got != c.exp {
+			t.Errorf("%s is %v, expected %v", c.name, c.got, c.exp)
+		}
+	}
+}
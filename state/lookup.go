@@ -0,0 +1,250 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// MaxLookupScan is the most unsynced directory blocks LookupChain reads
+// looking for a chain
+const MaxLookupScan = 1000
+
+// LookupEntry reports where an entry stands on this node.  It looks, from
+// most to least settled, in the database, the process lists and holding.
+func (s *State) LookupEntry(entryHash interfaces.IHash) *interfaces.LookupResult {
+	r := s.newLookupResult("entry", entryHash)
+
+	entry, _ := s.DB.FetchEntry(entryHash)
+	var eBlock interfaces.IEntryBlock
+	ebKeyMR, err := s.DB.FetchIncludedIn(entryHash)
+	if err == nil && ebKeyMR != nil {
+		eBlock, _ = s.DB.FetchEBlock(ebKeyMR)
+		if eBlock != nil {
+			r.DBHeight = eBlock.GetDatabaseHeight()
+		}
+	}
+	if entry != nil {
+		r.Status = interfaces.LookupConfirmed
+		return r
+	}
+	if eBlock != nil {
+		r.Status = interfaces.LookupMissingLocally
+		r.Detail = fmt.Sprintf("In entry block %s, entries are synced up to block %d", ebKeyMR, r.EntryDBHeightComplete)
+		r.Retry = true
+		return r
+	}
+
+	for _, pl := range s.LoadLookupLists() {
+		if pl.Revealed[entryHash.Fixed()] {
+			r.Status = interfaces.LookupProcessList
+			r.Detail = "Revealed"
+			r.DBHeight = pl.DBHeight
+			r.Retry = true
+			return r
+		}
+		if pl.Committed[entryHash.Fixed()] {
+			r.Status = interfaces.LookupProcessList
+			r.Detail = "Committed, waiting for the reveal"
+			r.DBHeight = pl.DBHeight
+			r.Retry = true
+			return r
+		}
+	}
+
+	reveal, commit := s.FetchEntryRevealAndCommitFromHolding(entryHash)
+	if reveal != nil || commit != nil {
+		r.Status = interfaces.LookupHolding
+		r.Detail = holdingDetail(reveal, commit)
+		r.Retry = true
+		return r
+	}
+
+	r.Retry = r.Synced == false
+	return r
+}
+
+// LookupChain reports where a chain stands on this node, like LookupEntry.
+// Directory blocks whose entry blocks are not synced yet are read, up to
+// MaxLookupScan of them, to tell a chain missing locally from one never seen.
+func (s *State) LookupChain(chainID interfaces.IHash) *interfaces.LookupResult {
+	r := s.newLookupResult("chain", chainID)
+
+	head, err := s.DB.FetchHeadIndexByChainID(chainID)
+	if err == nil && head != nil {
+		r.Status = interfaces.LookupConfirmed
+		r.Detail = fmt.Sprintf("Chain head %s", head)
+		eBlock, _ := s.DB.FetchEBlock(head)
+		if eBlock != nil {
+			r.DBHeight = eBlock.GetDatabaseHeight()
+		}
+		return r
+	}
+
+	saved := s.GetHighestSavedBlk()
+	start := s.GetEntryBlockDBHeightComplete() + 1
+	if saved >= MaxLookupScan && start < saved-MaxLookupScan {
+		start = saved - MaxLookupScan
+	}
+	for height := start; height <= saved; height++ {
+		dBlock, err := s.DB.FetchDBlockByHeight(height)
+		if err != nil || dBlock == nil {
+			continue
+		}
+		for _, v := range dBlock.GetDBEntries() {
+			if v.GetChainID().IsSameAs(chainID) {
+				r.Status = interfaces.LookupMissingLocally
+				r.Detail = fmt.Sprintf("In entry block %s, entry blocks are synced up to block %d", v.GetKeyMR(), start-1)
+				r.DBHeight = height
+				r.Retry = true
+				return r
+			}
+		}
+	}
+
+	chainIDHash := primitives.NewHash(primitives.DoubleSha(chainID.Bytes()))
+	for _, pl := range s.LoadLookupLists() {
+		if pl.RevealedChains[chainID.Fixed()] {
+			r.Status = interfaces.LookupProcessList
+			r.Detail = "Revealed"
+			r.DBHeight = pl.DBHeight
+			r.Retry = true
+			return r
+		}
+		if pl.CommittedChains[chainIDHash.Fixed()] {
+			r.Status = interfaces.LookupProcessList
+			r.Detail = "Committed, waiting for the reveal"
+			r.DBHeight = pl.DBHeight
+			r.Retry = true
+			return r
+		}
+	}
+
+	var reveal, commit interfaces.IMsg
+	for _, h := range s.LoadHoldingMap() {
+		switch m := h.(type) {
+		case *messages.RevealEntryMsg:
+			if m.Entry.GetChainID().IsSameAs(chainID) {
+				reveal = m
+			}
+		case *messages.CommitChainMsg:
+			if m.CommitChain.ChainIDHash.IsSameAs(chainIDHash) {
+				commit = m
+			}
+		}
+	}
+	if reveal != nil || commit != nil {
+		r.Status = interfaces.LookupHolding
+		r.Detail = holdingDetail(reveal, commit)
+		r.Retry = true
+		return r
+	}
+
+	r.Retry = r.Synced == false
+	return r
+}
+
+func (s *State) newLookupResult(lookupType string, hash interfaces.IHash) *interfaces.LookupResult {
+	r := new(interfaces.LookupResult)
+	r.Type = lookupType
+	r.Hash = hash.String()
+	r.Status = interfaces.LookupNeverSeen
+	r.DBHeightComplete = s.GetDBHeightComplete()
+	r.EntryDBHeightComplete = s.GetEntryDBHeightComplete()
+	r.MissingEntries = len(s.MissingEntries)
+	saved := s.GetHighestSavedBlk()
+	r.Synced = s.GetHighestKnownBlock() <= saved+1 && r.EntryDBHeightComplete >= saved
+	return r
+}
+
+// LookupProcessList is what LookupEntry and LookupChain need from the
+// process list of a block not saved yet.  The API can't walk the process
+// lists, so the state copies them into LookupLists.
+type LookupProcessList struct {
+	DBHeight        uint32
+	Revealed        map[[32]byte]bool // Entry hashes of the new entries
+	RevealedChains  map[[32]byte]bool // Chain IDs of the new entry blocks
+	Committed       map[[32]byte]bool // Entry hashes of the entry and chain commits
+	CommittedChains map[[32]byte]bool // Chain ID hashes of the chain commits
+}
+
+// LoadLookupLists returns the last copy of the unsaved process lists, for
+// the lookup calls from outside the state's scope
+func (s *State) LoadLookupLists() []*LookupProcessList {
+	s.LookupMutex.RLock()
+	defer s.LookupMutex.RUnlock()
+	return s.LookupLists
+}
+
+// fillLookupLists copies the unsaved process lists into LookupLists.  It is
+// executed in the state maintenance processes, where the process lists are
+// in scope.
+func (s *State) fillLookupLists() {
+	// once a second is often enough, as for the holding map
+	if s.LookupLast >= s.GetClock().Now().Unix() {
+		return
+	}
+	var lists []*LookupProcessList
+	saved := s.GetHighestSavedBlk()
+	for _, pl := range s.ProcessLists.Lists {
+		if pl == nil || pl.DBHeight <= saved {
+			continue
+		}
+		l := new(LookupProcessList)
+		l.DBHeight = pl.DBHeight
+		l.Revealed = make(map[[32]byte]bool)
+		for _, k := range pl.GetKeysNewEntries() {
+			l.Revealed[k] = true
+		}
+		l.RevealedChains = make(map[[32]byte]bool)
+		pl.neweblockslock.Lock()
+		for k := range pl.NewEBlocks {
+			l.RevealedChains[k] = true
+		}
+		pl.neweblockslock.Unlock()
+		l.Committed = make(map[[32]byte]bool)
+		l.CommittedChains = make(map[[32]byte]bool)
+		for _, vm := range pl.VMs {
+			for _, m := range vm.List {
+				if h := commitEntryHash(m); h != nil {
+					l.Committed[h.Fixed()] = true
+				}
+				if cc, ok := m.(*messages.CommitChainMsg); ok {
+					l.CommittedChains[cc.CommitChain.ChainIDHash.Fixed()] = true
+				}
+			}
+		}
+		lists = append(lists, l)
+	}
+	s.LookupLast = s.GetClock().Now().Unix()
+	s.LookupMutex.Lock()
+	defer s.LookupMutex.Unlock()
+	s.LookupLists = lists
+}
+
+// commitEntryHash returns the entry hash of an entry or chain commit, or nil
+func commitEntryHash(m interfaces.IMsg) interfaces.IHash {
+	switch c := m.(type) {
+	case *messages.CommitEntryMsg:
+		return c.CommitEntry.EntryHash
+	case *messages.CommitChainMsg:
+		return c.CommitChain.EntryHash
+	}
+	return nil
+}
+
+func holdingDetail(reveal, commit interfaces.IMsg) string {
+	switch {
+	case reveal != nil && commit != nil:
+		return "Committed and revealed"
+	case reveal != nil:
+		return "Revealed, waiting for a commit"
+	}
+	return "Committed, waiting for the reveal"
+}
@@ -0,0 +1,99 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestLookupEntry(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+
+	block := testHelper.CreateFullTestBlockSet()[1]
+	entry := block.Entries[0]
+	r := s.LookupEntry(entry.GetHash())
+	if r.Status != interfaces.LookupConfirmed || r.Retry || r.Type != "entry" {
+		t.Errorf("Expected the entry to be confirmed, got %+v", r)
+	}
+	if r.DBHeight != block.DBlock.GetDatabaseHeight() {
+		t.Errorf("Entry in block %v, expected %v", r.DBHeight, block.DBlock.GetDatabaseHeight())
+	}
+
+	r = s.LookupEntry(primitives.NewHash([]byte("not an entry hash, just 32 bytes")))
+	if r.Status != interfaces.LookupNeverSeen {
+		t.Errorf("Expected an unknown entry to be never seen, got %+v", r)
+	}
+
+	// An entry block saved without its entry
+	missing := entryBlock.NewEntry()
+	missing.ChainID = primitives.NewHash([]byte("chain with an entry not synced.."))
+	missing.Content = primitives.ByteSlice{Bytes: []byte("missing")}
+	eBlock := entryBlock.NewEBlock()
+	eBlock.GetHeader().SetChainID(missing.ChainID)
+	eBlock.GetHeader().SetDBHeight(3)
+	eBlock.AddEBEntry(missing)
+	if err := s.DB.ProcessEBlockBatch(eBlock, false); err != nil {
+		t.Fatalf("%v", err)
+	}
+	r = s.LookupEntry(missing.GetHash())
+	if r.Status != interfaces.LookupMissingLocally || r.Retry == false || r.DBHeight != 3 {
+		t.Errorf("Expected the entry to be missing locally, got %+v", r)
+	}
+
+	// A commit in the copy of an unsaved process list
+	pending := testHelper.CreateTestEntry(999)
+	s.LookupLists = []*LookupProcessList{{
+		DBHeight:  10,
+		Committed: map[[32]byte]bool{pending.GetHash().Fixed(): true},
+	}}
+	r = s.LookupEntry(pending.GetHash())
+	if r.Status != interfaces.LookupProcessList || r.DBHeight != 10 || r.Detail != "Committed, waiting for the reveal" {
+		t.Errorf("Expected the entry to be in the process list, got %+v", r)
+	}
+	s.LookupLists = nil
+
+	// A reveal waiting in holding
+	reveal := new(messages.RevealEntryMsg)
+	reveal.Entry = pending
+	reveal.Timestamp = primitives.NewTimestampNow()
+	s.HoldingMap = map[[32]byte]interfaces.IMsg{reveal.GetMsgHash().Fixed(): reveal}
+	r = s.LookupEntry(pending.GetHash())
+	if r.Status != interfaces.LookupHolding || r.Retry == false {
+		t.Errorf("Expected the entry to be in holding, got %+v", r)
+	}
+	if r.Detail != "Revealed, waiting for a commit" {
+		t.Errorf("Wrong detail %q", r.Detail)
+	}
+}
+
+func TestLookupChain(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+
+	r := s.LookupChain(testHelper.GetChainID())
+	if r.Status != interfaces.LookupConfirmed || r.Type != "chain" {
+		t.Errorf("Expected the chain to be confirmed, got %+v", r)
+	}
+
+	chainID := primitives.NewHash([]byte("a chain that was never created.."))
+	r = s.LookupChain(chainID)
+	if r.Status != interfaces.LookupNeverSeen {
+		t.Errorf("Expected an unknown chain to be never seen, got %+v", r)
+	}
+
+	first := entryBlock.NewEntry()
+	first.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("lookup")}}
+	first.ChainID = entryBlock.NewChainID(first)
+	reveal := new(messages.RevealEntryMsg)
+	reveal.Entry = first
+	reveal.Timestamp = primitives.NewTimestampNow()
+	s.HoldingMap = map[[32]byte]interfaces.IMsg{reveal.GetMsgHash().Fixed(): reveal}
+	r = s.LookupChain(first.ChainID)
+	if r.Status != interfaces.LookupHolding {
+		t.Errorf("Expected the chain to be in holding, got %+v", r)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsHistory keeps ring buffers of the node's recent throughput, queues and consensus timing,
+// so the control panel can chart trends without an external metrics service. The state's
+// goroutine adds to it, and anything may read it. All methods are safe on a nil history.
+type MetricsHistory struct {
+	mutex sync.RWMutex
+
+	samples    []MetricsSample
+	sampleNext int
+	blocks     []MetricsDuration
+	blockNext  int
+	minutes    []MetricsDuration
+	minuteNext int
+
+	lastSample   time.Time
+	lastEntries  int
+	lastFactoids int
+}
+
+var (
+	MetricsSampleInterval = time.Second
+	MetricsSampleLength   = 900 // 15 minutes of samples
+	MetricsDurationLength = 240 // Blocks and minutes
+)
+
+// MetricsSample is the node at one moment, with rates over the time since the previous sample
+type MetricsSample struct {
+	Time          int64         `json:"time"` // Unix milliseconds
+	Height        uint32        `json:"height"`
+	Minute        int           `json:"minute"`
+	EntriesPerSec float64       `json:"entriespersec"`
+	FactoidTPS    float64       `json:"factoidtps"`
+	Peers         int           `json:"peers"`
+	Queues        MetricsQueues `json:"queues"`
+}
+
+type MetricsQueues struct {
+	InMsg      int `json:"inmsg"`
+	Msg        int `json:"msg"`
+	API        int `json:"api"`
+	Ack        int `json:"ack"`
+	NetworkOut int `json:"networkout"`
+	Holding    int `json:"holding"`
+	Acks       int `json:"acks"`
+	Commits    int `json:"commits"`
+}
+
+// MetricsDuration is how long a block or a minute took
+type MetricsDuration struct {
+	Time    int64   `json:"time"` // Unix milliseconds when it ended
+	Height  uint32  `json:"height"`
+	Minute  int     `json:"minute"` // Only for minutes
+	Seconds float64 `json:"seconds"`
+}
+
+// MetricsHistoryData is a copy of the history, oldest first
+type MetricsHistoryData struct {
+	Interval float64           `json:"interval"` // Seconds between samples
+	Samples  []MetricsSample   `json:"samples"`
+	Blocks   []MetricsDuration `json:"blocks"`
+	Minutes  []MetricsDuration `json:"minutes"`
+}
+
+func NewMetricsHistory() *MetricsHistory {
+	h := new(MetricsHistory)
+	h.samples = make([]MetricsSample, 0, MetricsSampleLength)
+	h.blocks = make([]MetricsDuration, 0, MetricsDurationLength)
+	h.minutes = make([]MetricsDuration, 0, MetricsDurationLength)
+	return h
+}
+
+// Sample adds a sample of the state, if MetricsSampleInterval has passed since the last one.
+// Called from the state's goroutine.
+func (h *MetricsHistory) Sample(s *State) {
+	if h == nil {
+		return
+	}
+	now := s.GetClock().Now()
+	if now.Sub(h.lastSample) < MetricsSampleInterval {
+		return
+	}
+
+	m := MetricsSample{
+		Time:   now.UnixNano() / 1e6,
+		Height: s.GetHighestSavedBlk(),
+		Minute: s.CurrentMinute,
+		Peers:  s.GetNumberConnections(),
+	}
+	m.Queues.InMsg = s.InMsgQueue().Length()
+	m.Queues.Msg = len(s.MsgQueue())
+	m.Queues.API = s.APIQueue().Length()
+	m.Queues.Ack = len(s.AckQueue())
+	m.Queues.NetworkOut = s.NetworkOutMsgQueue().Length()
+	m.Queues.Holding = len(s.Holding)
+	m.Queues.Acks = len(s.Acks)
+	m.Queues.Commits = s.Commits.Len()
+
+	entries, factoids := s.NewEntries, s.FactoidTrans
+	if !h.lastSample.IsZero() {
+		elapsed := now.Sub(h.lastSample).Seconds()
+		m.EntriesPerSec = float64(entries-h.lastEntries) / elapsed
+		m.FactoidTPS = float64(factoids-h.lastFactoids) / elapsed
+	}
+	h.lastSample, h.lastEntries, h.lastFactoids = now, entries, factoids
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if len(h.samples) < cap(h.samples) {
+		h.samples = append(h.samples, m)
+	} else {
+		h.samples[h.sampleNext] = m
+		h.sampleNext = (h.sampleNext + 1) % len(h.samples)
+	}
+}
+
+// AddBlock records how long the block at the height took, start and end are in Unix nanoseconds
+func (h *MetricsHistory) AddBlock(height uint32, start, end int64) {
+	if h == nil || start == 0 {
+		return
+	}
+	d := newMetricsDuration(height, 0, start, end)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.blocks, h.blockNext = addDuration(h.blocks, h.blockNext, d)
+}
+
+// AddMinute records how long the minute of the block at the height took, start and end are in
+// Unix nanoseconds
+func (h *MetricsHistory) AddMinute(height uint32, minute int, start, end int64) {
+	if h == nil || start == 0 {
+		return
+	}
+	d := newMetricsDuration(height, minute, start, end)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.minutes, h.minuteNext = addDuration(h.minutes, h.minuteNext, d)
+}
+
+func newMetricsDuration(height uint32, minute int, start, end int64) MetricsDuration {
+	return MetricsDuration{
+		Time:    end / 1e6,
+		Height:  height,
+		Minute:  minute,
+		Seconds: float64(end-start) / 1e9,
+	}
+}
+
+func addDuration(ring []MetricsDuration, next int, d MetricsDuration) ([]MetricsDuration, int) {
+	if len(ring) < cap(ring) {
+		return append(ring, d), next
+	}
+	ring[next] = d
+	return ring, (next + 1) % len(ring)
+}
+
+// Get returns a copy of everything after since, in Unix milliseconds, oldest first. 0 returns all.
+func (h *MetricsHistory) Get(since int64) *MetricsHistoryData {
+	data := new(MetricsHistoryData)
+	data.Interval = MetricsSampleInterval.Seconds()
+	data.Samples = []MetricsSample{}
+	data.Blocks = []MetricsDuration{}
+	data.Minutes = []MetricsDuration{}
+	if h == nil {
+		return data
+	}
+
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	for i := range h.samples {
+		m := h.samples[(h.sampleNext+i)%len(h.samples)]
+		if m.Time > since {
+			data.Samples = append(data.Samples, m)
+		}
+	}
+	data.Blocks = durationsSince(h.blocks, h.blockNext, since)
+	data.Minutes = durationsSince(h.minutes, h.minuteNext, since)
+	return data
+}
+
+func durationsSince(ring []MetricsDuration, next int, since int64) []MetricsDuration {
+	list := []MetricsDuration{}
+	for i := range ring {
+		d := ring[(next+i)%len(ring)]
+		if d.Time > since {
+			list = append(list, d)
+		}
+	}
+	return list
+}
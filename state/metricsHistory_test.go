@@ -0,0 +1,75 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/state"
+	. "github.com/FactomProject/factomd/testHelper"
+)
+
+func TestMetricsHistoryDurations(t *testing.T) {
+	length := MetricsDurationLength
+	defer func() { MetricsDurationLength = length }()
+	MetricsDurationLength = 5
+
+	h := NewMetricsHistory()
+	end := time.Now().UnixNano()
+	start := end - int64(6*time.Second)
+	for i := uint32(0); i < 8; i++ {
+		h.AddBlock(i, start, end)
+		h.AddMinute(i, int(i%10), start, end)
+	}
+	h.AddBlock(8, 0, end) // No start time while syncing
+
+	data := h.Get(0)
+	if len(data.Blocks) != 5 || len(data.Minutes) != 5 {
+		t.Fatalf("The rings should hold 5 blocks and minutes, not %d and %d", len(data.Blocks), len(data.Minutes))
+	}
+	for i, b := range data.Blocks {
+		if b.Height != uint32(i+3) {
+			t.Errorf("Block %d should be height %d, not %d", i, i+3, b.Height)
+		}
+		if b.Seconds < 6 {
+			t.Errorf("Block %d took %f seconds, should be at least 6", i, b.Seconds)
+		}
+	}
+	if data.Minutes[4].Minute != 7 {
+		t.Errorf("The last minute should be 7, not %d", data.Minutes[4].Minute)
+	}
+	if since := h.Get(data.Blocks[4].Time); len(since.Blocks) != 0 {
+		t.Errorf("Nothing should be after the last block, not %d blocks", len(since.Blocks))
+	}
+
+	var none *MetricsHistory
+	none.AddBlock(1, start, end)
+	if data := none.Get(0); data.Samples == nil || len(data.Blocks) != 0 {
+		t.Error("A nil history should return empty lists")
+	}
+}
+
+func TestMetricsHistorySample(t *testing.T) {
+	interval := MetricsSampleInterval
+	defer func() { MetricsSampleInterval = interval }()
+	MetricsSampleInterval = 0
+
+	s := CreateAndPopulateTestState()
+	h := NewMetricsHistory()
+	h.Sample(s)
+	s.FactoidTrans += 10
+	s.NewEntries += 20
+	time.Sleep(10 * time.Millisecond)
+	h.Sample(s)
+
+	data := h.Get(0)
+	if len(data.Samples) != 2 {
+		t.Fatalf("There should be 2 samples, not %d", len(data.Samples))
+	}
+	m := data.Samples[1]
+	if m.FactoidTPS <= 0 || m.EntriesPerSec <= m.FactoidTPS {
+		t.Errorf("Wrong rates %f factoid and %f entries per second", m.FactoidTPS, m.EntriesPerSec)
+	}
+	if m.Height != s.GetHighestSavedBlk() {
+		t.Errorf("The sample should be at height %d, not %d", s.GetHighestSavedBlk(), m.Height)
+	}
+}
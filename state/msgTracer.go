@@ -0,0 +1,190 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Stages of the node pipeline a message is stamped at
+const (
+	TraceNetIn       = "NetIn"       // Received from a peer or the API
+	TraceInMsgQueue  = "InMsgQueue"  // Pulled off the InMsgQueue by the validator
+	TraceExecute     = "Execute"     // Executed by LeaderExecute or FollowerExecute
+	TraceProcessList = "ProcessList" // Acknowledged and added to a process list
+	TraceDBState     = "DBState"     // Saved to the database as part of a block
+)
+
+// MsgTracer stamps the messages that end up in process lists as they move through the node,
+// exporting the latency of each stage per message type.  Traces that take longer than
+// SlowThreshold to reach a saved block (or never do) are kept for the debug API.
+//
+// A nil *MsgTracer is valid, and does nothing.
+type MsgTracer struct {
+	SlowThreshold time.Duration // Traces slower than this are kept
+	MaxSlow       int           // Number of slow traces to keep
+	MaxAge        time.Duration // Traces not complete after this long are given up on
+
+	mutex     sync.Mutex
+	traces    map[[32]byte]*interfaces.MsgTrace
+	slow      []*interfaces.MsgTrace
+	lastPrune time.Time
+}
+
+func NewMsgTracer(slowThreshold time.Duration) *MsgTracer {
+	t := new(MsgTracer)
+	t.SlowThreshold = slowThreshold
+	t.MaxSlow = 100
+	t.MaxAge = 30 * time.Minute
+	t.traces = make(map[[32]byte]*interfaces.MsgTrace)
+	t.lastPrune = time.Now()
+	return t
+}
+
+// traced returns true for the types of messages that go into process lists, as only those
+// messages ever reach a block.
+func traced(msg interfaces.IMsg) bool {
+	switch msg.Type() {
+	case constants.EOM_MSG,
+		constants.DIRECTORY_BLOCK_SIGNATURE_MSG,
+		constants.COMMIT_CHAIN_MSG,
+		constants.COMMIT_ENTRY_MSG,
+		constants.REVEAL_ENTRY_MSG,
+		constants.FACTOID_TRANSACTION_MSG,
+		constants.ADDSERVER_MSG,
+		constants.REMOVESERVER_MSG,
+		constants.CHANGESERVER_KEY_MSG:
+		return true
+	}
+	return false
+}
+
+// Stamp records that the message reached the given stage.  Only the first time a message
+// reaches a stage is recorded, so time spent recycling through Holding counts against
+// the stage that follows it.
+func (t *MsgTracer) Stamp(msg interfaces.IMsg, stage string) {
+	if t == nil || msg == nil || !traced(msg) {
+		return
+	}
+	hash := msg.GetMsgHash()
+	if hash == nil {
+		return
+	}
+	now := time.Now()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.stamp(hash.Fixed(), msg, stage, now)
+	t.prune(now)
+}
+
+// Finish stamps the message with the final stage, and stops tracing it.  Messages that are
+// not being traced (i.e. that came to us in a DBState) are ignored.
+func (t *MsgTracer) Finish(msg interfaces.IMsg, stage string) {
+	if t == nil || msg == nil || !traced(msg) {
+		return
+	}
+	hash := msg.GetMsgHash()
+	if hash == nil {
+		return
+	}
+	now := time.Now()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := hash.Fixed()
+	if _, ok := t.traces[key]; !ok {
+		return
+	}
+	trace := t.stamp(key, msg, stage, now)
+	delete(t.traces, key)
+	trace.Complete = true
+	if now.Sub(time.Unix(0, trace.Start)) > t.SlowThreshold {
+		t.addSlow(trace)
+	}
+}
+
+// FinishProcessList finishes the traces of all the messages in a process list, once its
+// block has been saved.
+func (t *MsgTracer) FinishProcessList(pl *ProcessList) {
+	if t == nil || pl == nil {
+		return
+	}
+	for _, vm := range pl.VMs {
+		for _, msg := range vm.List {
+			t.Finish(msg, TraceDBState)
+		}
+	}
+}
+
+// SlowTraces returns the most recent slow traces, oldest first
+func (t *MsgTracer) SlowTraces() []*interfaces.MsgTrace {
+	if t == nil {
+		return nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	traces := make([]*interfaces.MsgTrace, len(t.slow))
+	copy(traces, t.slow)
+	return traces
+}
+
+func (t *MsgTracer) stamp(key [32]byte, msg interfaces.IMsg, stage string, now time.Time) *interfaces.MsgTrace {
+	trace, ok := t.traces[key]
+	if !ok {
+		trace = new(interfaces.MsgTrace)
+		trace.MsgHash = msg.GetMsgHash().String()
+		trace.Type = messages.MessageName(msg.Type())
+		trace.Start = now.UnixNano()
+		t.traces[key] = trace
+	}
+	for _, s := range trace.Stamps {
+		if s.Stage == stage {
+			return trace
+		}
+	}
+
+	elapsed := now.UnixNano() - trace.Start
+	trace.Stamps = append(trace.Stamps, interfaces.MsgTraceStamp{Stage: stage, Nanos: elapsed})
+	MsgTraceStageLatency.WithLabelValues(trace.Type, stage).Observe(float64(elapsed) / float64(time.Second))
+	return trace
+}
+
+func (t *MsgTracer) addSlow(trace *interfaces.MsgTrace) {
+	t.slow = append(t.slow, trace)
+	if len(t.slow) > t.MaxSlow {
+		t.slow = t.slow[len(t.slow)-t.MaxSlow:]
+	}
+}
+
+// prune gives up on traces older than MaxAge, keeping them as slow (incomplete) traces.
+// These are messages that were dropped somewhere along the way.
+func (t *MsgTracer) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < time.Minute {
+		return
+	}
+	t.lastPrune = now
+
+	oldest := now.Add(-t.MaxAge).UnixNano()
+	for k, trace := range t.traces {
+		if trace.Start < oldest {
+			delete(t.traces, k)
+			t.addSlow(trace)
+		}
+	}
+}
+
+// GetSlowMsgTraces returns the slow message traces kept by the tracer, if tracing is on
+func (s *State) GetSlowMsgTraces() []*interfaces.MsgTrace {
+	return s.MsgTrace.SlowTraces()
+}
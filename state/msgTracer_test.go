@@ -0,0 +1,85 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/messages"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func newTracedCommit() *messages.CommitEntryMsg {
+	commit := messages.NewCommitEntryMsg()
+	commit.CommitEntry = entryCreditBlock.NewCommitEntry()
+	commit.CommitEntry.Credits = 2
+	commit.CommitEntry.Init()
+	return commit
+}
+
+func TestMsgTracer(t *testing.T) {
+	tracer := NewMsgTracer(0)
+	commit := newTracedCommit()
+
+	tracer.Stamp(commit, TraceNetIn)
+	tracer.Stamp(commit, TraceExecute)
+	tracer.Stamp(commit, TraceExecute) // Only the first execution is recorded
+	tracer.Finish(commit, TraceDBState)
+
+	traces := tracer.SlowTraces()
+	if len(traces) != 1 {
+		t.Fatalf("Expected 1 slow trace, found %d", len(traces))
+	}
+	trace := traces[0]
+	if !trace.Complete {
+		t.Error("Trace should be complete")
+	}
+	if trace.MsgHash != commit.GetMsgHash().String() {
+		t.Errorf("Wrong hash in trace %s", trace.MsgHash)
+	}
+	if len(trace.Stamps) != 3 {
+		t.Fatalf("Expected 3 stamps, found %d", len(trace.Stamps))
+	}
+	stages := []string{TraceNetIn, TraceExecute, TraceDBState}
+	for i, s := range trace.Stamps {
+		if s.Stage != stages[i] {
+			t.Errorf("Expected stage %s, found %s", stages[i], s.Stage)
+		}
+		if i > 0 && s.Nanos < trace.Stamps[i-1].Nanos {
+			t.Error("Stamps are out of order")
+		}
+	}
+
+	// Finishing a message we never saw does nothing
+	tracer.Finish(newTracedCommit(), TraceDBState)
+	if len(tracer.SlowTraces()) != 1 {
+		t.Error("Untraced message should not be finished")
+	}
+
+	// Acks never reach a block, so they are not traced
+	tracer.Stamp(new(messages.Ack), TraceNetIn)
+}
+
+func TestMsgTracerSlowLimit(t *testing.T) {
+	tracer := NewMsgTracer(0)
+	tracer.MaxSlow = 5
+	for i := 0; i < 10; i++ {
+		commit := newTracedCommit()
+		commit.CommitEntry.Credits = uint8(i + 1)
+		tracer.Stamp(commit, TraceNetIn)
+		tracer.Finish(commit, TraceDBState)
+	}
+	if len(tracer.SlowTraces()) != 5 {
+		t.Errorf("Expected 5 slow traces, found %d", len(tracer.SlowTraces()))
+	}
+}
+
+func TestNilMsgTracer(t *testing.T) {
+	var tracer *MsgTracer
+	commit := newTracedCommit()
+	tracer.Stamp(commit, TraceNetIn)
+	tracer.Finish(commit, TraceDBState)
+	tracer.FinishProcessList(nil)
+	if tracer.SlowTraces() != nil {
+		t.Error("A nil tracer has no traces")
+	}
+}
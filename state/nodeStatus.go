@@ -0,0 +1,191 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// GetNodeStatus collects the same information printed by the console summary
+// (SetStringQueues and engine.printSummary), in a form tooling can consume.
+func (s *State) GetNodeStatus() *interfaces.NodeStatus {
+	ns := new(interfaces.NodeStatus)
+	ns.NodeName = s.FactomNodeName
+	ns.IdentityChainID = s.IdentityChainID.String()
+	ns.NetStateOff = s.NetStateOff
+	ns.RunLeader = s.RunLeader
+	ns.IgnoreMissing = s.IgnoreMissing
+
+	vmin := s.CurrentMinute
+	if vmin > 9 {
+		vmin = 0
+	}
+
+	// Role and VM assignment
+	ns.Role = "follower"
+	ns.VM = -1
+	ns.Heights.LeaderMinute = -1
+	pl := s.ProcessLists.Get(s.LLeaderHeight)
+	if pl != nil {
+		if found, vm := pl.GetVirtualServers(vmin, s.IdentityChainID); found {
+			ns.Role = "leader"
+			ns.VM = vm
+			ns.Negotiator = pl.AmINegotiator
+			if vm < len(pl.VMs) {
+				ns.Heights.LeaderMinute = pl.VMs[vm].LeaderMinute
+			}
+		} else if foundAudit, _ := pl.GetAuditServerIndexHash(s.IdentityChainID); foundAudit {
+			ns.Role = "audit"
+		}
+	}
+
+	// Heights
+	ns.Heights.DirectoryBlock = s.GetHighestSavedBlk()
+	if d := s.DBStates.Get(int(ns.Heights.DirectoryBlock)); d != nil && d.DirectoryBlock != nil {
+		ns.Heights.DirectoryBlockMR = d.DirectoryBlock.GetKeyMR().String()
+	}
+	ns.Heights.Leader = s.LLeaderHeight
+	ns.Heights.HighestKnown = s.GetHighestKnownBlock()
+	ns.Heights.HighestAck = s.GetHighestAck()
+	ns.Heights.ProcessListBase = s.ProcessLists.DBHeightBase
+	ns.Heights.ProcessListProc = s.PLProcessHeight
+	ns.Heights.ProcessListTop = s.GetTrueLeaderHeight() + 2
+	ns.Heights.CurrentMinute = s.CurrentMinute
+
+	// Process list
+	ns.ProcessList.VMs = []interfaces.NodeStatusVM{}
+	if pl != nil {
+		ns.ProcessList.DBHeight = pl.DBHeight
+		for i, vm := range pl.VMs {
+			if i >= len(pl.FedServers) {
+				break
+			}
+			v := interfaces.NodeStatusVM{
+				VM:           i,
+				Height:       vm.Height,
+				ListLength:   len(vm.List),
+				LeaderMinute: vm.LeaderMinute,
+				Synced:       vm.Synced,
+				Faulted:      vm.WhenFaulted > 0,
+			}
+			if fi := pl.ServerMap[vmin][i]; fi >= 0 && fi < len(pl.FedServers) {
+				v.FedServer = pl.FedServers[fi].GetChainID().String()
+			}
+			ns.ProcessList.VMs = append(ns.ProcessList.VMs, v)
+		}
+	}
+	if s.LeaderPL != nil {
+		ns.ProcessList.PendingEBlocks = len(s.LeaderPL.NewEBlocks)
+		ns.ProcessList.PendingEntries = s.LeaderPL.LenNewEntries()
+	}
+
+	// Queues
+	q := &ns.Queues
+	q.MsgQueue = len(s.MsgQueue())
+	q.InMsgQueue = s.InMsgQueue().Length()
+	q.APIQueue = s.APIQueue().Length()
+	q.AckQueue = len(s.AckQueue())
+	q.TimerMsgQueue = len(s.TimerMsgQueue())
+	q.NetworkOutMsgQueue = s.NetworkOutMsgQueue().Length()
+	q.NetworkInvalidMsgQueue = len(s.NetworkInvalidMsgQueue())
+	q.Holding = len(s.Holding)
+	q.Acks = len(s.Acks)
+	q.Commits = s.Commits.Len()
+	q.Review = len(s.XReview)
+	q.UpdateEntryHash = len(s.UpdateEntryHash)
+	q.MissingEntries = len(s.MissingEntries)
+	q.WriteEntry = len(s.WriteEntry)
+
+	// Sync
+	if ns.Heights.HighestKnown > ns.Heights.DirectoryBlock {
+		ns.Sync.BlockGap = ns.Heights.HighestKnown - ns.Heights.DirectoryBlock
+	}
+	ns.Sync.EntryBlockDBHeightComplete = s.EntryBlockDBHeightComplete
+	ns.Sync.EntryBlockDBHeightProcessing = s.EntryBlockDBHeightProcessing
+	ns.Sync.EntryDBHeightComplete = s.EntryDBHeightComplete
+	if ns.Heights.DirectoryBlock > s.EntryDBHeightComplete {
+		ns.Sync.EntrySyncGap = ns.Heights.DirectoryBlock - s.EntryDBHeightComplete
+	}
+	ns.Sync.MissingEntryCount = s.GetMissingEntryCount()
+	ns.Sync.DBFinished = s.DBFinished
+
+	// Faults and authorities
+	ns.Authorities = []interfaces.NodeStatusAuthority{}
+	if pl != nil {
+		ns.Faults.SystemHeight = pl.System.Height
+		ns.Faults.SystemLength = len(pl.System.List)
+		if ff := pl.CurrentFault(); !ff.IsNil() {
+			ns.Faults.Current = &interfaces.NodeStatusFault{
+				VM:            int(ff.VMIndex),
+				ServerID:      ff.ServerID.String(),
+				AuditServerID: ff.AuditServerID.String(),
+				Votes:         len(ff.LocalVoteMap),
+				Signatures:    int(ff.SignatureList.Length),
+				Tally:         ff.SigTally(s),
+				PledgeDone:    ff.PledgeDone,
+			}
+		}
+		for _, f := range pl.FedServers {
+			ns.Authorities = append(ns.Authorities, interfaces.NodeStatusAuthority{
+				ChainID:   f.GetChainID().String(),
+				Federated: true,
+				Online:    f.IsOnline(),
+			})
+		}
+		for _, a := range pl.AuditServers {
+			ns.Authorities = append(ns.Authorities, interfaces.NodeStatusAuthority{
+				ChainID:   a.GetChainID().String(),
+				Federated: false,
+				Online:    a.IsOnline(),
+			})
+		}
+	}
+
+	// Counters
+	c := &ns.Counters
+	c.ResetTries = s.ResetTryCnt
+	c.Resets = s.ResetCnt
+	c.DropRate = s.DropRate
+	c.Delay = s.Delay
+	c.DBStateAsk = s.DBStateAskCnt
+	c.DBStateReply = s.DBStateReplyCnt
+	c.DBStateIgnore = s.DBStateIgnoreCnt
+	c.DBStateApplied = s.DBStateAppliedCnt
+	c.MissingAsk = s.MissingRequestAskCnt
+	c.MissingReply = s.MissingRequestReplyCnt
+	c.MissingIgnore = s.MissingRequestIgnoreCnt
+	c.MissingApplied = s.MissingResponseAppliedCnt
+	c.Resend = s.ResendCnt
+	c.Expire = s.ExpireCnt
+	c.FactoidTrans = s.FactoidTrans
+	c.NewEntryChains = s.NewEntryChains
+	c.NewEntries = s.NewEntries - s.NewEntryChains
+	c.FCTSubmits = s.FCTSubmits
+	c.ECCommits = s.ECCommits
+	c.ECommits = s.ECommits
+	// Don't call CalculateTransactionRate; it advances the instant rate window
+	if runtime := time.Since(s.starttime).Seconds(); runtime > 0 {
+		c.TotalTPS = float64(s.FactoidTrans+s.NewEntryChains+s.NewEntries) / runtime
+	}
+	c.InstantTPS = s.tps
+
+	// Network
+	ns.Network.Peers = s.GetNumberConnections()
+	if s.MessageTally {
+		for i := 0; i < constants.NUM_MESSAGES; i++ {
+			ns.Network.Received = append(ns.Network.Received, s.GetMessageTalliesReceived(i))
+			ns.Network.Sent = append(ns.Network.Sent, s.GetMessageTalliesSent(i))
+		}
+	}
+
+	if s.Balancehash != nil {
+		ns.BalanceHash = s.Balancehash.String()
+	}
+
+	return ns
+}
@@ -0,0 +1,49 @@
+package state_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestGetNodeStatus(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	s.MessageTally = true
+
+	ns := s.GetNodeStatus()
+	if ns.NodeName != s.FactomNodeName {
+		t.Errorf("Wrong node name %s", ns.NodeName)
+	}
+	if ns.IdentityChainID != s.IdentityChainID.String() {
+		t.Errorf("Wrong identity %s", ns.IdentityChainID)
+	}
+	switch ns.Role {
+	case "leader":
+		if ns.VM < 0 {
+			t.Errorf("Leader has no VM")
+		}
+	case "audit", "follower":
+		if ns.VM != -1 {
+			t.Errorf("%s should have no VM, has %d", ns.Role, ns.VM)
+		}
+	default:
+		t.Errorf("Unknown role %s", ns.Role)
+	}
+	if ns.Heights.DirectoryBlock != s.GetHighestSavedBlk() {
+		t.Errorf("Directory block height %d, expected %d", ns.Heights.DirectoryBlock, s.GetHighestSavedBlk())
+	}
+	if ns.Heights.Leader != s.LLeaderHeight {
+		t.Errorf("Leader height %d, expected %d", ns.Heights.Leader, s.LLeaderHeight)
+	}
+	if ns.Queues.Holding != len(s.Holding) || ns.Queues.Acks != len(s.Acks) {
+		t.Errorf("Holding/Acks sizes do not match the state")
+	}
+	if len(ns.Network.Received) == 0 || len(ns.Network.Received) != len(ns.Network.Sent) {
+		t.Errorf("Message tallies missing")
+	}
+
+	if _, err := json.Marshal(ns); err != nil {
+		t.Errorf("%v", err)
+	}
+}
@@ -716,6 +716,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 					break systemloop
 				}
 				p.System.Height++
+				FaultNegotiationsCompleted.Inc()
 				progress = true
 			}
 		}
@@ -843,6 +844,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 				break VMListLoop
 			}
 		}
+		ProcessListVMHeight.WithLabelValues(vmLabel(i)).Set(float64(vm.Height))
 	}
 	return
 }
@@ -883,6 +885,7 @@ func (p *ProcessList) AddToSystemList(m interfaces.IMsg) bool {
 	if len(p.System.List) <= p.System.Height {
 		// Nothing in our list a this slot yet, so insert this FullFault message
 		p.System.List = append(p.System.List, fullFault)
+		FaultNegotiationsStarted.Inc()
 		//p.State.AddStatus(fmt.Sprintf("FULL FAULT AddToSystemList Success (append) : %s",
 		//	fullFault.String()))
 		return true
@@ -1013,6 +1016,7 @@ func (p *ProcessList) AddToProcessList(ack *messages.Ack, m interfaces.IMsg) {
 	// recorded, then we still treat it as if we recorded it.
 
 	vm.heartBeat = 0 // We have heard from this VM
+	ProcessListVMLastAck.WithLabelValues(vmLabel(ack.VMIndex)).Set(float64(now.GetTimeSeconds()))
 
 	TotalHoldingQueueOutputs.Inc()
 	TotalAcksOutputs.Inc()
@@ -1038,6 +1042,7 @@ func (p *ProcessList) AddToProcessList(ack *messages.Ack, m interfaces.IMsg) {
 	p.VMs[ack.VMIndex].ListAck[ack.Height] = ack
 	p.AddOldMsgs(m)
 	p.OldAcks[m.GetMsgHash().Fixed()] = ack
+	p.State.MsgTrace.Stamp(m, TraceProcessList)
 
 	plLogger.WithFields(log.Fields{"func": "AddToProcessList", "node-name": p.State.GetFactomNodeName(), "plheight": ack.Height, "dbheight": p.DBHeight}).WithFields(m.LogFields()).Info("Add To Process List")
 }
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -82,7 +83,11 @@ type State struct {
 	ControlPanelPort        int
 	ControlPanelSetting     int
 	ControlPanelChannel     chan DisplayState
-	ControlPanelDataRequest bool // If true, update Display state
+	ControlPanelDataRequest bool     // If true, update Display state
+	ControlPanelUsers       []string // name:role:hash accounts, see controlPanel/auth.go
+	ControlPanelUsersFile   string
+	ControlPanelAuditLog    string
+	MetricsHistory          *MetricsHistory // Recent samples for the control panel charts
 
 	// Network Configuration
 	Network                 string
@@ -100,10 +105,11 @@ type State struct {
 	CustomBootstrapIdentity string
 	CustomBootstrapKey      string
 
-	IdentityChainID      interfaces.IHash // If this node has an identity, this is it
-	Identities           []*Identity      // Identities of all servers in management chain
-	Authorities          []*Authority     // Identities of all servers in management chain
-	AuthorityServerCount int              // number of federated or audit servers allowed
+	IdentityChainID      interfaces.IHash  // If this node has an identity, this is it
+	Identities           []*Identity       // Identities of all servers in management chain
+	Authorities          []*Authority      // Identities of all servers in management chain
+	authorityHistory     *authorityHistory // Authority set changes read from the admin blocks, for the API
+	AuthorityServerCount int               // number of federated or audit servers allowed
 
 	// Just to print (so debugging doesn't drive functionaility)
 	Status      int // Return a status (0 do nothing, 1 provide queues, 2 provide consensus data)
@@ -129,6 +135,12 @@ type State struct {
 	AcksLast  int64
 	AcksMap   map[[32]byte]interfaces.IMsg
 
+	//  lookup api calls can't walk the process lists either
+	//  This is a copy of what they need from the unsaved process lists, see lookup.go
+	LookupMutex sync.RWMutex
+	LookupLast  int64
+	LookupLists []*LookupProcessList
+
 	DBStateAskCnt     int
 	DBStateReplyCnt   int
 	DBStateIgnoreCnt  int
@@ -322,6 +334,17 @@ type State struct {
 	MessageTally           bool
 	MessageTalliesReceived [constants.NUM_MESSAGES]int
 	MessageTalliesSent     [constants.NUM_MESSAGES]int
+	// MsgTrace, if not nil, traces messages through the node pipeline
+	MsgTrace *MsgTracer
+
+	// Clock, if not nil, is the clock the node runs on, rather than the
+	// primitives clock.  It is set before the node starts.
+	Clock primitives.Clock
+
+	// The nodes of a network share these, nil if they have none
+	EntryValidator interfaces.IEntryValidator // The operator's rules for the content of entries
+	ComposeKeys    interfaces.IKeyStore       // The entry credit keys compose-and-submit-entry pays with
+	SimNetwork     interfaces.ISimNetwork     // Controls the links of a simulated network
 
 	LastPrint    string
 	LastPrintCnt int
@@ -426,6 +449,9 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 
 	newState.ControlPanelPort = s.ControlPanelPort
 	newState.ControlPanelSetting = s.ControlPanelSetting
+	newState.ControlPanelUsers = s.ControlPanelUsers
+	newState.ControlPanelUsersFile = s.ControlPanelUsersFile
+	newState.ControlPanelAuditLog = s.ControlPanelAuditLog
 
 	newState.Identities = s.Identities
 	newState.Authorities = s.Authorities
@@ -435,6 +461,15 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.FaultWait = s.FaultWait
 	newState.EOMfaultIndex = s.EOMfaultIndex
 
+	if s.MsgTrace != nil {
+		newState.MsgTrace = NewMsgTracer(s.MsgTrace.SlowThreshold)
+	}
+
+	newState.Clock = s.Clock
+	newState.EntryValidator = s.EntryValidator
+	newState.ComposeKeys = s.ComposeKeys
+	newState.SimNetwork = s.SimNetwork
+
 	if !config {
 		newState.IdentityChainID = primitives.Sha([]byte(newState.FactomNodeName))
 		//generate and use a new deterministic PrivateKey for this clone
@@ -562,6 +597,19 @@ func (s *State) GetFactomdLocations() string {
 	return s.FactomdLocations
 }
 
+func (s *State) GetDBFinished() bool {
+	return s.DBFinished
+}
+
+// GetNumberConnections returns the number of peers we are connected to, or 0
+// if this node has no network (e.g. a simulated node)
+func (s *State) GetNumberConnections() int {
+	if s.NetworkControler == nil {
+		return 0
+	}
+	return s.NetworkControler.GetNumberConnections()
+}
+
 func (s *State) GetCurrentBlockStartTime() int64 {
 	return s.CurrentBlockStartTime
 }
@@ -575,7 +623,27 @@ func (s *State) GetCurrentMinuteStartTime() int64 {
 }
 
 func (s *State) GetCurrentTime() int64 {
-	return time.Now().UnixNano()
+	return s.GetClock().Now().UnixNano()
+}
+
+// GetClock returns the clock the node runs on
+func (s *State) GetClock() primitives.Clock {
+	if s.Clock == nil {
+		return primitives.GetClock()
+	}
+	return s.Clock
+}
+
+func (s *State) GetEntryValidator() interfaces.IEntryValidator {
+	return s.EntryValidator
+}
+
+func (s *State) GetComposeKeys() interfaces.IKeyStore {
+	return s.ComposeKeys
+}
+
+func (s *State) GetSimNetwork() interfaces.ISimNetwork {
+	return s.SimNetwork
 }
 
 func (s *State) IncDBStateAnswerCnt() {
@@ -628,6 +696,12 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		cfg.App.ExportDataSubpath = cfg.App.HomeDir + networkName + cfg.App.ExportDataSubpath
 		cfg.App.PeersFile = cfg.App.HomeDir + networkName + cfg.App.PeersFile
 		cfg.App.ControlPanelFilesPath = cfg.App.HomeDir + cfg.App.ControlPanelFilesPath
+		if cfg.App.ControlPanelUsersFile != "" && !filepath.IsAbs(cfg.App.ControlPanelUsersFile) {
+			cfg.App.ControlPanelUsersFile = cfg.App.HomeDir + cfg.App.ControlPanelUsersFile
+		}
+		if cfg.App.ControlPanelAuditLog != "" && !filepath.IsAbs(cfg.App.ControlPanelAuditLog) {
+			cfg.App.ControlPanelAuditLog = cfg.App.HomeDir + networkName + cfg.App.ControlPanelAuditLog
+		}
 
 		s.LogPath = cfg.Log.LogPath + s.Prefix
 		s.LdbPath = cfg.App.LdbPath + s.Prefix
@@ -684,6 +758,9 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		default:
 			s.ControlPanelSetting = 1
 		}
+		s.ControlPanelUsers = cfg.App.ControlPanelUser
+		s.ControlPanelUsersFile = cfg.App.ControlPanelUsersFile
+		s.ControlPanelAuditLog = cfg.App.ControlPanelAuditLog
 		s.FERChainId = cfg.App.ExchangeRateChainId
 		s.ExchangeRateAuthorityPublicKey = cfg.App.ExchangeRateAuthorityPublicKey
 		identity, err := primitives.HexToHash(cfg.App.IdentityChainID)
@@ -780,6 +857,7 @@ func (s *State) Init() {
 	}
 
 	s.ControlPanelChannel = make(chan DisplayState, 20)
+	s.MetricsHistory = NewMetricsHistory()
 	s.tickerQueue = make(chan int, 100)                        //ticks from a clock
 	s.timerMsgQueue = make(chan interfaces.IMsg, 100)          //incoming eom notifications, used by leaders
 	s.TimeOffset = new(primitives.Timestamp)                   //interfaces.Timestamp(int64(rand.Int63() % int64(time.Microsecond*10)))
@@ -866,6 +944,7 @@ func (s *State) Init() {
 	if s.ExportData {
 		s.DB.SetExportData(s.ExportDataSubpath)
 	}
+	s.authorityHistory = new(authorityHistory)
 
 	//Network
 	switch s.Network {
@@ -1246,13 +1325,13 @@ func (s *State) LoadHoldingMap() map[[32]byte]interfaces.IMsg {
 func (s *State) fillHoldingMap() {
 	// once a second is often enough to rebuild the Ack list exposed to api
 
-	if s.HoldingLast < time.Now().Unix() {
+	if s.HoldingLast < s.GetClock().Now().Unix() {
 
 		localMap := make(map[[32]byte]interfaces.IMsg)
 		for i, msg := range s.Holding {
 			localMap[i] = msg
 		}
-		s.HoldingLast = time.Now().Unix()
+		s.HoldingLast = s.GetClock().Now().Unix()
 		s.HoldingMutex.Lock()
 		defer s.HoldingMutex.Unlock()
 		s.HoldingMap = localMap
@@ -1275,12 +1354,12 @@ func (s *State) LoadAcksMap() map[[32]byte]interfaces.IMsg {
 //  This is what fills the AcksMap requested in LoadAcksMap
 func (s *State) fillAcksMap() {
 	// once a second is often enough to rebuild the Ack list exposed to api
-	if s.AcksLast < time.Now().Unix() {
+	if s.AcksLast < s.GetClock().Now().Unix() {
 		localMap := make(map[[32]byte]interfaces.IMsg)
 		for i, msg := range s.Acks {
 			localMap[i] = msg
 		}
-		s.AcksLast = time.Now().Unix()
+		s.AcksLast = s.GetClock().Now().Unix()
 		s.AcksMutex.Lock()
 		defer s.AcksMutex.Unlock()
 		s.AcksMap = localMap
@@ -1607,7 +1686,7 @@ func (s *State) IsStalled() bool {
 	stalltime = stalltime * 1.5 * 1e9
 	//fmt.Println("STALL 2", s.CurrentMinuteStartTime/1e9, time.Now().UnixNano()/1e9, stalltime/1e9, (float64(time.Now().UnixNano())-stalltime)/1e9)
 
-	if float64(s.CurrentMinuteStartTime) < float64(time.Now().UnixNano())-stalltime { //-90 seconds was arbitrary
+	if float64(s.CurrentMinuteStartTime) < float64(s.GetClock().Now().UnixNano())-stalltime { //-90 seconds was arbitrary
 		return true
 	}
 
@@ -1731,10 +1810,12 @@ func (s *State) UpdateState() (progress bool) {
 	if s.lasttime.Before(time.Now().Add(-3 * time.Second)) {
 		s.CalculateTransactionRate()
 	}
+	s.MetricsHistory.Sample(s)
 
 	// check to see ig a holding queue list request has been made
 	s.fillHoldingMap()
 	s.fillAcksMap()
+	s.fillLookupLists()
 
 entryHashProcessing:
 	for {
@@ -1937,7 +2018,7 @@ func (s *State) GetTimestamp() interfaces.Timestamp {
 		fmt.Println("^^^^^^^^ IsReplying is true")
 		return s.ReplayTimestamp
 	}
-	return primitives.NewTimestampNow()
+	return primitives.NewTimestampFromMilliseconds(uint64(s.GetClock().Now().UnixNano() / 1e6))
 }
 
 func (s *State) GetTimeOffset() interfaces.Timestamp {
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"strconv"
 	"time"
 
 	"github.com/FactomProject/factomd/common/constants"
@@ -79,9 +80,11 @@ func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 				TotalXReviewQueueInputs.Inc()
 				s.XReview = append(s.XReview, msg)
 			} else {
+				s.MsgTrace.Stamp(msg, TraceExecute)
 				msg.LeaderExecute(s)
 			}
 		} else {
+			s.MsgTrace.Stamp(msg, TraceExecute)
 			msg.FollowerExecute(s)
 		}
 		ret = true
@@ -1497,6 +1500,9 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 		//fmt.Println(fmt.Sprintf("EOM PROCESS: %10s vm %2d Process Once: !e.Processed(%v) EOM: %s", s.FactomNodeName, e.VMIndex, e.Processed, e.String()))
 		vm.LeaderMinute++
 		s.EOMProcessed++
+		if ns, ok := sinceMinuteStart(s); ok {
+			EOMArrivalLatency.WithLabelValues(strconv.Itoa(int(e.Minute))).Observe(ns)
+		}
 		//fmt.Println(fmt.Sprintf("EOM PROCESS: %10s vm %2d EOMProcessed++ (%2d)", s.FactomNodeName, e.VMIndex, s.EOMProcessed))
 		vm.Synced = true
 		markNoFault(pl, msg.GetVMIndex())
@@ -1536,7 +1542,9 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 		}
 
 		s.CurrentMinute++
-		s.CurrentMinuteStartTime = time.Now().UnixNano()
+		now := s.GetClock().Now().UnixNano()
+		s.MetricsHistory.AddMinute(dbheight, int(e.Minute), s.CurrentMinuteStartTime, now)
+		s.CurrentMinuteStartTime = now
 
 		switch {
 		case s.CurrentMinute < 10:
@@ -1778,6 +1786,9 @@ func (s *State) ProcessDBSig(dbheight uint32, msg interfaces.IMsg) bool {
 		s.AddDBSig(dbheight, dbs.ServerIdentityChainID, dbs.DBSignature)
 
 		s.DBSigProcessed++
+		if ns, ok := sinceMinuteStart(s); ok {
+			DBSigArrivalLatency.WithLabelValues(vmLabel(dbs.VMIndex)).Observe(ns)
+		}
 		//fmt.Println(fmt.Sprintf("Process DBSig %10s vm %2v DBSigProcessed++ (%2d)", s.FactomNodeName, dbs.VMIndex, s.DBSigProcessed))
 		vm.Synced = true
 	}
@@ -1960,7 +1971,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 					"server": fullFault.ServerID.String()[4:12], "audit": fullFault.AuditServerID.String()[4:12]}).Info("Full fault success")
 				//s.AddStatus(authorityDeltaString)
 
-				pl.State.LastFaultAction = time.Now().Unix()
+				pl.State.LastFaultAction = pl.State.GetClock().Now().Unix()
 				markNoFault(pl, fullFault.GetVMIndex())
 				nextIndex := (int(fullFault.VMIndex) + 1) % len(pl.FedServers)
 				if pl.VMs[nextIndex].FaultFlag > 0 {
@@ -2031,7 +2042,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 
 		if s.Leader || s.IdentityChainID.IsSameAs(fullFault.AuditServerID) {
 			if !fullFault.GetMyVoteTallied() {
-				now := time.Now().Unix()
+				now := s.GetClock().Now().Unix()
 				if now-fullFault.LastMatch > 5 && int(now-s.LastTiebreak) > s.FaultTimeout/2 {
 					if fullFault.SigTally(s) >= len(pl.FedServers)-1 {
 						s.LastTiebreak = now
@@ -2076,7 +2087,7 @@ func (s *State) SendHeartBeat() {
 		if auditServer.GetChainID().IsSameAs(s.IdentityChainID) {
 			hb := new(messages.Heartbeat)
 			hb.DBHeight = s.LLeaderHeight
-			hb.Timestamp = primitives.NewTimestampNow()
+			hb.Timestamp = s.GetTimestamp()
 			hb.SecretNumber = s.GetSalt(hb.Timestamp)
 			hb.DBlockHash = dbstate.DBHash
 			hb.IdentityChainID = s.IdentityChainID
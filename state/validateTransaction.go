@@ -0,0 +1,189 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// DryRunTransaction runs the checks a factoid transaction goes through on its
+// way into a block, and reports every one of them.  Nothing is submitted, and
+// the transaction is not recorded in the Replay filters.  Balances are checked
+// against FactoidBalancesP, so spends still in the process list are not seen.
+func (s *State) DryRunTransaction(trans interfaces.ITransaction) *interfaces.TransactionValidation {
+	v := new(interfaces.TransactionValidation)
+	v.Type = "factoid"
+	v.TxID = trans.GetSigHash().String()
+
+	v.AddCheck("structure", trans.Validate(1))
+	v.AddCheck("signatures", s.dryRunSignatures(trans))
+
+	if fs, ok := s.FactoidState.(*FactoidState); ok {
+		v.AddCheck("activation", fs.ValidateRCDActivation(trans))
+	}
+
+	v.FactoshisPerEC = s.GetFactoshisPerEC()
+	v.AddCheck("fee", s.dryRunFee(trans, v))
+	v.AddCheck("balances", s.dryRunBalances(trans))
+	v.AddCheck("replay", s.dryRunReplay(trans.GetSigHash(), trans.GetTimestamp()))
+
+	if fs, ok := s.FactoidState.(*FactoidState); ok && fs.GetCurrentBlock() != nil {
+		v.AddCheck("age", fs.ValidateTransactionAge(trans))
+	}
+
+	return v
+}
+
+// DryRunCommit is DryRunTransaction for a CommitEntryMsg or CommitChainMsg.
+// Balances are checked against ECBalancesP.
+func (s *State) DryRunCommit(msg interfaces.IMsg) *interfaces.TransactionValidation {
+	v := new(interfaces.TransactionValidation)
+
+	var ec interfaces.IECBlockEntry
+	var valid bool
+	var credits uint8
+	var ecPubKey *primitives.ByteSlice32
+	var entryHash interfaces.IHash
+	switch m := msg.(type) {
+	case *messages.CommitEntryMsg:
+		v.Type = "commitentry"
+		ec, valid = m.CommitEntry, m.CommitEntry.IsValid()
+		credits, ecPubKey, entryHash = m.CommitEntry.Credits, m.CommitEntry.ECPubKey, m.CommitEntry.GetEntryHash()
+	case *messages.CommitChainMsg:
+		v.Type = "commitchain"
+		ec, valid = m.CommitChain, m.CommitChain.IsValid()
+		credits, ecPubKey, entryHash = m.CommitChain.Credits, m.CommitChain.ECPubKey, m.CommitChain.GetEntryHash()
+	default:
+		v.AddCheck("structure", fmt.Errorf("Not an entry or chain commit"))
+		return v
+	}
+	v.TxID = ec.GetSigHash().String()
+
+	if valid {
+		v.AddCheck("signatures", nil)
+	} else {
+		v.AddCheck("signatures", fmt.Errorf("Bad version, credits out of range, or bad signature"))
+	}
+
+	var err error
+	if bal := s.GetE(false, ecPubKey.Fixed()); int64(credits) > bal {
+		adr := factoid.NewAddress(ecPubKey[:])
+		err = fmt.Errorf("%s holds %d entry credits, the commit needs %d", primitives.ConvertECAddressToUserStr(adr), bal, credits)
+	}
+	v.AddCheck("balances", err)
+
+	v.AddCheck("replay", s.dryRunReplay(ec.GetSigHash(), ec.GetTimestamp()))
+
+	err = nil
+	if !s.IsHighestCommit(entryHash, msg) {
+		err = fmt.Errorf("A commit with equal or greater payment already exists for entry %s", entryHash.String())
+	}
+	v.AddCheck("commit", err)
+
+	return v
+}
+
+// dryRunSignatures is ValidateSignatures, but says which RCD failed
+func (s *State) dryRunSignatures(trans interfaces.ITransaction) error {
+	rcds := trans.GetRCDs()
+	sigBlks := trans.GetSignatureBlocks()
+	if len(sigBlks) < len(rcds) {
+		return fmt.Errorf("%d RCDs but only %d signature blocks", len(rcds), len(sigBlks))
+	}
+	var failed []string
+	for i, rcd := range rcds {
+		if msig, ok := rcd.(interface {
+			CheckSigError(interfaces.ITransaction, interfaces.ISignatureBlock) error
+		}); ok {
+			if err := msig.CheckSigError(trans, sigBlks[i]); err != nil {
+				failed = append(failed, fmt.Sprintf("RCD %d: %v", i, err))
+			}
+		} else if !rcd.CheckSig(trans, sigBlks[i]) {
+			failed = append(failed, fmt.Sprintf("RCD %d: bad signature", i))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Missing %d of %d signatures %v", len(failed), len(rcds), failed)
+	}
+	return nil
+}
+
+// dryRunFee compares what the transaction pays with its fee at the current
+// exchange rate, and fills in the fee fields of v.
+func (s *State) dryRunFee(trans interfaces.ITransaction, v *interfaces.TransactionValidation) error {
+	fee, err := trans.CalculateFee(v.FactoshisPerEC)
+	if err != nil {
+		return err
+	}
+	v.Fee = fee
+
+	tInputs, err := trans.TotalInputs()
+	if err != nil {
+		return err
+	}
+	tOutputs, err := trans.TotalOutputs()
+	if err != nil {
+		return err
+	}
+	tecs, err := trans.TotalECs()
+	if err != nil {
+		return err
+	}
+	if tInputs < tOutputs+tecs {
+		return fmt.Errorf("Inputs of %d do not cover outputs of %d", tInputs, tOutputs+tecs)
+	}
+	v.FeePaid = tInputs - tOutputs - tecs
+
+	if v.FeePaid < fee {
+		return fmt.Errorf("Pays %d factoshis, the fee is %d at %d factoshis per EC", v.FeePaid, fee, v.FactoshisPerEC)
+	}
+	return nil
+}
+
+// dryRunBalances is FactoidState.Validate against the permanent balances,
+// listing every input that is short.
+func (s *State) dryRunBalances(trans interfaces.ITransaction) error {
+	var failed []string
+	var sums = make(map[[32]byte]uint64, 10)
+	for i, input := range trans.GetInputs() {
+		adr := input.GetAddress().Fixed()
+		total, err := factoid.ValidateAmounts(sums[adr], input.GetAmount())
+		if err != nil {
+			return err
+		}
+		sums[adr] = total
+		if bal := s.GetF(false, adr); int64(total) > bal {
+			failed = append(failed, fmt.Sprintf("input %d: %s holds %d, spends %d",
+				i, primitives.ConvertFctAddressToUserStr(input.GetAddress()), bal, total))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Not enough funds in input addresses %v", failed)
+	}
+	return nil
+}
+
+// dryRunReplay checks the timestamp window and the replay filters without
+// marking the hash as seen.
+func (s *State) dryRunReplay(hash interfaces.IHash, timestamp interfaces.Timestamp) error {
+	if !s.FReplay.IsHashUnique(constants.BLOCK_REPLAY, hash.Fixed()) {
+		return fmt.Errorf("Already recorded in a block")
+	}
+	index, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, hash.Fixed(), timestamp, s.GetTimestamp())
+	if ok {
+		return nil
+	}
+	if index < 0 {
+		return fmt.Errorf("Timestamp %s is not within %d minutes of the node's time %s",
+			timestamp.String(), Range, s.GetTimestamp().String())
+	}
+	return fmt.Errorf("Already submitted")
+}
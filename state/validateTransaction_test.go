@@ -0,0 +1,136 @@
+package state_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// dryRunTrans builds a transaction from testHelper key 0 to key 1, paying
+// fee times the minimum fee.
+func dryRunTrans(s interfaces.IState, amt uint64, fee uint64, ts interfaces.Timestamp) interfaces.ITransaction {
+	tx := new(factoid.Transaction)
+	tx.AddInput(testHelper.NewFactoidAddress(0), amt)
+	tx.AddOutput(testHelper.NewFactoidAddress(1), amt)
+	tx.AddAuthorization(testHelper.NewFactoidRCDAddress(0))
+	tx.SetTimestamp(ts)
+
+	minFee, err := tx.CalculateFee(s.GetFactoshisPerEC())
+	if err != nil {
+		panic(err)
+	}
+	in, _ := tx.GetInput(0)
+	in.SetAmount(amt + minFee*fee)
+
+	data, err := tx.MarshalBinarySig()
+	if err != nil {
+		panic(err)
+	}
+	tx.SetSignatureBlock(0, factoid.NewSingleSignatureBlock(testHelper.NewPrivKey(0), data))
+	return tx
+}
+
+func findCheck(t *testing.T, v *interfaces.TransactionValidation, name string) interfaces.Check {
+	c, ok := v.Find(name)
+	if !ok {
+		t.Fatalf("No %s check in %v", name, v.Checks)
+	}
+	return c
+}
+
+func TestDryRunTransaction(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	now := primitives.NewTimestampNow()
+
+	v := s.DryRunTransaction(dryRunTrans(s, 1, 1, now))
+	for _, name := range []string{"structure", "signatures", "fee", "replay"} {
+		if c := findCheck(t, v, name); !c.Passed {
+			t.Errorf("%s failed: %s", name, c.Reason)
+		}
+	}
+	if v.FactoshisPerEC != s.GetFactoshisPerEC() || v.Fee == 0 || v.FeePaid != v.Fee {
+		t.Errorf("Bad fee report %d %d %d", v.FactoshisPerEC, v.Fee, v.FeePaid)
+	}
+
+	// Underpaid
+	v = s.DryRunTransaction(dryRunTrans(s, 1, 0, now))
+	if c := findCheck(t, v, "fee"); c.Passed {
+		t.Errorf("Expected the fee check to fail")
+	}
+	if v.Valid || len(v.Reasons) == 0 {
+		t.Errorf("Expected an invalid transaction with reasons")
+	}
+
+	// Overspent
+	v = s.DryRunTransaction(dryRunTrans(s, 1e18, 1, now))
+	if c := findCheck(t, v, "balances"); c.Passed {
+		t.Errorf("Expected the balance check to fail")
+	}
+
+	// Stale
+	old := primitives.NewTimestampFromMilliseconds(uint64(time.Now().Add(-24*time.Hour).UnixNano() / 1e6))
+	v = s.DryRunTransaction(dryRunTrans(s, 1, 1, old))
+	if c := findCheck(t, v, "replay"); c.Passed {
+		t.Errorf("Expected the replay check to fail")
+	}
+
+	// Bad signature
+	tx := dryRunTrans(s, 1, 1, now)
+	tx.GetInputs()[0].SetAmount(tx.GetInputs()[0].GetAmount() + 1)
+	v = s.DryRunTransaction(tx)
+	if c := findCheck(t, v, "signatures"); c.Passed {
+		t.Errorf("Expected the signature check to fail")
+	}
+
+	// Multisig, before the network's activation height
+	rcd, err := factoid.NewRCD_2(1, 2, []interfaces.IAddress{testHelper.NewFactoidAddress(0), testHelper.NewFactoidAddress(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx = dryRunTrans(s, 1, 1, now)
+	tx.GetRCDs()[0] = rcd
+	v = s.DryRunTransaction(tx)
+	if c := findCheck(t, v, "activation"); c.Passed {
+		t.Errorf("Expected multisig to be inactive on network %x", s.GetNetworkID())
+	}
+}
+
+func TestDryRunCommit(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+
+	commit := entryCreditBlock.NewCommitEntry()
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(time.Now().UnixNano()/1e6))
+	copy(commit.MilliTime[:], ms[2:])
+	commit.EntryHash = primitives.Sha([]byte("dry run"))
+	commit.Credits = 1
+	testHelper.SignCommit(0, commit)
+
+	msg := new(messages.CommitEntryMsg)
+	msg.CommitEntry = commit
+
+	v := s.DryRunCommit(msg)
+	if v.Type != "commitentry" || v.TxID != commit.GetSigHash().String() {
+		t.Errorf("Bad commit report %s %s", v.Type, v.TxID)
+	}
+	for _, name := range []string{"signatures", "replay", "commit"} {
+		if c := findCheck(t, v, name); !c.Passed {
+			t.Errorf("%s failed: %s", name, c.Reason)
+		}
+	}
+
+	// Too many credits for an entry commit
+	commit.Credits = 11
+	testHelper.SignCommit(0, commit)
+	v = s.DryRunCommit(msg)
+	if c := findCheck(t, v, "signatures"); c.Passed {
+		t.Errorf("Expected the signature check to fail")
+	}
+}
@@ -57,12 +57,13 @@ func (state *State) ValidatorLoop() {
 
 				msg = state.InMsgQueue().Dequeue()
 				if msg != nil {
+					state.MsgTrace.Stamp(msg, TraceInMsgQueue)
 					state.JournalMessage(msg)
 					break loop
 				} else {
 					// No messages? Sleep for a bit
 					for i := 0; i < 10 && state.InMsgQueue().Length() == 0; i++ {
-						time.Sleep(10 * time.Millisecond)
+						state.GetClock().Sleep(10 * time.Millisecond)
 					}
 				}
 			}
@@ -0,0 +1,191 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package testHarness runs networks of factomd nodes in the test process, on
+// map databases and linked by SimPeers, for tests that need more than one
+// node.  A network holds its own clock, links, entry rules and keys, so
+// tests may run many at once.  Only the prometheus metrics are shared, and
+// count the nodes of every network, as they count every simulated node:
+//
+//	net, err := testHarness.Start(testHarness.Config{Nodes: 3})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer net.Stop()
+//	err = net.WaitForHeight(2, time.Minute)
+package testHarness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/engine"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// Config is the shape of a test network
+type Config struct {
+	Nodes        int    // Nodes in the network, the first is its leader
+	BlockTime    int    // Seconds per directory block, DefaultBlockTime if 0, at least MinBlockTime
+	FaultTimeout int    // Seconds before a leader is faulted, DefaultFaultTimeout if 0
+	StartDelay   int    // Seconds a node waits to be sure it is in sync before it may lead
+	Net          string // How the nodes are linked, "mesh" (the default) or "long"
+
+	Clock primitives.Clock // The clock the nodes run on, the wall clock if nil
+}
+
+var DefaultBlockTime int = 10
+var DefaultFaultTimeout int = 60
+
+// MinBlockTime is the shortest block time a network may run.  A minute of a
+// shorter block ends before the leader has finished the last, so it is always
+// syncing the end of minute, and transactions wait in holding.
+var MinBlockTime int = 10
+
+// Network is a running test network
+type Network struct {
+	Nodes []*Node
+	ECKey []byte // The entry credit private key entries are paid with
+
+	fnodes []*engine.FactomNode
+}
+
+// Start builds a network of nodes on a LOCAL network, links them, and starts
+// them.  Node 0 leads, the others follow.
+func Start(cfg Config) (*Network, error) {
+	if cfg.Nodes < 1 {
+		return nil, fmt.Errorf("A network needs at least one node, not %d", cfg.Nodes)
+	}
+	if cfg.BlockTime == 0 {
+		cfg.BlockTime = DefaultBlockTime
+	}
+	if cfg.FaultTimeout == 0 {
+		cfg.FaultTimeout = DefaultFaultTimeout
+	}
+	if cfg.BlockTime < 0 || cfg.FaultTimeout < 0 || cfg.StartDelay < 0 {
+		return nil, fmt.Errorf("Negative times")
+	}
+	if cfg.BlockTime < MinBlockTime {
+		return nil, fmt.Errorf("A block time of %d seconds is too short for a minute to finish, use at least %d", cfg.BlockTime, MinBlockTime)
+	}
+	if cfg.Net == "" {
+		cfg.Net = "mesh"
+	}
+	if cfg.Net != "mesh" && cfg.Net != "long" {
+		return nil, fmt.Errorf("Unknown net %q, use mesh or long", cfg.Net)
+	}
+
+	n := new(Network)
+	n.ECKey = testHelper.NewPrivKey(1)
+
+	// As NetStart does, the first state is set up, and the others cloned
+	s0 := new(state.State)
+	s0.Clock = cfg.Clock
+	if s0.Clock == nil {
+		s0.Clock = primitives.WallClock
+	}
+	s0.SetLeaderTimestamp(primitives.NewTimestampFromMilliseconds(0))
+	s0.LoadConfig("", "")
+	s0.Network = "LOCAL"
+	s0.LogPath = "stdout"
+	s0.DBType = "Map"
+	s0.CloneDBType = "Map"
+	s0.DirectoryBlockInSeconds = cfg.BlockTime
+	s0.FaultTimeout = cfg.FaultTimeout
+	s0.StartDelayLimit = int64(cfg.StartDelay) * 1000
+	s0.SetOut(false)
+	s0.Init()
+	engine.SetupFirstAuthority(s0)
+
+	mLog := new(engine.MsgLog)
+	mLog.Init(false, cfg.Nodes)
+	for i := 0; i < cfg.Nodes; i++ {
+		s := s0
+		if i > 0 {
+			s = s0.Clone(i).(*state.State)
+			s.Init()
+		}
+		s.IntiateNetworkSkeletonIdentity()
+
+		fnode := &engine.FactomNode{Index: i, State: s, MLog: mLog}
+		n.fnodes = append(n.fnodes, fnode)
+		n.Nodes = append(n.Nodes, &Node{Index: i, State: s, FNode: fnode, network: n})
+	}
+
+	network := engine.NewSimNetwork(n.fnodes)
+	for _, fnode := range n.fnodes {
+		fnode.State.SimNetwork = network
+	}
+	for i := 1; i < cfg.Nodes; i++ {
+		if cfg.Net == "long" {
+			engine.AddSimPeer(n.fnodes, i-1, i)
+			continue
+		}
+		for j := 0; j < i; j++ {
+			engine.AddSimPeer(n.fnodes, j, i)
+		}
+	}
+
+	for _, fnode := range n.fnodes {
+		engine.StartNode(fnode, true)
+	}
+	return n, nil
+}
+
+// Stop stops every node of the network
+func (n *Network) Stop() {
+	for _, node := range n.Nodes {
+		node.Stop()
+	}
+}
+
+// Leader returns the node that leads when the network starts
+func (n *Network) Leader() *Node {
+	return n.Nodes[0]
+}
+
+// Link returns the link from one node to another, to inject faults on, or
+// nil if they are not linked
+func (n *Network) Link(from, to int) *engine.SimPeer {
+	if from < 0 || to < 0 || from >= len(n.fnodes) || to >= len(n.fnodes) {
+		return nil
+	}
+	for _, p := range n.fnodes[from].Peers {
+		peer, ok := p.(*engine.SimPeer)
+		if ok && peer.ToName == n.fnodes[to].State.FactomNodeName {
+			return peer
+		}
+	}
+	return nil
+}
+
+// WaitForHeight waits until every running node has saved the block at
+// height
+func (n *Network) WaitForHeight(height uint32, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for _, node := range n.Nodes {
+		if !node.State.Running() {
+			continue
+		}
+		if err := node.WaitForHeight(height, deadline.Sub(time.Now())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitFor polls done until it is true, or the timeout passes.  Timeouts are
+// on the wall clock.
+func waitFor(timeout time.Duration, done func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for !done() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package testHarness_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/testHarness"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestStartErrors(t *testing.T) {
+	for _, cfg := range []Config{
+		{},
+		{Nodes: 2, BlockTime: -1},
+		{Nodes: 2, BlockTime: 4},
+		{Nodes: 2, Net: "square"},
+	} {
+		if _, err := Start(cfg); err == nil {
+			t.Errorf("Expected an error starting %+v", cfg)
+		}
+	}
+}
+
+func TestNetworksInParallel(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		i := i
+		t.Run(fmt.Sprintf("network %d", i), func(t *testing.T) {
+			t.Parallel()
+			runNetwork(t, i)
+		})
+	}
+}
+
+func runNetwork(t *testing.T, i int) {
+	net, err := Start(Config{Nodes: 3, BlockTime: 10, StartDelay: 1})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer net.Stop()
+
+	if net.Link(0, 1) == nil || net.Link(1, 0) == nil {
+		t.Errorf("Expected the nodes to be linked")
+	}
+	// Only the links of this network, whatever else is running
+	if links := net.Leader().State.GetSimNetwork().GetLinkFaults(); len(links) != 6 {
+		t.Errorf("Expected the 6 links of the network, got %d", len(links))
+	}
+	if err := net.WaitForHeight(1, time.Minute); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Each transaction is confirmed everywhere before the next is submitted
+	leader := net.Leader()
+	to := testHelper.NewFactoidAddress(uint64(i + 1))
+	for _, submit := range []func() (string, error){
+		func() (string, error) { return leader.BuyEntryCredits(100) },
+		func() (string, error) { return leader.SendFactoids(to, 1e8) },
+	} {
+		txid, err := submit()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		for _, node := range net.Nodes {
+			if err := node.WaitForTransaction(txid, time.Minute); err != nil {
+				t.Fatalf("%v", err)
+			}
+		}
+	}
+	for _, node := range net.Nodes {
+		if node.ECBalance() < 100 || node.FactoidBalance(to) < 1e8 {
+			t.Fatalf("%s has %d credits and %d factoshis", node.State.FactomNodeName, node.ECBalance(), node.FactoidBalance(to))
+		}
+	}
+
+	first := entryBlock.NewEntry()
+	first.ExtIDs = []primitives.ByteSlice{{Bytes: []byte("harness")}, {Bytes: []byte(fmt.Sprint(i))}}
+	chainID, err := net.Nodes[1].SubmitChain(first)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := net.Nodes[2].WaitForEntry(first.GetHash(), time.Minute); err != nil {
+		t.Fatalf("%v", err)
+	}
+	entry := entryBlock.NewEntry()
+	entry.ChainID = chainID
+	entry.Content = primitives.ByteSlice{Bytes: []byte("an entry")}
+	if err := net.Nodes[2].SubmitEntry(entry); err != nil {
+		t.Fatalf("%v", err)
+	}
+	for _, node := range net.Nodes {
+		if err := node.WaitForEntry(entry.GetHash(), time.Minute); err != nil {
+			t.Errorf("%v", err)
+		}
+	}
+
+	net.Stop()
+	for _, node := range net.Nodes {
+		if node.State.Running() {
+			t.Errorf("%s is still running", node.State.FactomNodeName)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package testHarness
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/engine"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// Node is a node of a test network.  Entries and transactions are submitted
+// to it as the API would submit them.
+type Node struct {
+	Index int
+	State *state.State
+	FNode *engine.FactomNode
+
+	network *Network
+}
+
+// Stop shuts the node down.  Its links stay, but it neither sends nor
+// recieves.
+func (n *Node) Stop() {
+	engine.StopNode(n.FNode)
+}
+
+// Height returns the height of the highest block the node has saved
+func (n *Node) Height() uint32 {
+	return n.State.GetHighestSavedBlk()
+}
+
+// WaitForHeight waits until the node has saved the block at height
+func (n *Node) WaitForHeight(height uint32, timeout time.Duration) error {
+	if !waitFor(timeout, func() bool { return n.Height() >= height }) {
+		return fmt.Errorf("%s is at height %d, not %d, after %v", n.State.FactomNodeName, n.Height(), height, timeout)
+	}
+	return nil
+}
+
+// WaitForSync waits until the node is not syncing the end of a minute or a
+// block, when the leader takes a message as a follower and holds it
+func (n *Node) WaitForSync(timeout time.Duration) error {
+	if !waitFor(timeout, func() bool { return !n.State.Syncing }) {
+		return fmt.Errorf("%s is still syncing after %v", n.State.FactomNodeName, timeout)
+	}
+	return nil
+}
+
+// WaitForEntry waits until the entry is in a block saved by the node
+func (n *Node) WaitForEntry(entryHash interfaces.IHash, timeout time.Duration) error {
+	var r *interfaces.LookupResult
+	confirmed := func() bool {
+		r = n.State.LookupEntry(entryHash)
+		return r.Status == interfaces.LookupConfirmed
+	}
+	if !waitFor(timeout, confirmed) {
+		return fmt.Errorf("Entry %s is %s on %s after %v", entryHash.String(), r.Status, n.State.FactomNodeName, timeout)
+	}
+	return nil
+}
+
+// WaitForTransaction waits until the factoid transaction is in a block saved
+// by the node
+func (n *Node) WaitForTransaction(txid string, timeout time.Duration) error {
+	hash, err := primitives.HexToHash(txid)
+	if err != nil {
+		return err
+	}
+	status := constants.AckStatusUnknown
+	confirmed := func() bool {
+		status, _, _, _, _ = n.State.GetACKStatus(hash)
+		return status == constants.AckStatusDBlockConfirmed
+	}
+	if !waitFor(timeout, confirmed) {
+		return fmt.Errorf("Transaction %s is %s on %s after %v", txid, constants.AckStatusString(status), n.State.FactomNodeName, timeout)
+	}
+	return nil
+}
+
+// SubmitChain submits a new chain, paying with the network's entry credit
+// key.  The chain ID of its first entry is set from its external IDs.
+func (n *Node) SubmitChain(entry *entryBlock.Entry) (interfaces.IHash, error) {
+	entry.ChainID = entryBlock.NewChainID(entry)
+	if err := engine.SubmitEntry(n.State, entry, n.network.ECKey, true); err != nil {
+		return nil, err
+	}
+	return entry.ChainID, nil
+}
+
+// SubmitEntry submits an entry into an existing chain, paying with the
+// network's entry credit key
+func (n *Node) SubmitEntry(entry *entryBlock.Entry) error {
+	return engine.SubmitEntry(n.State, entry, n.network.ECKey, false)
+}
+
+// SubmitTransaction submits a signed factoid transaction, and returns its ID
+func (n *Node) SubmitTransaction(trans interfaces.ITransaction) (string, error) {
+	data, err := trans.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	resp, jErr := wsapi.HandleV2FactoidSubmit(n.State, wsapi.TransactionRequest{Transaction: hex.EncodeToString(data)})
+	if jErr != nil {
+		return "", fmt.Errorf("%s %v", jErr.Message, jErr.Data)
+	}
+	return resp.(*wsapi.FactoidSubmitResponse).TxID, nil
+}
+
+// BuyEntryCredits buys credits for the network's entry credit key with the
+// factoids of the genesis block, and returns the transaction ID
+func (n *Node) BuyEntryCredits(credits uint64) (string, error) {
+	pub, err := primitives.PrivateKeyToPublicKey(n.network.ECKey)
+	if err != nil {
+		return "", err
+	}
+	amount := credits * n.State.GetFactoshisPerEC()
+	trans := new(factoid.Transaction)
+	trans.AddECOutput(factoid.NewAddress(pub), amount)
+	return n.submitFromFaucet(trans, amount)
+}
+
+// SendFactoids sends factoshis to an address from the factoids of the
+// genesis block, and returns the transaction ID
+func (n *Node) SendFactoids(to interfaces.IAddress, amount uint64) (string, error) {
+	trans := new(factoid.Transaction)
+	trans.AddOutput(to, amount)
+	return n.submitFromFaucet(trans, amount)
+}
+
+// ECBalance returns the credits of the network's entry credit key, as the
+// node has them
+func (n *Node) ECBalance() int64 {
+	pub, err := primitives.PrivateKeyToPublicKey(n.network.ECKey)
+	if err != nil {
+		return 0
+	}
+	return n.State.GetFactoidState().GetECBalance(factoid.NewAddress(pub).Fixed())
+}
+
+// FactoidBalance returns the factoshis of an address, as the node has them
+func (n *Node) FactoidBalance(address interfaces.IAddress) int64 {
+	return n.State.GetFactoidState().GetFactoidBalance(address.Fixed())
+}
+
+// submitFromFaucet pays for trans from the factoids of the genesis block, as
+// the simulator pays for its load, and returns its ID.  It is submitted once
+// the leader is out of sync, so the leader acknowledges it.
+func (n *Node) submitFromFaucet(trans *factoid.Transaction, amount uint64) (string, error) {
+	if err := n.network.Leader().WaitForSync(time.Minute); err != nil {
+		return "", err
+	}
+	txid, err := engine.SubmitFaucetTransaction(n.State, trans, amount)
+	if err != nil {
+		return "", err
+	}
+	return txid.String(), nil
+}
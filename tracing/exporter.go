@@ -0,0 +1,173 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tracing
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Exporter ships finished spans somewhere.  Export is called from a single
+// background goroutine with batches of spans.
+type Exporter interface {
+	Export(spans []*Span) error
+	Shutdown() error
+}
+
+const (
+	MaxQueue      = 4096
+	MaxBatch      = 512
+	FlushInterval = 5 * time.Second
+)
+
+var (
+	mutex    sync.Mutex
+	exporter Exporter
+	queue    []*Span
+	dropped  uint64
+	flushReq chan chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+
+	// enabled is 1 while an exporter is installed, so Enabled needs no lock
+	enabled int32
+)
+
+// Enabled returns true if an exporter has been installed
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// SetExporter installs e as the destination for finished spans, shutting
+// down any previous exporter.  A nil e disables tracing.
+func SetExporter(e Exporter) {
+	Shutdown()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if e == nil {
+		return
+	}
+	exporter = e
+	queue = nil
+	flushReq = make(chan chan struct{})
+	stop = make(chan struct{})
+	done = make(chan struct{})
+	atomic.StoreInt32(&enabled, 1)
+	go run(e, flushReq, stop, done)
+}
+
+// Setup builds an exporter by name ("otlp" or "file") and installs it.  An
+// empty name disables tracing.
+func Setup(name, endpoint, service string) error {
+	var e Exporter
+	var err error
+	switch name {
+	case "":
+	case "otlp":
+		e = NewOTLPExporter(endpoint, service)
+	case "file":
+		e, err = NewFileExporter(endpoint, service)
+	default:
+		err = fmt.Errorf("unknown tracing exporter %q", name)
+	}
+	if err != nil {
+		return err
+	}
+	SetExporter(e)
+	return nil
+}
+
+// Flush blocks until every span ended so far has been handed to the exporter
+func Flush() {
+	mutex.Lock()
+	req, d := flushReq, done
+	mutex.Unlock()
+	if req == nil {
+		return
+	}
+	ack := make(chan struct{})
+	select {
+	case req <- ack:
+		<-ack
+	case <-d:
+	}
+}
+
+// Shutdown flushes outstanding spans and stops the current exporter
+func Shutdown() {
+	mutex.Lock()
+	e, s, d := exporter, stop, done
+	exporter, flushReq, stop, done = nil, nil, nil, nil
+	atomic.StoreInt32(&enabled, 0)
+	mutex.Unlock()
+	if e == nil {
+		return
+	}
+	close(s)
+	<-d
+	e.Shutdown()
+}
+
+// Dropped returns the number of spans discarded because the queue was full
+func Dropped() uint64 {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return dropped
+}
+
+func enqueue(s *Span) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if exporter == nil {
+		return
+	}
+	if len(queue) >= MaxQueue {
+		dropped++
+		return
+	}
+	queue = append(queue, s)
+}
+
+func take() []*Span {
+	mutex.Lock()
+	defer mutex.Unlock()
+	q := queue
+	queue = nil
+	return q
+}
+
+func export(e Exporter, spans []*Span) {
+	for len(spans) > 0 {
+		n := len(spans)
+		if n > MaxBatch {
+			n = MaxBatch
+		}
+		if err := e.Export(spans[:n]); err != nil {
+			fmt.Println("Tracing export failed:", err)
+		}
+		spans = spans[n:]
+	}
+}
+
+func run(e Exporter, flushReq chan chan struct{}, stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			export(e, take())
+		case ack := <-flushReq:
+			export(e, take())
+			close(ack)
+		case <-stop:
+			export(e, take())
+			return
+		}
+	}
+}
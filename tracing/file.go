@@ -0,0 +1,52 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tracing
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// FileExporter appends each batch of spans to a file as one OTLP JSON
+// document per line, suitable for replay into a collector.
+type FileExporter struct {
+	mutex   sync.Mutex
+	Service string
+	file    *os.File
+}
+
+var _ Exporter = (*FileExporter)(nil)
+
+func NewFileExporter(path, service string) (*FileExporter, error) {
+	if path == "" {
+		path = "spans.json"
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	e := new(FileExporter)
+	e.Service = service
+	e.file = f
+	return e, nil
+}
+
+func (e *FileExporter) Export(spans []*Span) error {
+	data, err := json.Marshal(NewOTLPRequest(e.Service, spans))
+	if err != nil {
+		return err
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	_, err = e.file.Write(append(data, '\n'))
+	return err
+}
+
+func (e *FileExporter) Shutdown() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.file.Close()
+}
@@ -0,0 +1,168 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The OTLP/JSON encoding of an ExportTraceServiceRequest.  Only the fields
+// we populate are declared.
+
+type OTLPRequest struct {
+	ResourceSpans []OTLPResourceSpans `json:"resourceSpans"`
+}
+
+type OTLPResourceSpans struct {
+	Resource   OTLPResource     `json:"resource"`
+	ScopeSpans []OTLPScopeSpans `json:"scopeSpans"`
+}
+
+type OTLPResource struct {
+	Attributes []OTLPKeyValue `json:"attributes"`
+}
+
+type OTLPScopeSpans struct {
+	Scope OTLPScope  `json:"scope"`
+	Spans []OTLPSpan `json:"spans"`
+}
+
+type OTLPScope struct {
+	Name string `json:"name"`
+}
+
+type OTLPSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []OTLPKeyValue `json:"attributes,omitempty"`
+	Status            OTLPStatus     `json:"status"`
+}
+
+type OTLPKeyValue struct {
+	Key   string    `json:"key"`
+	Value OTLPValue `json:"value"`
+}
+
+type OTLPValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type OTLPStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpKindInternal = 1
+	otlpStatusOk     = 1
+	otlpStatusError  = 2
+)
+
+// NewOTLPRequest converts spans to the OTLP JSON trace payload
+func NewOTLPRequest(service string, spans []*Span) *OTLPRequest {
+	ss := OTLPScopeSpans{Scope: OTLPScope{Name: "factomd"}}
+	for _, s := range spans {
+		ss.Spans = append(ss.Spans, toOTLPSpan(s))
+	}
+	rs := OTLPResourceSpans{
+		Resource:   OTLPResource{Attributes: []OTLPKeyValue{{Key: "service.name", Value: OTLPValue{service}}}},
+		ScopeSpans: []OTLPScopeSpans{ss},
+	}
+	return &OTLPRequest{ResourceSpans: []OTLPResourceSpans{rs}}
+}
+
+func toOTLPSpan(s *Span) OTLPSpan {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	o := OTLPSpan{
+		TraceID:           s.Context.TraceID.String(),
+		SpanID:            s.Context.SpanID.String(),
+		Name:              s.Name,
+		Kind:              otlpKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+		Status:            OTLPStatus{Code: otlpStatusOk},
+	}
+	if !s.Parent.IsZero() {
+		o.ParentSpanID = s.Parent.String()
+	}
+	keys := make([]string, 0, len(s.Attributes))
+	for k := range s.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		o.Attributes = append(o.Attributes, OTLPKeyValue{Key: k, Value: OTLPValue{s.Attributes[k]}})
+	}
+	if s.Err != "" {
+		o.Status = OTLPStatus{Code: otlpStatusError, Message: s.Err}
+	}
+	return o
+}
+
+// OTLPExporter posts spans to an OpenTelemetry collector using OTLP over
+// HTTP with JSON encoding.
+type OTLPExporter struct {
+	Endpoint string
+	Service  string
+	Client   *http.Client
+}
+
+var _ Exporter = (*OTLPExporter)(nil)
+
+// NewOTLPExporter returns an exporter for the collector at endpoint.  A bare
+// host:port is expanded to http://host:port/v1/traces.
+func NewOTLPExporter(endpoint, service string) *OTLPExporter {
+	if endpoint == "" {
+		endpoint = "localhost:4318"
+	}
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "http://" + endpoint
+	}
+	if strings.Count(endpoint, "/") == 2 {
+		endpoint += "/v1/traces"
+	}
+	e := new(OTLPExporter)
+	e.Endpoint = endpoint
+	e.Service = service
+	e.Client = &http.Client{Timeout: 10 * time.Second}
+	return e
+}
+
+func (e *OTLPExporter) Export(spans []*Span) error {
+	data, err := json.Marshal(NewOTLPRequest(e.Service, spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.Client.Post(e.Endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector at %s returned %s", e.Endpoint, resp.Status)
+	}
+	return nil
+}
+
+func (e *OTLPExporter) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package tracing records OpenTelemetry compatible spans for API requests,
+// database calls and block processing, and hands them to a pluggable
+// Exporter.  When no exporter is configured, Start returns nil and every
+// Span method is a no-op, so instrumented code pays only an atomic load and
+// a nil check.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type TraceID [16]byte
+type SpanID [8]byte
+
+func (t TraceID) String() string { return hex.EncodeToString(t[:]) }
+func (s SpanID) String() string  { return hex.EncodeToString(s[:]) }
+
+func (t TraceID) IsZero() bool { return t == TraceID{} }
+func (s SpanID) IsZero() bool  { return s == SpanID{} }
+
+// SpanContext identifies a span across process boundaries.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+}
+
+// ParseTraceParent decodes a W3C traceparent header
+// (version-traceid-spanid-flags).  It returns nil if the header is missing
+// or malformed.
+func ParseTraceParent(header string) *SpanContext {
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return nil
+	}
+	sc := new(SpanContext)
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return nil
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return nil
+	}
+	if sc.TraceID.IsZero() || sc.SpanID.IsZero() {
+		return nil
+	}
+	return sc
+}
+
+// TraceParent encodes the context as a W3C traceparent header
+func (sc *SpanContext) TraceParent() string {
+	return fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID)
+}
+
+type Span struct {
+	mutex sync.Mutex
+
+	Name       string
+	Context    SpanContext
+	Parent     SpanID
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes map[string]string
+	Err        string
+
+	ended bool
+}
+
+// Start begins a new root span, or returns nil if tracing is disabled.
+func Start(name string) *Span {
+	return StartWithParent(name, nil)
+}
+
+// StartWithParent begins a span that continues the trace of a remote parent.
+// A nil parent starts a new trace.
+func StartWithParent(name string, parent *SpanContext) *Span {
+	if !Enabled() {
+		return nil
+	}
+	s := new(Span)
+	s.Name = name
+	s.StartTime = time.Now()
+	if parent != nil {
+		s.Context.TraceID = parent.TraceID
+		s.Parent = parent.SpanID
+	} else {
+		rand.Read(s.Context.TraceID[:])
+	}
+	rand.Read(s.Context.SpanID[:])
+	return s
+}
+
+// Child begins a span in the same trace as s.  A nil s yields a nil child.
+func (s *Span) Child(name string) *Span {
+	if s == nil || !Enabled() {
+		return nil
+	}
+	c := new(Span)
+	c.Name = name
+	c.StartTime = time.Now()
+	c.Context.TraceID = s.Context.TraceID
+	c.Parent = s.Context.SpanID
+	rand.Read(c.Context.SpanID[:])
+	return c
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]string)
+	}
+	s.Attributes[key] = fmt.Sprint(value)
+}
+
+// SetError marks the span as failed.  A nil error is ignored.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.Err = err.Error()
+}
+
+// End stamps the end time and queues the span for export.  Only the first
+// call has any effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	s.EndTime = time.Now()
+	s.mutex.Unlock()
+	enqueue(s)
+}
+
+func (s *Span) Duration() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return s.EndTime.Sub(s.StartTime)
+}
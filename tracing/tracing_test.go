@@ -0,0 +1,207 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tracing_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	. "github.com/FactomProject/factomd/tracing"
+)
+
+type memExporter struct {
+	mutex sync.Mutex
+	spans []*Span
+	shut  bool
+}
+
+func (m *memExporter) Export(spans []*Span) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func (m *memExporter) Shutdown() error {
+	m.shut = true
+	return nil
+}
+
+func TestDisabled(t *testing.T) {
+	SetExporter(nil)
+	span := Start("nothing")
+	if span != nil {
+		t.Errorf("Expected a nil span when tracing is disabled")
+	}
+	// All of these must be safe on a nil span
+	span.SetAttribute("a", 1)
+	span.SetError(errors.New("x"))
+	span.Child("child").End()
+	span.End()
+	Flush()
+}
+
+func TestSpans(t *testing.T) {
+	m := new(memExporter)
+	SetExporter(m)
+	defer SetExporter(nil)
+
+	root := Start("root")
+	root.SetAttribute("dbheight", 10)
+	child := root.Child("child")
+	child.SetError(errors.New("failed"))
+	child.End()
+	root.End()
+	root.End()
+
+	Flush()
+	if len(m.spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(m.spans))
+	}
+	c, r := m.spans[0], m.spans[1]
+	if c.Context.TraceID != r.Context.TraceID {
+		t.Errorf("Child is not in the parent's trace")
+	}
+	if c.Parent != r.Context.SpanID {
+		t.Errorf("Child parent is %s, expected %s", c.Parent, r.Context.SpanID)
+	}
+	if !r.Parent.IsZero() {
+		t.Errorf("Root span has a parent")
+	}
+	if r.Attributes["dbheight"] != "10" {
+		t.Errorf("Attribute not recorded: %v", r.Attributes)
+	}
+	if c.Err != "failed" {
+		t.Errorf("Error not recorded")
+	}
+
+	SetExporter(nil)
+	if !m.shut {
+		t.Errorf("Exporter was not shut down")
+	}
+}
+
+func TestTraceParent(t *testing.T) {
+	header := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc := ParseTraceParent(header)
+	if sc == nil {
+		t.Fatalf("Failed to parse %s", header)
+	}
+	if sc.TraceParent() != header {
+		t.Errorf("Round trip gave %s", sc.TraceParent())
+	}
+
+	bad := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e473z-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+	}
+	for _, b := range bad {
+		if ParseTraceParent(b) != nil {
+			t.Errorf("Parsed bad header %q", b)
+		}
+	}
+
+	m := new(memExporter)
+	SetExporter(m)
+	defer SetExporter(nil)
+	StartWithParent("remote", sc).End()
+	Flush()
+	if len(m.spans) != 1 || m.spans[0].Context.TraceID != sc.TraceID || m.spans[0].Parent != sc.SpanID {
+		t.Errorf("Span did not join the remote trace")
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var got OTLPRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+	}))
+	defer srv.Close()
+
+	if err := Setup("otlp", srv.URL, "fnode0"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetExporter(nil)
+
+	span := Start("wsapi.v2.heights")
+	span.SetAttribute("rpc.method", "heights")
+	span.End()
+	Flush()
+
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("Bad payload: %+v", got)
+	}
+	if got.ResourceSpans[0].Resource.Attributes[0].Value.StringValue != "fnode0" {
+		t.Errorf("Service name not exported")
+	}
+	spans := got.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 1 || spans[0].Name != "wsapi.v2.heights" || spans[0].TraceID != span.Context.TraceID.String() {
+		t.Errorf("Bad spans: %+v", spans)
+	}
+	if len(spans[0].Attributes) != 1 || spans[0].Attributes[0].Value.StringValue != "heights" {
+		t.Errorf("Bad attributes: %+v", spans[0].Attributes)
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "spans.json")
+
+	if err := Setup("file", path, "fnode0"); err != nil {
+		t.Fatal(err)
+	}
+	Start("one").End()
+	Flush()
+	Start("two").End()
+	SetExporter(nil)
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		req := new(OTLPRequest)
+		if err := json.Unmarshal(scanner.Bytes(), req); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range req.ResourceSpans[0].ScopeSpans[0].Spans {
+			names = append(names, s.Name)
+		}
+	}
+	if len(names) != 2 || names[0] != "one" || names[1] != "two" {
+		t.Errorf("Expected spans one and two, got %v", names)
+	}
+}
+
+func TestSetupUnknown(t *testing.T) {
+	if err := Setup("zipkin", "", ""); err == nil {
+		t.Errorf("Expected an error for an unknown exporter")
+	}
+	if Enabled() {
+		t.Errorf("Tracing should be disabled")
+	}
+}
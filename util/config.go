@@ -21,6 +21,9 @@ type FactomdConfig struct {
 		ControlPanelPort                       int
 		ControlPanelFilesPath                  string
 		ControlPanelSetting                    string
+		ControlPanelUser                       []string
+		ControlPanelUsersFile                  string
+		ControlPanelAuditLog                   string
 		DBType                                 string
 		LdbPath                                string
 		BoltDBPath                             string
@@ -108,6 +111,10 @@ HomeDir                               = ""
 ; --------------- ControlPanel disabled | readonly | readwrite
 ControlPanelSetting                   = readonly
 ControlPanelPort                      = 8090
+; --------------- ControlPanel accounts: name:viewer|operator|admin:hash, hash from "factomd -controlpanelhash"
+; ControlPanelUser                    = "noc:viewer:scrypt$..."
+ControlPanelUsersFile                 = ""
+ControlPanelAuditLog                  = "controlpanel-audit.log"
 ; --------------- DBType: LDB | Bolt | Map
 DBType                                = "LDB"
 LdbPath                               = "database/ldb"
@@ -201,6 +208,9 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    ControlPanelPort        %v", s.App.ControlPanelPort))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelFilesPath   %v", s.App.ControlPanelFilesPath))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelSetting     %v", s.App.ControlPanelSetting))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelUser        %v", len(s.App.ControlPanelUser)))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelUsersFile   %v", s.App.ControlPanelUsersFile))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelAuditLog    %v", s.App.ControlPanelAuditLog))
 	out.WriteString(fmt.Sprintf("\n    DBType                  %v", s.App.DBType))
 	out.WriteString(fmt.Sprintf("\n    LdbPath                 %v", s.App.LdbPath))
 	out.WriteString(fmt.Sprintf("\n    BoltDBPath              %v", s.App.BoltDBPath))
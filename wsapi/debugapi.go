@@ -108,6 +108,9 @@ func HandleDebugRequest(
 	case "messages":
 		resp, jsonError = HandleMessages(state, params)
 		break
+	case "message-traces":
+		resp, jsonError = HandleMessageTraces(state, params)
+		break
 	case "network-info":
 		resp, jsonError = HandleNetworkInfo(state, params)
 		break
@@ -123,6 +126,27 @@ func HandleDebugRequest(
 	case "reload-configuration":
 		resp, jsonError = HandleReloadConfig(state, params)
 		break
+	case "link-faults":
+		resp, jsonError = HandleLinkFaults(state, params)
+		break
+	case "set-link-faults":
+		resp, jsonError = HandleSetLinkFaults(state, params)
+		break
+	case "partition":
+		resp, jsonError = HandlePartition(state, params)
+		break
+	case "heal-links":
+		resp, jsonError = HandleHealLinks(state, params)
+		break
+	case "start-load":
+		resp, jsonError = HandleStartLoad(state, params)
+		break
+	case "stop-load":
+		resp, jsonError = HandleStopLoad(state, params)
+		break
+	case "load-report":
+		resp, jsonError = HandleLoadReport(state, params)
+		break
 	default:
 		jsonError = NewMethodNotFoundError()
 		break
@@ -327,6 +351,21 @@ func HandleMessages(
 	return r, nil
 }
 
+func HandleMessageTraces(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		Traces []*interfaces.MsgTrace
+	}
+	r := new(ret)
+	r.Traces = state.GetSlowMsgTraces()
+	return r, nil
+}
+
 func HandleNetworkInfo(
 	state interfaces.IState,
 	params interface{},
@@ -405,6 +444,143 @@ func HandleReloadConfig(
 	return state.GetCfg(), nil
 }
 
+func HandleLinkFaults(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	network := state.GetSimNetwork()
+	if network == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	type ret struct {
+		Links []interfaces.LinkStatus
+	}
+	r := new(ret)
+	r.Links = network.GetLinkFaults()
+	return r, nil
+}
+
+func HandleSetLinkFaults(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	network := state.GetSimNetwork()
+	if network == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	req := new(SetLinkFaultsRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	from, to := -1, -1
+	if req.From != nil {
+		from = *req.From
+	}
+	if req.To != nil {
+		to = *req.To
+	}
+	if err := network.SetLinkFaults(from, to, req.Faults); err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	return HandleLinkFaults(state, nil)
+}
+
+func HandlePartition(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	network := state.GetSimNetwork()
+	if network == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	req := new(PartitionRequest)
+	err := MapToObject(params, req)
+	if err != nil || len(req.Groups) < 2 {
+		return nil, NewInvalidParamsError()
+	}
+	if err := network.Partition(req.Groups, req.Oneway); err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	return HandleLinkFaults(state, nil)
+}
+
+func HandleHealLinks(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	network := state.GetSimNetwork()
+	if network == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	network.Heal()
+	return HandleLinkFaults(state, nil)
+}
+
+// LoadGenerator submits load to the simulated network, for the load
+// methods.  Nil when factomd is not simulating.
+var LoadGenerator interfaces.ILoadGenerator
+
+func HandleStartLoad(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	if LoadGenerator == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	req := new(interfaces.LoadConfig)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if err := LoadGenerator.StartLoad(*req); err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	return HandleLoadReport(state, nil)
+}
+
+func HandleStopLoad(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	if LoadGenerator == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	LoadGenerator.StopLoad()
+	return HandleLoadReport(state, nil)
+}
+
+func HandleLoadReport(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	if LoadGenerator == nil {
+		return nil, NewCustomInvalidRequestError("Not a simulated network")
+	}
+	return LoadGenerator.GetLoadReport(), nil
+}
+
 type SetDelayRequest struct {
 	Delay int64 `json:"delay"`
 }
@@ -412,3 +588,16 @@ type SetDelayRequest struct {
 type SetDropRateRequest struct {
 	DropRate int `json:"droprate"`
 }
+
+// SetLinkFaultsRequest sets the faults of the links from one node to another,
+// by their index in the simulation.  Every node if not given.
+type SetLinkFaultsRequest struct {
+	From   *int                  `json:"from,omitempty"`
+	To     *int                  `json:"to,omitempty"`
+	Faults interfaces.LinkFaults `json:"faults"`
+}
+
+type PartitionRequest struct {
+	Groups [][]int `json:"groups"`
+	Oneway bool    `json:"oneway"`
+}
@@ -47,6 +47,9 @@ func NewCustomInternalError(data interface{}) *primitives.JSONError {
 func NewCustomInvalidParamsError(data interface{}) *primitives.JSONError {
 	return primitives.NewJSONError(-32602, "Invalid params", data)
 }
+func NewCustomInvalidRequestError(data interface{}) *primitives.JSONError {
+	return primitives.NewJSONError(-32600, "Invalid Request", data)
+}
 
 /*******************************************************************/
 
@@ -97,3 +100,6 @@ func NewReceiptError() *primitives.JSONError {
 func NewRepeatCommitError(data interface{}) *primitives.JSONError {
 	return primitives.NewJSONError(-32011, "Repeated Commit", data)
 }
+func NewEntryRuleError(data interface{}) *primitives.JSONError {
+	return primitives.NewJSONError(-32012, "Entry rule violated", data)
+}
@@ -0,0 +1,139 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/web"
+)
+
+// HealthThresholds decide when a node is considered ready to serve API
+// traffic.  They are set from the command line before the API starts.
+type HealthThresholds struct {
+	MaxBlockGap       uint32        // Highest known block minus highest saved block
+	MaxEntrySyncGap   uint32        // Highest saved block minus entry sync height
+	MinPeers          int           // Connected peers
+	MaxTimeSinceBlock time.Duration // Time since the last block was processed, 0 disables
+}
+
+var Thresholds = HealthThresholds{
+	MaxBlockGap:       1,
+	MaxEntrySyncGap:   2,
+	MinPeers:          0,
+	MaxTimeSinceBlock: 20 * time.Minute,
+}
+
+type HealthStatus struct {
+	Healthy bool     `json:"healthy"`
+	Ready   bool     `json:"ready"`
+	Reasons []string `json:"reasons,omitempty"`
+
+	DBOpen                bool   `json:"dbopen"`
+	DBFinished            bool   `json:"dbfinished"`
+	HighestSavedBlock     uint32 `json:"highestsavedblock"`
+	HighestKnownBlock     uint32 `json:"highestknownblock"`
+	BlockGap              uint32 `json:"blockgap"`
+	EntryDBHeightComplete uint32 `json:"entrydbheightcomplete"`
+	EntrySyncGap          uint32 `json:"entrysyncgap"`
+	Peers                 int    `json:"peers"`
+	SecondsSinceBlock     int64  `json:"secondssinceblock"` // -1 if no block has been processed yet
+}
+
+// CheckHealth gathers the node's health.  A node is healthy if its database
+// is usable, and ready if it is healthy and synced within the thresholds.
+func CheckHealth(state interfaces.IState, t HealthThresholds) *HealthStatus {
+	h := new(HealthStatus)
+
+	db := state.GetAndLockDB()
+	if db != nil {
+		_, err := db.FetchDBKeyMRByHeight(0)
+		h.DBOpen = err == nil
+	}
+	state.UnlockDB()
+
+	h.DBFinished = state.GetDBFinished()
+	h.HighestSavedBlock = state.GetHighestSavedBlk()
+	h.HighestKnownBlock = state.GetHighestKnownBlock()
+	if h.HighestKnownBlock > h.HighestSavedBlock {
+		h.BlockGap = h.HighestKnownBlock - h.HighestSavedBlock
+	}
+	h.EntryDBHeightComplete = state.GetEntryDBHeightComplete()
+	if h.HighestSavedBlock > h.EntryDBHeightComplete {
+		h.EntrySyncGap = h.HighestSavedBlock - h.EntryDBHeightComplete
+	}
+	h.Peers = state.GetNumberConnections()
+	h.SecondsSinceBlock = -1
+	if start := state.GetCurrentBlockStartTime(); start > 0 {
+		h.SecondsSinceBlock = int64(primitives.Since(time.Unix(0, start)).Seconds())
+	}
+
+	if !h.DBOpen {
+		h.Reasons = append(h.Reasons, "database is not open")
+	}
+	h.Healthy = h.DBOpen
+
+	if !h.DBFinished {
+		h.Reasons = append(h.Reasons, "database is still loading")
+	}
+	if h.BlockGap > t.MaxBlockGap {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("%d blocks behind the network, max %d", h.BlockGap, t.MaxBlockGap))
+	}
+	if h.EntrySyncGap > t.MaxEntrySyncGap {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("entries synced to %d, %d blocks behind, max %d", h.EntryDBHeightComplete, h.EntrySyncGap, t.MaxEntrySyncGap))
+	}
+	if h.Peers < t.MinPeers {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("%d peers, min %d", h.Peers, t.MinPeers))
+	}
+	if t.MaxTimeSinceBlock > 0 && h.SecondsSinceBlock > int64(t.MaxTimeSinceBlock.Seconds()) {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("last block %d seconds ago, max %d", h.SecondsSinceBlock, int64(t.MaxTimeSinceBlock.Seconds())))
+	}
+	h.Ready = len(h.Reasons) == 0
+
+	return h
+}
+
+// HandleHealth is a liveness check.  It answers 200 while the database is
+// usable, and 503 otherwise.  No authentication is required so that
+// orchestrators can probe it.
+func HandleHealth(ctx *web.Context) {
+	ServersMutex.Lock()
+	state := ctx.Server.Env["state"].(interfaces.IState)
+	ServersMutex.Unlock()
+
+	h := CheckHealth(state, Thresholds)
+	writeHealth(ctx, h, h.Healthy)
+}
+
+// HandleReady is a readiness check.  It answers 200 only when the node is
+// synced within the configured thresholds, and 503 otherwise.
+func HandleReady(ctx *web.Context) {
+	ServersMutex.Lock()
+	state := ctx.Server.Env["state"].(interfaces.IState)
+	ServersMutex.Unlock()
+
+	h := CheckHealth(state, Thresholds)
+	writeHealth(ctx, h, h.Ready)
+}
+
+func writeHealth(ctx *web.Context, h *HealthStatus, ok bool) {
+	data, err := json.Marshal(h)
+	if err != nil {
+		http.Error(ctx.ResponseWriter, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ctx.ResponseWriter.Header().Set("Content-Type", "application/json")
+	if ok {
+		ctx.WriteHeader(http.StatusOK)
+	} else {
+		ctx.WriteHeader(http.StatusServiceUnavailable)
+	}
+	ctx.Write(data)
+}
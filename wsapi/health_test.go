@@ -0,0 +1,100 @@
+package wsapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+// laggingState has saved blocks beyond those its entries are synced to
+type laggingState struct {
+	*state.State
+	saved uint32
+}
+
+func (s *laggingState) GetHighestSavedBlk() uint32 {
+	return s.saved
+}
+
+func TestCheckHealth(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	thresholds := HealthThresholds{MaxBlockGap: 1, MaxEntrySyncGap: 2, MinPeers: 0, MaxTimeSinceBlock: time.Minute}
+
+	setSynced := func(s *state.State) {
+		s.DBFinished = true
+		s.HighestKnown = s.GetHighestSavedBlk()
+		s.EntryDBHeightComplete = s.GetHighestSavedBlk()
+		s.CurrentBlockStartTime = time.Now().UnixNano()
+	}
+
+	setSynced(s)
+	h := CheckHealth(s, thresholds)
+	if !h.DBOpen || !h.Healthy {
+		t.Errorf("Expected a healthy node, got %v", h.Reasons)
+	}
+	if !h.Ready {
+		t.Errorf("Expected a ready node, got %v", h.Reasons)
+	}
+	if h.SecondsSinceBlock < 0 || h.SecondsSinceBlock > 1 {
+		t.Errorf("Bad time since block %d", h.SecondsSinceBlock)
+	}
+
+	checks := []struct {
+		name  string
+		setup func(s *state.State) interfaces.IState
+	}{
+		{"loading", func(s *state.State) interfaces.IState { s.DBFinished = false; return s }},
+		{"behind", func(s *state.State) interfaces.IState { s.HighestKnown = s.GetHighestSavedBlk() + 2; return s }},
+		{"entries", func(s *state.State) interfaces.IState {
+			saved := s.EntryDBHeightComplete + thresholds.MaxEntrySyncGap + 1
+			s.HighestKnown = saved
+			return &laggingState{State: s, saved: saved}
+		}},
+		{"peers", func(s *state.State) interfaces.IState { thresholds.MinPeers = 1; return s }},
+		{"stale", func(s *state.State) interfaces.IState {
+			s.CurrentBlockStartTime = time.Now().Add(-2 * time.Minute).UnixNano()
+			return s
+		}},
+	}
+	for _, c := range checks {
+		setSynced(s)
+		thresholds.MinPeers = 0
+		h := CheckHealth(c.setup(s), thresholds)
+		if !h.Healthy {
+			t.Errorf("%s: Expected the node to stay healthy", c.name)
+		}
+		if h.Ready || len(h.Reasons) != 1 {
+			t.Errorf("%s: Expected exactly one reason not to be ready, got %v", c.name, h.Reasons)
+		}
+	}
+}
+
+func TestHandleReady(t *testing.T) {
+	context := testHelper.CreateWebContext()
+	s := context.Server.Env["state"].(*state.State)
+
+	s.DBFinished = false
+	HandleReady(context)
+	if code := context.ResponseWriter.(*testHelper.TestResponseWriter).HeaderCode; code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 while loading, got %d", code)
+	}
+	h := new(HealthStatus)
+	if err := json.Unmarshal([]byte(testHelper.GetBody(context)), h); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if h.Ready || h.DBFinished {
+		t.Errorf("Bad readiness report %v", testHelper.GetBody(context))
+	}
+
+	testHelper.ClearContextResponseWriter(context)
+	HandleHealth(context)
+	if code := context.ResponseWriter.(*testHelper.TestResponseWriter).HeaderCode; code != http.StatusOK {
+		t.Errorf("Expected 200 from /health, got %d", code)
+	}
+}
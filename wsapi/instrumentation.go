@@ -164,6 +164,46 @@ var (
 		Name: "factomd_wsapi_v2_api_call_tpsrate_ns",
 		Help: "Time it takes to compelete a tpsrate",
 	})
+
+	HandleV2APICallNodeStatus = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_nodestatus_ns",
+		Help: "Time it takes to compelete a node-status",
+	})
+
+	HandleV2APICallValidateTx = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_validatetx_ns",
+		Help: "Time it takes to compelete a validate-transaction",
+	})
+
+	HandleV2APICallEstimateFee = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_estimatefee_ns",
+		Help: "Time it takes to compelete an estimate-fee",
+	})
+
+	HandleV2APICallAnchors = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_anchors_ns",
+		Help: "Time it takes to compelete an anchors",
+	})
+
+	HandleV2APICallComposeEntry = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_composeentry_ns",
+		Help: "Time it takes to compelete a compose-and-submit-entry",
+	})
+
+	HandleV2APICallLookup = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_lookup_ns",
+		Help: "Time it takes to compelete a lookup",
+	})
+
+	HandleV2APICallIdentity = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_identity_ns",
+		Help: "Time it takes to compelete an identity",
+	})
+
+	HandleV2APICallAuthorityHistory = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_authorityhistory_ns",
+		Help: "Time it takes to compelete an authority-history",
+	})
 )
 
 var registered = false
@@ -207,4 +247,12 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(HandleV2APICallABlockByHeight)
 	prometheus.MustRegister(HandleV2APICallAuthorities)
 	prometheus.MustRegister(HandleV2APICallTpsRate)
+	prometheus.MustRegister(HandleV2APICallNodeStatus)
+	prometheus.MustRegister(HandleV2APICallValidateTx)
+	prometheus.MustRegister(HandleV2APICallEstimateFee)
+	prometheus.MustRegister(HandleV2APICallAnchors)
+	prometheus.MustRegister(HandleV2APICallComposeEntry)
+	prometheus.MustRegister(HandleV2APICallLookup)
+	prometheus.MustRegister(HandleV2APICallIdentity)
+	prometheus.MustRegister(HandleV2APICallAuthorityHistory)
 }
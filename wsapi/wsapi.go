@@ -86,6 +86,9 @@ func Start(state interfaces.IState) {
 		server.Post("/v2", HandleV2)
 		server.Get("/v2", HandleV2)
 
+		server.Get("/health", HandleHealth)
+		server.Get("/ready", HandleReady)
+
 		// start the debugging api if we are not on the main network
 		if state.GetNetworkName() != "MAIN" {
 			server.Post("/debug", HandleDebug)
@@ -5,6 +5,7 @@
 package wsapi
 
 import (
+	"github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/receipts"
@@ -129,6 +130,24 @@ type EntryCreditRateResponse struct {
 	Rate int64 `json:"rate"`
 }
 
+type EstimateFeeResponse struct {
+	Predicted bool `json:"predicted"` // Priced at GetPredictiveFER rather than the current rate
+	*factoid.FeeBreakdown
+}
+
+type AnchorsResponse struct {
+	Anchors []*interfaces.AnchorStatus `json:"anchors"`
+}
+
+// ComposeEntryResponse holds the hashes to follow the commit and the reveal
+// with entry-ack.
+type ComposeEntryResponse struct {
+	Message    string `json:"message"`
+	CommitTxID string `json:"committxid"`
+	EntryHash  string `json:"entryhash"`
+	ChainID    string `json:"chainid"`
+}
+
 type PropertiesResponse struct {
 	FactomdVersion string `json:"factomdversion"`
 	ApiVersion     string `json:"factomdapiversion"`
@@ -249,10 +268,34 @@ type EntryRequest struct {
 	Entry string `json:"entry"`
 }
 
+// LookupRequest takes either an entry hash or a chain ID
+type LookupRequest struct {
+	EntryHash string `json:"entryhash,omitempty"`
+	ChainID   string `json:"chainid,omitempty"`
+}
+
+// ComposeEntryRequest is an entry for compose-and-submit-entry to pay for
+// with the key of ECAddress.  Without a chain ID the entry starts a new
+// chain, whose ID comes from the external IDs.  External IDs and content are
+// hex.
+type ComposeEntryRequest struct {
+	ChainID   string   `json:"chainid,omitempty"`
+	ExtIDs    []string `json:"extids,omitempty"`
+	Content   string   `json:"content,omitempty"`
+	ECAddress string   `json:"ecaddress"`
+}
+
 type HashRequest struct {
 	Hash string `json:"hash"`
 }
 
+// ReceiptRequest asks for the receipt of an entry (the default), a factoid
+// transaction or an EC commit.
+type ReceiptRequest struct {
+	Hash string `json:"hash"`
+	Type string `json:"type,omitempty"` // entry, factoid or entrycredit
+}
+
 type KeyMRRequest struct {
 	KeyMR string `json:"keymr"`
 }
@@ -265,6 +308,14 @@ type MessageRequest struct {
 	Message string `json:"message"`
 }
 
+// CommitRequest is a commit, and optionally the entry it pays for.  Given the
+// entry, the commit is refused if the entry breaks the entry rules, before its
+// credits are spent.
+type CommitRequest struct {
+	Message string `json:"message"`
+	Entry   string `json:"entry,omitempty"`
+}
+
 type PendingEntry struct {
 	EntryHash interfaces.IHash `json:"entryhash"`
 	ChainID   interfaces.IHash `json:"chainid"`
@@ -280,6 +331,52 @@ type TransactionRequest struct {
 	Transaction string `json:"transaction"`
 }
 
+// EstimateFeeRequest takes either a transaction, signed or not, or the
+// number of inputs, outputs and EC outputs of one.  RCDs gives the RCD of
+// each input when the transaction has none; inputs without one are RCD_1.
+type EstimateFeeRequest struct {
+	Transaction string           `json:"transaction,omitempty"`
+	Inputs      int              `json:"inputs,omitempty"`
+	Outputs     int              `json:"outputs,omitempty"`
+	ECOutputs   int              `json:"ecoutputs,omitempty"`
+	RCDs        []EstimateFeeRCD `json:"rcds,omitempty"`
+	Predicted   bool             `json:"predicted,omitempty"`
+}
+
+type EstimateFeeRCD struct {
+	Type      int `json:"type"`
+	Required  int `json:"required,omitempty"`  // RCD_2 only
+	Addresses int `json:"addresses,omitempty"` // RCD_2 only
+}
+
+// AnchorsRequest takes a directory block height or KeyMR, or a range of
+// heights from start to end.
+type AnchorsRequest struct {
+	Height *int64 `json:"height,omitempty"`
+	KeyMR  string `json:"keymr,omitempty"`
+	Start  *int64 `json:"start,omitempty"`
+	End    *int64 `json:"end,omitempty"`
+}
+
+// AuthorityHistoryRequest asks for the authority set as of a height, the
+// highest saved block without one.  With a chain ID the changes to that
+// authority come too.
+type AuthorityHistoryRequest struct {
+	Height  *int64 `json:"height,omitempty"`
+	ChainID string `json:"chainid,omitempty"`
+}
+
+// ValidateTransactionRequest takes either a factoid transaction, as given to
+// factoid-submit, or an entry or chain commit, as given to commit-entry and
+// commit-chain.  An entry, as given to reveal-entry, may be given alone or
+// with its commit, to check it against the node's entry rules before paying
+// for it.
+type ValidateTransactionRequest struct {
+	Transaction string `json:"transaction,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Entry       string `json:"entry,omitempty"`
+}
+
 type SendRawMessageRequest struct {
 	Message string `json:"message"`
 }
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"strings"
@@ -21,7 +23,10 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
 	"github.com/FactomProject/factomd/receipts"
+	"github.com/FactomProject/factomd/tracing"
 	"github.com/FactomProject/web"
 )
 
@@ -56,7 +61,13 @@ func HandleV2(ctx *web.Context) {
 		return
 	}
 
-	jsonResp, jsonError := HandleV2Request(state, j)
+	if LocalOnlyMethods[j.Method] && isLoopback(ctx.Request.RemoteAddr) == false {
+		HandleV2Error(ctx, j, NewCustomInvalidRequestError("Only served to local clients"))
+		return
+	}
+
+	parent := tracing.ParseTraceParent(ctx.Request.Header.Get("traceparent"))
+	jsonResp, jsonError := HandleV2RequestWithTrace(state, j, parent)
 
 	if jsonError != nil {
 		HandleV2Error(ctx, j, jsonError)
@@ -66,7 +77,35 @@ func HandleV2(ctx *web.Context) {
 	ctx.Write([]byte(jsonResp.String()))
 }
 
+// LocalOnlyMethods are only served to clients on the node's own host
+var LocalOnlyMethods map[string]bool = map[string]bool{
+	"compose-and-submit-entry": true,
+}
+
+func isLoopback(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*primitives.JSON2Response, *primitives.JSONError) {
+	return HandleV2RequestWithTrace(state, j, nil)
+}
+
+// HandleV2RequestWithTrace handles the request inside a span for the method.
+// The span joins the caller's trace if parent is not nil.
+func HandleV2RequestWithTrace(state interfaces.IState, j *primitives.JSON2Request, parent *tracing.SpanContext) (*primitives.JSON2Response, *primitives.JSONError) {
+	span := tracing.StartWithParent("wsapi.v2."+j.Method, parent)
+	span.SetAttribute("rpc.system", "jsonrpc")
+	span.SetAttribute("rpc.method", j.Method)
+	defer span.End()
+	if span != nil {
+		state = tracedState{state, span} // The handler's database calls are children of the span
+	}
+
 	var resp interface{}
 	var jsonError *primitives.JSONError
 	params := j.Params
@@ -80,9 +119,18 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "commit-entry":
 		resp, jsonError = HandleV2CommitEntry(state, params)
 		break
+	case "compose-and-submit-entry":
+		resp, jsonError = HandleV2ComposeEntry(state, params)
+		break
 	case "current-minute":
 		resp, jsonError = HandleV2CurrentMinute(state, params)
 		break
+	case "node-status":
+		resp, jsonError = HandleV2NodeStatus(state, params)
+		break
+	case "validate-transaction":
+		resp, jsonError = HandleV2ValidateTransaction(state, params)
+		break
 	case "directory-block":
 		resp, jsonError = HandleV2DirectoryBlock(state, params)
 		break
@@ -92,6 +140,12 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "entry-block":
 		resp, jsonError = HandleV2EntryBlock(state, params)
 		break
+	case "lookup":
+		resp, jsonError = HandleV2Lookup(state, params)
+		break
+	case "anchors":
+		resp, jsonError = HandleV2Anchors(state, params)
+		break
 	case "admin-block":
 		resp, jsonError = HandleV2AdminBlock(state, params)
 		break
@@ -107,6 +161,9 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "entry-credit-balance":
 		resp, jsonError = HandleV2EntryCreditBalance(state, params)
 		break
+	case "estimate-fee":
+		resp, jsonError = HandleV2EstimateFee(state, params)
+		break
 	case "entry-credit-rate":
 		resp, jsonError = HandleV2EntryCreditRate(state, params)
 		break
@@ -166,6 +223,10 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 		break
 	case "authorities":
 		resp, jsonError = HandleAuthorities(state, params)
+	case "identity":
+		resp, jsonError = HandleV2Identity(state, params)
+	case "authority-history":
+		resp, jsonError = HandleV2AuthorityHistory(state, params)
 	case "tps-rate":
 		resp, jsonError = HandleV2TransactionRate(state, params)
 	case "ack":
@@ -175,6 +236,8 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 		break
 	}
 	if jsonError != nil {
+		span.SetAttribute("rpc.jsonrpc.error_code", jsonError.Code)
+		span.SetError(jsonError)
 		return nil, jsonError
 	}
 
@@ -185,6 +248,168 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	return jsonResp, nil
 }
 
+// tracedState is the state as a traced handler sees it, its database calls
+// traced as children of the handler's span.  Calls the state makes for it
+// are not traced.
+type tracedState struct {
+	interfaces.IState
+	span *tracing.Span
+}
+
+func (s tracedState) GetAndLockDB() interfaces.DBOverlaySimple {
+	return databaseOverlay.TraceDB(s.IState.GetAndLockDB(), s.span)
+}
+
+// HandleV2Lookup reports where an entry or chain stands on this node, so a
+// client can tell one that does not exist from one that is pending or not
+// synced yet.
+func HandleV2Lookup(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallLookup.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(LookupRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	switch {
+	case req.EntryHash != "" && req.ChainID == "":
+		h, err := primitives.HexToHash(req.EntryHash)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		return state.LookupEntry(h), nil
+	case req.ChainID != "" && req.EntryHash == "":
+		h, err := primitives.HexToHash(req.ChainID)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		return state.LookupChain(h), nil
+	}
+	return nil, NewCustomInvalidParamsError("Give either an entryhash or a chainid")
+}
+
+// HandleV2Identity returns everything the node knows of an identity: its
+// chains, keys, Matryoshka hash and anchor keys, and as an authority its
+// signing key history.
+func HandleV2Identity(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallIdentity.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(ChainIDRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	h, err := primitives.HexToHash(req.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+	r := state.GetIdentityRecord(h)
+	if r == nil {
+		return nil, NewObjectNotFoundError()
+	}
+	return r, nil
+}
+
+// HandleV2AuthorityHistory returns the federated and audit servers as of a
+// height, derived from the admin blocks.
+func HandleV2AuthorityHistory(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallAuthorityHistory.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(AuthorityHistoryRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+
+	height := int64(state.GetHighestSavedBlk())
+	if req.Height != nil {
+		height = *req.Height
+	}
+	if height < 0 || height > math.MaxUint32 {
+		return nil, NewInvalidParamsError()
+	}
+	var chainID interfaces.IHash
+	if req.ChainID != "" {
+		h, err := primitives.HexToHash(req.ChainID)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		chainID = h
+	}
+
+	set, err := state.GetAuthoritySet(uint32(height), chainID)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	return set, nil
+}
+
+// MaxAnchorRange is the most directory blocks an anchors call returns
+const MaxAnchorRange = 1000
+
+// HandleV2Anchors returns the anchor status of a directory block, given by
+// height or KeyMR, or of a range of directory blocks.
+func HandleV2Anchors(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallAnchors.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(AnchorsRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	tracker := state.GetAnchor()
+	if tracker == nil {
+		return nil, NewCustomInternalError("Anchor tracking is not running")
+	}
+
+	var start, end int64
+	switch {
+	case req.KeyMR != "":
+		h, err := primitives.HexToHash(req.KeyMR)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+		dbase := state.GetAndLockDB()
+		block, err := dbase.FetchDBlock(h)
+		state.UnlockDB()
+		if err != nil {
+			return nil, NewInternalDatabaseError()
+		}
+		if block == nil {
+			return nil, NewBlockNotFoundError()
+		}
+		start = int64(block.GetDatabaseHeight())
+		end = start
+	case req.Height != nil:
+		start, end = *req.Height, *req.Height
+	case req.Start != nil && req.End != nil:
+		start, end = *req.Start, *req.End
+	default:
+		return nil, NewCustomInvalidParamsError("Give a height, a keymr, or a start and an end")
+	}
+	if start < 0 || end < start || start > math.MaxUint32 || end > math.MaxUint32 {
+		return nil, NewInvalidParamsError()
+	}
+	if end-start >= MaxAnchorRange {
+		return nil, NewCustomInvalidParamsError(fmt.Sprintf("At most %d directory blocks at a time", MaxAnchorRange))
+	}
+
+	resp := new(AnchorsResponse)
+	resp.Anchors = tracker.GetAnchorStatuses(uint32(start), uint32(end))
+	if len(resp.Anchors) == 0 {
+		return nil, NewBlockNotFoundError()
+	}
+	return resp, nil
+}
+
 func HandleV2DBlockByHeight(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallDBlockByHeight.Observe(float64(time.Since(n).Nanoseconds()))
@@ -536,7 +761,7 @@ func HandleV2CommitChain(state interfaces.IState, params interface{}) (interface
 	n := time.Now()
 	defer HandleV2APICallCommitChain.Observe(float64(time.Since(n).Nanoseconds()))
 
-	commitChainMsg := new(MessageRequest)
+	commitChainMsg := new(CommitRequest)
 	err := MapToObject(params, commitChainMsg)
 	if err != nil {
 		return nil, NewInvalidParamsError()
@@ -555,6 +780,9 @@ func HandleV2CommitChain(state interfaces.IState, params interface{}) (interface
 	if !commit.IsValid() {
 		return nil, NewInvalidCommitChainError()
 	}
+	if jErr := checkCommittedEntry(state, commitChainMsg.Entry, commit.EntryHash); jErr != nil {
+		return nil, jErr
+	}
 
 	msg := new(messages.CommitChainMsg)
 	msg.CommitChain = commit
@@ -584,7 +812,7 @@ func HandleV2CommitEntry(state interfaces.IState, params interface{}) (interface
 	n := time.Now()
 	defer HandleV2APICallCommitEntry.Observe(float64(time.Since(n).Nanoseconds()))
 
-	commitEntryMsg := new(MessageRequest)
+	commitEntryMsg := new(CommitRequest)
 	err := MapToObject(params, commitEntryMsg)
 	if err != nil {
 		return nil, NewInvalidParamsError()
@@ -603,6 +831,9 @@ func HandleV2CommitEntry(state interfaces.IState, params interface{}) (interface
 	if !commit.IsValid() {
 		return nil, NewInvalidCommitEntryError()
 	}
+	if jErr := checkCommittedEntry(state, commitEntryMsg.Entry, commit.EntryHash); jErr != nil {
+		return nil, jErr
+	}
 
 	msg := new(messages.CommitEntryMsg)
 	msg.CommitEntry = commit
@@ -623,6 +854,153 @@ func HandleV2CommitEntry(state interfaces.IState, params interface{}) (interface
 	return resp, nil
 }
 
+// HandleV2ComposeEntry builds an entry and the commit paying for it, signs
+// the commit with one of the node's entry credit keys, and submits both.  The
+// entry is checked against the entry rules, and the commit dry run, before
+// anything is
+// submitted.
+func HandleV2ComposeEntry(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallComposeEntry.Observe(float64(time.Since(n).Nanoseconds()))
+
+	keys := state.GetComposeKeys()
+	if keys == nil {
+		return nil, NewCustomInternalError("No entry credit keys are loaded")
+	}
+
+	req := new(ComposeEntryRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	entry := entryBlock.NewEntry()
+	for _, v := range req.ExtIDs {
+		p, err := hex.DecodeString(v)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError("External IDs must be hex")
+		}
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: p})
+	}
+	p, err := hex.DecodeString(req.Content)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError("Content must be hex")
+	}
+	entry.Content = primitives.ByteSlice{Bytes: p}
+	newChain := req.ChainID == ""
+	if newChain {
+		entry.ChainID = entryBlock.NewChainID(entry)
+	} else {
+		entry.ChainID, err = primitives.HexToHash(req.ChainID)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+	}
+	if !entry.IsValid() {
+		return nil, NewInvalidEntryError()
+	}
+	if err := checkEntryRules(state, entry); err != nil {
+		return nil, NewEntryRuleError(err)
+	}
+
+	priv, err := keys.PrivateKey(req.ECAddress)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	var commit interfaces.IECBlockEntry
+	var msg interfaces.IMsg
+	if newChain {
+		c, err := compose.NewChainCommit(entry, priv, state.GetTimestamp())
+		if err != nil {
+			return nil, NewCustomInternalError(err.Error())
+		}
+		commit, msg = c, &messages.CommitChainMsg{CommitChain: c}
+	} else {
+		c, err := compose.NewEntryCommit(entry, priv, state.GetTimestamp())
+		if err != nil {
+			return nil, NewCustomInternalError(err.Error())
+		}
+		commit, msg = c, &messages.CommitEntryMsg{CommitEntry: c}
+	}
+	if v := state.DryRunCommit(msg); v.Valid == false {
+		return nil, NewCustomInvalidParamsError(v.Reasons)
+	}
+
+	commitBin, err := commit.MarshalBinary()
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	entryBin, err := entry.MarshalBinary()
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	commitParams := MessageRequest{Message: hex.EncodeToString(commitBin)}
+	if newChain {
+		_, jErr := HandleV2CommitChain(state, commitParams)
+		if jErr != nil {
+			return nil, jErr
+		}
+	} else {
+		_, jErr := HandleV2CommitEntry(state, commitParams)
+		if jErr != nil {
+			return nil, jErr
+		}
+	}
+	_, jErr := HandleV2RevealEntry(state, EntryRequest{Entry: hex.EncodeToString(entryBin)})
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	resp := new(ComposeEntryResponse)
+	if newChain {
+		resp.Message = "Chain Compose Success"
+	} else {
+		resp.Message = "Entry Compose Success"
+	}
+	resp.CommitTxID = commit.GetSigHash().String()
+	resp.EntryHash = entry.GetHash().String()
+	resp.ChainID = entry.ChainID.String()
+	return resp, nil
+}
+
+// checkEntryRules checks an entry against the operator's rules for the
+// content of entries, if the node has any.  commit-chain and commit-entry
+// refuse the commit of an entry breaking them, when given the entry, and
+// compose-and-submit-entry checks them before it commits, so no credits are
+// spent.  reveal-entry refuses the entry too, but its commit is already paid
+// for.  validate-transaction reports them.
+func checkEntryRules(state interfaces.IState, entry interfaces.IEBEntry) error {
+	if rules := state.GetEntryValidator(); rules != nil {
+		return rules.Validate(entry)
+	}
+	return nil
+}
+
+// checkCommittedEntry checks the entry given with a commit is the one it pays
+// for, and against the entry rules.  There is nothing to check without one.
+func checkCommittedEntry(state interfaces.IState, entryHex string, entryHash interfaces.IHash) *primitives.JSONError {
+	if entryHex == "" {
+		return nil
+	}
+	entry := entryBlock.NewEntry()
+	if p, err := hex.DecodeString(entryHex); err != nil {
+		return NewInvalidEntryError()
+	} else if _, err := entry.UnmarshalBinaryData(p); err != nil {
+		return NewInvalidEntryError()
+	}
+	if !entry.IsValid() {
+		return NewInvalidEntryError()
+	}
+	if !entry.GetHash().IsSameAs(entryHash) {
+		return NewCustomInvalidParamsError("The commit does not pay for the entry")
+	}
+	if err := checkEntryRules(state, entry); err != nil {
+		return NewEntryRuleError(err)
+	}
+	return nil
+}
+
 func HandleV2RevealEntry(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallRevealEntry.Observe(float64(time.Since(n).Nanoseconds()))
@@ -646,6 +1024,9 @@ func HandleV2RevealEntry(state interfaces.IState, params interface{}) (interface
 	if !entry.IsValid() {
 		return nil, NewInvalidEntryError()
 	}
+	if err := checkEntryRules(state, entry); err != nil {
+		return nil, NewEntryRuleError(err)
+	}
 
 	msg := new(messages.RevealEntryMsg)
 	msg.Entry = entry
@@ -730,12 +1111,18 @@ func HandleV2Receipt(state interfaces.IState, params interface{}) (interface{},
 	n := time.Now()
 	defer HandleV2APICallReceipt.Observe(float64(time.Since(n).Nanoseconds()))
 
-	hashkey := new(HashRequest)
+	hashkey := new(ReceiptRequest)
 	err := MapToObject(params, hashkey)
 	if err != nil {
 		return nil, NewInvalidParamsError()
 	}
 
+	switch hashkey.Type {
+	case "", receipts.ReceiptTypeEntry, receipts.ReceiptTypeFactoid, receipts.ReceiptTypeEntryCredit:
+	default:
+		return nil, NewInvalidParamsError()
+	}
+
 	h, err := primitives.HexToHash(hashkey.Hash)
 	if err != nil {
 		return nil, NewInvalidHashError()
@@ -744,7 +1131,7 @@ func HandleV2Receipt(state interfaces.IState, params interface{}) (interface{},
 	dbase := state.GetAndLockDB()
 	defer state.UnlockDB()
 
-	receipt, err := receipts.CreateFullReceipt(dbase, h)
+	receipt, err := receipts.CreateReceiptOfType(dbase, hashkey.Type, h)
 	if err != nil {
 		return nil, NewReceiptError()
 	}
@@ -971,6 +1358,120 @@ func HandleV2CurrentMinute(state interfaces.IState, params interface{}) (interfa
 	return h, nil
 }
 
+// HandleV2NodeStatus returns the role, process list, queues, sync and fault
+// state of each node as printed by the console summary: every simulated node
+// when the node runs a simulation, else just this one.
+func HandleV2NodeStatus(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallNodeStatus.Observe(float64(time.Since(n).Nanoseconds()))
+
+	if network, ok := state.GetSimNetwork().(interfaces.INodeStatusReporter); ok {
+		return network.GetNodeStatus(), nil
+	}
+	return []*interfaces.NodeStatus{state.GetNodeStatus()}, nil
+}
+
+// HandleV2ValidateTransaction runs a factoid transaction or an EC commit
+// through the node's checks without submitting it, and reports each check.
+func HandleV2ValidateTransaction(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallValidateTx.Observe(float64(time.Since(n).Nanoseconds()))
+
+	t := new(ValidateTransactionRequest)
+	err := MapToObject(params, t)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	switch {
+	case t.Entry != "" && t.Transaction == "":
+		return validateEntry(state, t)
+	case t.Transaction != "" && t.Message == "":
+		msg := new(messages.FactoidTransaction)
+		p, err := hex.DecodeString(t.Transaction)
+		if err != nil {
+			return nil, NewUnableToDecodeTransactionError()
+		}
+		_, err = msg.UnmarshalTransData(p)
+		if err != nil {
+			return nil, NewUnableToDecodeTransactionError()
+		}
+		return state.DryRunTransaction(msg.Transaction), nil
+
+	case t.Message != "" && t.Transaction == "":
+		msg, _, jErr := decodeCommit(t.Message)
+		if jErr != nil {
+			return nil, jErr
+		}
+		return state.DryRunCommit(msg), nil
+	}
+
+	return nil, NewInvalidParamsError()
+}
+
+// decodeCommit decodes an entry or chain commit, and returns it as a message
+// along with the hash of the entry it pays for.
+func decodeCommit(message string) (interfaces.IMsg, interfaces.IHash, *primitives.JSONError) {
+	p, err := hex.DecodeString(message)
+	if err != nil {
+		return nil, nil, NewInvalidCommitEntryError()
+	}
+	// Chain commits are longer than entry commits
+	if len(p) >= entryCreditBlock.CommitChainSize {
+		commit := entryCreditBlock.NewCommitChain()
+		if _, err := commit.UnmarshalBinaryData(p); err != nil {
+			return nil, nil, NewInvalidCommitChainError()
+		}
+		msg := new(messages.CommitChainMsg)
+		msg.CommitChain = commit
+		return msg, commit.GetEntryHash(), nil
+	}
+	commit := entryCreditBlock.NewCommitEntry()
+	if _, err := commit.UnmarshalBinaryData(p); err != nil {
+		return nil, nil, NewInvalidCommitEntryError()
+	}
+	msg := new(messages.CommitEntryMsg)
+	msg.CommitEntry = commit
+	return msg, commit.GetEntryHash(), nil
+}
+
+// validateEntry checks an entry, and its commit if one is given, without
+// submitting either.
+func validateEntry(state interfaces.IState, t *ValidateTransactionRequest) (interface{}, *primitives.JSONError) {
+	entry := entryBlock.NewEntry()
+	p, err := hex.DecodeString(t.Entry)
+	if err != nil {
+		return nil, NewInvalidEntryError()
+	}
+	if _, err := entry.UnmarshalBinaryData(p); err != nil {
+		return nil, NewInvalidEntryError()
+	}
+
+	v := new(interfaces.TransactionValidation)
+	v.Type = "entry"
+	v.TxID = entry.GetHash().String()
+	if t.Message != "" {
+		msg, entryHash, jErr := decodeCommit(t.Message)
+		if jErr != nil {
+			return nil, jErr
+		}
+		v = state.DryRunCommit(msg)
+		if entryHash.IsSameAs(entry.GetHash()) {
+			v.AddCheck("entryhash", nil)
+		} else {
+			v.AddCheck("entryhash", fmt.Errorf("The commit pays for entry %v, not %v", entryHash, entry.GetHash()))
+		}
+	}
+
+	if entry.IsValid() {
+		v.AddCheck("entry", nil)
+	} else {
+		v.AddCheck("entry", fmt.Errorf("Entry is too large or malformed"))
+	}
+	v.AddCheck("rules", checkEntryRules(state, entry))
+	return v, nil
+}
+
 func HandleV2EntryCreditBalance(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallECBal.Observe(float64(time.Since(n).Nanoseconds()))
@@ -1018,6 +1519,67 @@ func HandleV2EntryCreditRate(state interfaces.IState, params interface{}) (inter
 	return resp, nil
 }
 
+// HandleV2EstimateFee returns the minimum fee of a transaction, broken down
+// by size, inputs, outputs and EC outputs, so wallets don't need their own
+// copy of the fee formula.
+func HandleV2EstimateFee(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallEstimateFee.Observe(float64(time.Since(n).Nanoseconds()))
+
+	e := new(EstimateFeeRequest)
+	err := MapToObject(params, e)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	rcds := []interfaces.IRCD{}
+	for _, r := range e.RCDs {
+		rcd, err := factoid.NewFeeEstimateRCD(r.Type, r.Required, r.Addresses)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(err.Error())
+		}
+		rcds = append(rcds, rcd)
+	}
+
+	var trans *factoid.Transaction
+	if e.Transaction != "" {
+		p, err := hex.DecodeString(e.Transaction)
+		if err != nil {
+			return nil, NewUnableToDecodeTransactionError()
+		}
+		// An unsigned transaction is only the part that gets signed
+		trans, err = factoid.UnmarshalFeeEstimateTransaction(p)
+		if err != nil {
+			return nil, NewUnableToDecodeTransactionError()
+		}
+		if len(trans.RCDs) == 0 {
+			if len(rcds) > len(trans.Inputs) {
+				return nil, NewCustomInvalidParamsError("More RCDs than inputs")
+			}
+			trans.RCDs = rcds
+		}
+	} else {
+		trans, err = factoid.NewFeeEstimateTransaction(e.Inputs, e.Outputs, e.ECOutputs, rcds)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(err.Error())
+		}
+	}
+
+	rate := state.GetFactoshisPerEC()
+	if e.Predicted {
+		rate = state.GetPredictiveFER()
+	}
+	b, err := trans.EstimateFee(rate)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	resp := new(EstimateFeeResponse)
+	resp.Predicted = e.Predicted
+	resp.FeeBreakdown = b
+	return resp, nil
+}
+
 func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallFctTx.Observe(float64(time.Since(n).Nanoseconds()))
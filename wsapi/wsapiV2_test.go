@@ -2,15 +2,21 @@ package wsapi_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/FactomProject/factomd/anchor"
+	"github.com/FactomProject/factomd/common/identity"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/compose"
+	"github.com/FactomProject/factomd/entryValidation"
 	"github.com/FactomProject/factomd/receipts"
 	"github.com/FactomProject/factomd/testHelper"
 	. "github.com/FactomProject/factomd/wsapi"
@@ -411,6 +417,358 @@ func TestHandleV2GetTranasction(t *testing.T) {
 	}
 }
 
+func TestHandleV2NodeStatus(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	req := primitives.NewJSON2Request("node-status", 1, nil)
+	resp, jErr := HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	list, ok := resp.Result.([]*interfaces.NodeStatus)
+	if !ok || len(list) != 1 {
+		t.Fatalf("Wrong result %T %v", resp.Result, resp.Result)
+	}
+	ns := list[0]
+	if ns.NodeName != state.GetFactomNodeName() {
+		t.Errorf("Wrong node name %s", ns.NodeName)
+	}
+	if ns.Heights.DirectoryBlock != state.GetHighestSavedBlk() {
+		t.Errorf("Wrong directory block height %d", ns.Heights.DirectoryBlock)
+	}
+
+	// A simulation reports each of its nodes
+	state.SimNetwork = &testSimNetwork{nodes: 3}
+	resp, jErr = HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if list, ok := resp.Result.([]*interfaces.NodeStatus); !ok || len(list) != 3 {
+		t.Errorf("Expected 3 nodes, got %v", resp.Result)
+	}
+}
+
+// testSimNetwork is a simulated network of nodes
+type testSimNetwork struct {
+	interfaces.ISimNetwork
+	nodes int
+}
+
+func (n *testSimNetwork) GetNodeStatus() []*interfaces.NodeStatus {
+	var answer []*interfaces.NodeStatus
+	for i := 0; i < n.nodes; i++ {
+		answer = append(answer, &interfaces.NodeStatus{NodeName: fmt.Sprintf("FNode0%d", i)})
+	}
+	return answer
+}
+
+func TestHandleV2ValidateTransaction(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	for _, params := range []interface{}{
+		map[string]string{},
+		map[string]string{"transaction": "00", "message": "00"},
+	} {
+		req := primitives.NewJSON2Request("validate-transaction", 1, params)
+		if _, jErr := HandleV2Request(state, req); jErr == nil || jErr.Code != NewInvalidParamsError().Code {
+			t.Errorf("Expected invalid params for %v, got %v", params, jErr)
+		}
+	}
+
+	req := primitives.NewJSON2Request("validate-transaction", 1, map[string]string{"transaction": "zz"})
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an undecodable transaction to be rejected")
+	}
+}
+
+func TestHandleV2EntryRules(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	// A valid entry, breaking only the rule
+	entry := testHelper.CreateTestEntry(1)
+	entry.Version = 0
+	if !entry.IsValid() {
+		t.Fatalf("Expected a valid entry")
+	}
+	bin, err := entry.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	params := map[string]string{"entry": hex.EncodeToString(bin)}
+
+	rules := entryValidation.NewValidator()
+	rules.Register(entry.GetChainIDHash(), &entryValidation.ExtIDCount{Min: 2})
+	state.EntryValidator = rules
+
+	req := primitives.NewJSON2Request("reveal-entry", 1, params)
+	_, jErr := HandleV2Request(state, req)
+	if jErr == nil || jErr.Code != NewEntryRuleError(nil).Code {
+		t.Fatalf("Expected an entry rule error, got %v", jErr)
+	}
+	if r, ok := jErr.Data.(*entryValidation.RuleError); !ok || r.Rule != "extids" {
+		t.Errorf("Expected the broken rule in the error, got %v", jErr.Data)
+	}
+
+	// Its commit is refused given the entry, before its credits are spent
+	commit, err := compose.NewEntryCommit(entry, testHelper.NewPrivKey(0), state.GetTimestamp())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	commitBin, err := commit.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	req = primitives.NewJSON2Request("commit-entry", 1, map[string]string{"message": hex.EncodeToString(commitBin), "entry": params["entry"]})
+	if _, jErr := HandleV2Request(state, req); jErr == nil || jErr.Code != NewEntryRuleError(nil).Code {
+		t.Errorf("Expected the commit to be refused, got %v", jErr)
+	}
+	other := testHelper.CreateTestEntry(2)
+	other.Version = 0
+	otherBin, _ := other.MarshalBinary()
+	req = primitives.NewJSON2Request("commit-entry", 1, map[string]string{"message": hex.EncodeToString(commitBin), "entry": hex.EncodeToString(otherBin)})
+	if _, jErr := HandleV2Request(state, req); jErr == nil || jErr.Code != NewInvalidParamsError().Code {
+		t.Errorf("Expected the commit of another entry to be refused, got %v", jErr)
+	}
+
+	req = primitives.NewJSON2Request("validate-transaction", 1, params)
+	resp, jErr := HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	v := resp.Result.(*interfaces.TransactionValidation)
+	if v.Valid || v.Type != "entry" || v.TxID != entry.GetHash().String() || len(v.Reasons) != 1 {
+		t.Errorf("Expected the rules check to fail, got %+v", v)
+	}
+
+	state.EntryValidator = nil
+	req = primitives.NewJSON2Request("validate-transaction", 1, params)
+	resp, jErr = HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if v := resp.Result.(*interfaces.TransactionValidation); v.Valid == false {
+		t.Errorf("Expected the entry to pass without rules, got %v", v.Reasons)
+	}
+}
+
+func TestHandleV2ComposeEntry(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	params := map[string]interface{}{
+		"chainid":   testHelper.GetChainID().String(),
+		"extids":    []string{"0102"},
+		"content":   "0304",
+		"ecaddress": testHelper.NewECAddressString(0),
+	}
+	req := primitives.NewJSON2Request("compose-and-submit-entry", 1, params)
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an error without keys")
+	}
+
+	keys, err := compose.OpenKeyStore("", "Map", "password")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	state.ComposeKeys = keys
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an error for an address without a key")
+	}
+
+	if _, err := keys.AddPrivateKey(testHelper.NewPrivKey(0)); err != nil {
+		t.Fatalf("%v", err)
+	}
+	rules := entryValidation.NewValidator()
+	rules.Register(testHelper.GetChainID(), &entryValidation.ExtIDCount{Min: 2})
+	state.EntryValidator = rules
+	_, jErr := HandleV2Request(state, req)
+	if jErr == nil || jErr.Code != NewEntryRuleError(nil).Code {
+		t.Errorf("Expected the entry rules to be checked before paying, got %v", jErr)
+	}
+
+	params["content"] = "zz"
+	req = primitives.NewJSON2Request("compose-and-submit-entry", 1, params)
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected content that is not hex to be refused")
+	}
+}
+
+func TestHandleV2Lookup(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	entry := testHelper.CreateFullTestBlockSet()[1].Entries[0]
+	for params, status := range map[string]string{
+		`{"entryhash": "` + entry.GetHash().String() + `"}`:          interfaces.LookupConfirmed,
+		`{"chainid": "` + testHelper.GetChainID().String() + `"}`:    interfaces.LookupConfirmed,
+		`{"entryhash": "` + primitives.NewZeroHash().String() + `"}`: interfaces.LookupNeverSeen,
+	} {
+		req := primitives.NewJSON2Request("lookup", 1, json.RawMessage(params))
+		resp, jErr := HandleV2Request(state, req)
+		if jErr != nil {
+			t.Fatalf("%v", jErr)
+		}
+		if r := resp.Result.(*interfaces.LookupResult); r.Status != status {
+			t.Errorf("%v: got %v, expected %v", params, r.Status, status)
+		}
+	}
+
+	for _, params := range []map[string]string{
+		{},
+		{"entryhash": "zz"},
+		{"entryhash": entry.GetHash().String(), "chainid": testHelper.GetChainID().String()},
+	} {
+		req := primitives.NewJSON2Request("lookup", 1, params)
+		if _, jErr := HandleV2Request(state, req); jErr == nil {
+			t.Errorf("Expected an error for %v", params)
+		}
+	}
+}
+
+func TestHandleV2EstimateFee(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	params := map[string]interface{}{"inputs": 1, "outputs": 2, "rcds": []map[string]int{{"type": 2, "required": 2, "addresses": 3}}}
+	req := primitives.NewJSON2Request("estimate-fee", 1, params)
+	resp, jErr := HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r, ok := resp.Result.(*EstimateFeeResponse)
+	if !ok {
+		t.Fatalf("Wrong result type %T", resp.Result)
+	}
+	rate := state.GetFactoshisPerEC()
+	if r.Predicted || r.FactoshisPerEC != rate || r.OutputFee != 20*rate || r.InputFee != 2*rate {
+		t.Errorf("Bad estimate %+v", r.FeeBreakdown)
+	}
+
+	params["rcds"] = []map[string]int{{"type": 9}}
+	req = primitives.NewJSON2Request("estimate-fee", 1, params)
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an unknown RCD type to be rejected")
+	}
+}
+
+func TestHandleV2Anchors(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	req := primitives.NewJSON2Request("anchors", 1, map[string]interface{}{"height": 1})
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an error without anchor tracking")
+	}
+
+	tracker := anchor.NewTracker(state.DB, []interfaces.Verifier{testHelper.NewPrimitivesPrivateKey(0).Pub})
+	if _, err := tracker.Sync(); err != nil {
+		t.Fatalf("%v", err)
+	}
+	state.Anchor = tracker
+
+	resp, jErr := HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r := resp.Result.(*AnchorsResponse)
+	if len(r.Anchors) != 1 || r.Anchors[0].DBHeight != 1 || r.Anchors[0].Status != interfaces.AnchorStatusAnchored {
+		t.Errorf("Bad anchors %+v", r.Anchors)
+	}
+
+	dBlock, err := state.DB.FetchDBlockByHeight(2)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	req = primitives.NewJSON2Request("anchors", 1, map[string]interface{}{"keymr": dBlock.GetKeyMR().String()})
+	resp, jErr = HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	r = resp.Result.(*AnchorsResponse)
+	if len(r.Anchors) != 1 || r.Anchors[0].KeyMR != dBlock.GetKeyMR().String() {
+		t.Errorf("Bad anchors %+v", r.Anchors)
+	}
+
+	req = primitives.NewJSON2Request("anchors", 1, map[string]interface{}{"start": 0, "end": 100})
+	resp, jErr = HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if len(resp.Result.(*AnchorsResponse).Anchors) != testHelper.BlockCount {
+		t.Errorf("Expected %v anchors", testHelper.BlockCount)
+	}
+
+	for _, params := range []map[string]interface{}{
+		{},
+		{"start": 0, "end": MaxAnchorRange},
+		{"start": 5, "end": 4},
+		{"height": -1},
+		{"height": 1000},
+	} {
+		req = primitives.NewJSON2Request("anchors", 1, params)
+		if _, jErr := HandleV2Request(state, req); jErr == nil {
+			t.Errorf("Expected an error for %v", params)
+		}
+	}
+}
+
+func TestHandleV2Identity(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	id := primitives.NewHash([]byte("an authority chain, of 32 bytes."))
+
+	req := primitives.NewJSON2Request("identity", 1, map[string]string{"chainid": id.String()})
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an error for an unknown identity")
+	}
+
+	auth := new(identity.Authority)
+	auth.AuthorityChainID = id
+	state.Authorities = append(state.Authorities, auth)
+	resp, jErr := HandleV2Request(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if r := resp.Result.(*interfaces.IdentityRecord); r.ChainID != id.String() || r.Authority == nil {
+		t.Errorf("Bad identity %+v", r)
+	}
+
+	req = primitives.NewJSON2Request("identity", 1, map[string]string{"chainid": "zz"})
+	if _, jErr := HandleV2Request(state, req); jErr == nil {
+		t.Errorf("Expected an error for a bad chain ID")
+	}
+}
+
+func TestHandleV2AuthorityHistory(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	fed := "38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9"
+
+	for _, params := range []map[string]interface{}{
+		nil,
+		{"height": 0},
+		{"height": state.GetHighestSavedBlk(), "chainid": fed},
+	} {
+		req := primitives.NewJSON2Request("authority-history", 1, params)
+		resp, jErr := HandleV2Request(state, req)
+		if jErr != nil {
+			t.Fatalf("%v: %v", params, jErr)
+		}
+		r := resp.Result.(*interfaces.AuthoritySet)
+		if len(r.Federated) != 1 || r.Federated[0].ChainID != fed {
+			t.Errorf("%v: bad authority set %+v", params, r)
+		}
+		if params["chainid"] != nil && len(r.Changes) != 2 {
+			t.Errorf("%v: bad changes %+v", params, r.Changes)
+		}
+	}
+
+	for _, params := range []map[string]interface{}{
+		{"height": -1},
+		{"height": 1000},
+		{"chainid": "zz"},
+	} {
+		req := primitives.NewJSON2Request("authority-history", 1, params)
+		if _, jErr := HandleV2Request(state, req); jErr == nil {
+			t.Errorf("Expected an error for %v", params)
+		}
+	}
+}
+
 func TestJSONString(t *testing.T) {
 	eblock := new(EBlock)
 	eblock.Header.BlockSequenceNumber = 5